@@ -35,6 +35,27 @@ type CosmosCoin struct {
 	Denom  string
 }
 
+// CosmosPageRequest is an auto generated low-level Go binding around an user-defined struct.
+type CosmosPageRequest struct {
+	Key        string
+	Offset     uint64
+	Limit      uint64
+	CountTotal bool
+	Reverse    bool
+}
+
+// CosmosPageResponse is an auto generated low-level Go binding around an user-defined struct.
+type CosmosPageResponse struct {
+	NextKey string
+	Total   uint64
+}
+
+// IBankModuleBalanceQuery is an auto generated low-level Go binding around an user-defined struct.
+type IBankModuleBalanceQuery struct {
+	Account common.Address
+	Denom   string
+}
+
 // IBankModuleDenomMetadata is an auto generated low-level Go binding around an user-defined struct.
 type IBankModuleDenomMetadata struct {
 	Description string
@@ -52,9 +73,38 @@ type IBankModuleDenomUnit struct {
 	Exponent uint32
 }
 
+// IBankModuleGrant is an auto generated low-level Go binding around an user-defined struct.
+type IBankModuleGrant struct {
+	Spender    common.Address
+	Coins      []CosmosCoin
+	Expiration uint64
+}
+
+// IBankModuleSendAllowance is an auto generated low-level Go binding around an user-defined struct.
+type IBankModuleSendAllowance struct {
+	Coins      []CosmosCoin
+	Expiration uint64
+	AllowList  []common.Address
+}
+
+// IBankModuleVestingPeriod is an auto generated low-level Go binding around an user-defined struct.
+type IBankModuleVestingPeriod struct {
+	Length uint64
+	Amount []CosmosCoin
+}
+
+// IBankModuleVestingSchedule is an auto generated low-level Go binding around an user-defined struct.
+type IBankModuleVestingSchedule struct {
+	StartTime       uint64
+	EndTime         uint64
+	OriginalVesting []CosmosCoin
+	VestedCoins     []CosmosCoin
+	Periods         []IBankModuleVestingPeriod
+}
+
 // BankModuleMetaData contains all meta data concerning the BankModule contract.
 var BankModuleMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"burner\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Burn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"CoinReceived\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"CoinSpent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"minter\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Coinbase\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"Message\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"}],\"name\":\"getAllBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"}],\"name\":\"getAllSpendableBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAllSupply\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getDenomMetadata\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"aliases\",\"type\":\"string[]\"},{\"internalType\":\"uint32\",\"name\":\"exponent\",\"type\":\"uint32\"}],\"internalType\":\"structIBankModule.DenomUnit[]\",\"name\":\"denomUnits\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"base\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"display\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"}],\"internalType\":\"structIBankModule.DenomMetadata\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSendEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSpendableBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"toAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"send\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"spendable\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"required\",\"type\":\"uint256\"}],\"name\":\"InsufficientSpendableBalance\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidCoins\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"SendDisabled\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"burner\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Burn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"CoinReceived\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"CoinSpent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"minter\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Coinbase\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"Message\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"bech32s\",\"type\":\"string[]\"}],\"name\":\"batchBech32ToHex\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"}],\"name\":\"batchHexToBech32\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"burn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"caller\",\"type\":\"address\"},{\"internalType\":\"bytes4\",\"name\":\"methodSelector\",\"type\":\"bytes4\"}],\"name\":\"canCall\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structCosmos.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getAllBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"nextKey\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structCosmos.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"}],\"name\":\"getAllBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getAllLockedBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"}],\"name\":\"getAllSpendableBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structCosmos.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getAllSpendableBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"nextKey\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structCosmos.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structCosmos.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getAllSupply\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"nextKey\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structCosmos.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAllSupply\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"getAllowances\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"coins\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"expiration\",\"type\":\"uint64\"}],\"internalType\":\"structIBankModule.Grant[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"}],\"name\":\"getBalanceAtHeight\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structIBankModule.BalanceQuery[]\",\"name\":\"queries\",\"type\":\"tuple[]\"}],\"name\":\"getBalances\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getDenomMetadata\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"aliases\",\"type\":\"string[]\"},{\"internalType\":\"uint32\",\"name\":\"exponent\",\"type\":\"uint32\"}],\"internalType\":\"structIBankModule.DenomUnit[]\",\"name\":\"denomUnits\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"base\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"display\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"}],\"internalType\":\"structIBankModule.DenomMetadata\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structCosmos.PageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"getDenomsMetadata\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"aliases\",\"type\":\"string[]\"},{\"internalType\":\"uint32\",\"name\":\"exponent\",\"type\":\"uint32\"}],\"internalType\":\"structIBankModule.DenomUnit[]\",\"name\":\"denomUnits\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"base\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"display\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"}],\"internalType\":\"structIBankModule.DenomMetadata[]\",\"name\":\"\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"nextKey\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structCosmos.PageResponse\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getEffectiveAllowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"getGrantsToSpender\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"coins\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"expiration\",\"type\":\"uint64\"}],\"internalType\":\"structIBankModule.Grant[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getLockedCoins\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"getSendAllowances\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"coins\",\"type\":\"tuple[]\"},{\"internalType\":\"uint64\",\"name\":\"expiration\",\"type\":\"uint64\"},{\"internalType\":\"address[]\",\"name\":\"allowList\",\"type\":\"address[]\"}],\"internalType\":\"structIBankModule.SendAllowance[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSendEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSpendableBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"accountAddress\",\"type\":\"address\"},{\"internalType\":\"string[]\",\"name\":\"denoms\",\"type\":\"string[]\"}],\"name\":\"getSpendableBalances\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"height\",\"type\":\"int64\"}],\"name\":\"getSupplyAt\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getVestingSchedule\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"startTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"endTime\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"originalVesting\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"vestedCoins\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"length\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"internalType\":\"structIBankModule.VestingPeriod[]\",\"name\":\"periods\",\"type\":\"tuple[]\"}],\"internalType\":\"structIBankModule.VestingSchedule\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"isVestingAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"toAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"send\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"toAddress\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"send\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
 }
 
 // BankModuleABI is the input ABI used to generate the binding from.
@@ -203,12 +253,137 @@ func (_BankModule *BankModuleTransactorRaw) Transact(opts *bind.TransactOpts, me
 	return _BankModule.Contract.contract.Transact(opts, method, params...)
 }
 
-// GetAllBalances is a free data retrieval call binding the contract method 0xc53d6ce1.
+// BatchBech32ToHex is a free data retrieval call binding the contract method 0x818fa77b.
+//
+// Solidity: function batchBech32ToHex(string[] bech32s) view returns(address[])
+func (_BankModule *BankModuleCaller) BatchBech32ToHex(opts *bind.CallOpts, bech32s []string) ([]common.Address, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "batchBech32ToHex", bech32s)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// BatchBech32ToHex is a free data retrieval call binding the contract method 0x818fa77b.
+//
+// Solidity: function batchBech32ToHex(string[] bech32s) view returns(address[])
+func (_BankModule *BankModuleSession) BatchBech32ToHex(bech32s []string) ([]common.Address, error) {
+	return _BankModule.Contract.BatchBech32ToHex(&_BankModule.CallOpts, bech32s)
+}
+
+// BatchBech32ToHex is a free data retrieval call binding the contract method 0x818fa77b.
+//
+// Solidity: function batchBech32ToHex(string[] bech32s) view returns(address[])
+func (_BankModule *BankModuleCallerSession) BatchBech32ToHex(bech32s []string) ([]common.Address, error) {
+	return _BankModule.Contract.BatchBech32ToHex(&_BankModule.CallOpts, bech32s)
+}
+
+// BatchHexToBech32 is a free data retrieval call binding the contract method 0x5f5cb390.
+//
+// Solidity: function batchHexToBech32(address[] addrs) view returns(string[])
+func (_BankModule *BankModuleCaller) BatchHexToBech32(opts *bind.CallOpts, addrs []common.Address) ([]string, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "batchHexToBech32", addrs)
+
+	if err != nil {
+		return *new([]string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]string)).(*[]string)
+
+	return out0, err
+
+}
+
+// BatchHexToBech32 is a free data retrieval call binding the contract method 0x5f5cb390.
+//
+// Solidity: function batchHexToBech32(address[] addrs) view returns(string[])
+func (_BankModule *BankModuleSession) BatchHexToBech32(addrs []common.Address) ([]string, error) {
+	return _BankModule.Contract.BatchHexToBech32(&_BankModule.CallOpts, addrs)
+}
+
+// BatchHexToBech32 is a free data retrieval call binding the contract method 0x5f5cb390.
+//
+// Solidity: function batchHexToBech32(address[] addrs) view returns(string[])
+func (_BankModule *BankModuleCallerSession) BatchHexToBech32(addrs []common.Address) ([]string, error) {
+	return _BankModule.Contract.BatchHexToBech32(&_BankModule.CallOpts, addrs)
+}
+
+// CanCall is a free data retrieval call binding the contract method 0x86bed3e0.
+//
+// Solidity: function canCall(address caller, bytes4 methodSelector) view returns(bool)
+func (_BankModule *BankModuleCaller) CanCall(opts *bind.CallOpts, caller common.Address, methodSelector [4]byte) (bool, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "canCall", caller, methodSelector)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CanCall is a free data retrieval call binding the contract method 0x86bed3e0.
+//
+// Solidity: function canCall(address caller, bytes4 methodSelector) view returns(bool)
+func (_BankModule *BankModuleSession) CanCall(caller common.Address, methodSelector [4]byte) (bool, error) {
+	return _BankModule.Contract.CanCall(&_BankModule.CallOpts, caller, methodSelector)
+}
+
+// CanCall is a free data retrieval call binding the contract method 0x86bed3e0.
+//
+// Solidity: function canCall(address caller, bytes4 methodSelector) view returns(bool)
+func (_BankModule *BankModuleCallerSession) CanCall(caller common.Address, methodSelector [4]byte) (bool, error) {
+	return _BankModule.Contract.CanCall(&_BankModule.CallOpts, caller, methodSelector)
+}
+
+// GetAllBalances is a free data retrieval call binding the contract method 0x8afa690b.
+//
+// Solidity: function getAllBalances(address accountAddress, (string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleCaller) GetAllBalances(opts *bind.CallOpts, accountAddress common.Address, pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getAllBalances", accountAddress, pagination)
+
+	if err != nil {
+		return *new([]CosmosCoin), *new(CosmosPageResponse), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+	out1 := *abi.ConvertType(out[1], new(CosmosPageResponse)).(*CosmosPageResponse)
+
+	return out0, out1, err
+
+}
+
+// GetAllBalances is a free data retrieval call binding the contract method 0x8afa690b.
+//
+// Solidity: function getAllBalances(address accountAddress, (string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleSession) GetAllBalances(accountAddress common.Address, pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetAllBalances(&_BankModule.CallOpts, accountAddress, pagination)
+}
+
+// GetAllBalances is a free data retrieval call binding the contract method 0x8afa690b.
+//
+// Solidity: function getAllBalances(address accountAddress, (string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleCallerSession) GetAllBalances(accountAddress common.Address, pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetAllBalances(&_BankModule.CallOpts, accountAddress, pagination)
+}
+
+// GetAllBalances0 is a free data retrieval call binding the contract method 0xc53d6ce1.
 //
 // Solidity: function getAllBalances(address accountAddress) view returns((uint256,string)[])
-func (_BankModule *BankModuleCaller) GetAllBalances(opts *bind.CallOpts, accountAddress common.Address) ([]CosmosCoin, error) {
+func (_BankModule *BankModuleCaller) GetAllBalances0(opts *bind.CallOpts, accountAddress common.Address) ([]CosmosCoin, error) {
 	var out []interface{}
-	err := _BankModule.contract.Call(opts, &out, "getAllBalances", accountAddress)
+	err := _BankModule.contract.Call(opts, &out, "getAllBalances0", accountAddress)
 
 	if err != nil {
 		return *new([]CosmosCoin), err
@@ -220,18 +395,49 @@ func (_BankModule *BankModuleCaller) GetAllBalances(opts *bind.CallOpts, account
 
 }
 
-// GetAllBalances is a free data retrieval call binding the contract method 0xc53d6ce1.
+// GetAllBalances0 is a free data retrieval call binding the contract method 0xc53d6ce1.
 //
 // Solidity: function getAllBalances(address accountAddress) view returns((uint256,string)[])
-func (_BankModule *BankModuleSession) GetAllBalances(accountAddress common.Address) ([]CosmosCoin, error) {
-	return _BankModule.Contract.GetAllBalances(&_BankModule.CallOpts, accountAddress)
+func (_BankModule *BankModuleSession) GetAllBalances0(accountAddress common.Address) ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetAllBalances0(&_BankModule.CallOpts, accountAddress)
 }
 
-// GetAllBalances is a free data retrieval call binding the contract method 0xc53d6ce1.
+// GetAllBalances0 is a free data retrieval call binding the contract method 0xc53d6ce1.
 //
 // Solidity: function getAllBalances(address accountAddress) view returns((uint256,string)[])
-func (_BankModule *BankModuleCallerSession) GetAllBalances(accountAddress common.Address) ([]CosmosCoin, error) {
-	return _BankModule.Contract.GetAllBalances(&_BankModule.CallOpts, accountAddress)
+func (_BankModule *BankModuleCallerSession) GetAllBalances0(accountAddress common.Address) ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetAllBalances0(&_BankModule.CallOpts, accountAddress)
+}
+
+// GetAllLockedBalances is a free data retrieval call binding the contract method 0x98d15cdc.
+//
+// Solidity: function getAllLockedBalances(address account) view returns((uint256,string)[])
+func (_BankModule *BankModuleCaller) GetAllLockedBalances(opts *bind.CallOpts, account common.Address) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getAllLockedBalances", account)
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetAllLockedBalances is a free data retrieval call binding the contract method 0x98d15cdc.
+//
+// Solidity: function getAllLockedBalances(address account) view returns((uint256,string)[])
+func (_BankModule *BankModuleSession) GetAllLockedBalances(account common.Address) ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetAllLockedBalances(&_BankModule.CallOpts, account)
+}
+
+// GetAllLockedBalances is a free data retrieval call binding the contract method 0x98d15cdc.
+//
+// Solidity: function getAllLockedBalances(address account) view returns((uint256,string)[])
+func (_BankModule *BankModuleCallerSession) GetAllLockedBalances(account common.Address) ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetAllLockedBalances(&_BankModule.CallOpts, account)
 }
 
 // GetAllSpendableBalances is a free data retrieval call binding the contract method 0x5c70e594.
@@ -265,12 +471,76 @@ func (_BankModule *BankModuleCallerSession) GetAllSpendableBalances(accountAddre
 	return _BankModule.Contract.GetAllSpendableBalances(&_BankModule.CallOpts, accountAddress)
 }
 
-// GetAllSupply is a free data retrieval call binding the contract method 0xf01c9474.
+// GetAllSpendableBalances0 is a free data retrieval call binding the contract method 0xcf89cfe2.
+//
+// Solidity: function getAllSpendableBalances(address accountAddress, (string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleCaller) GetAllSpendableBalances0(opts *bind.CallOpts, accountAddress common.Address, pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getAllSpendableBalances0", accountAddress, pagination)
+
+	if err != nil {
+		return *new([]CosmosCoin), *new(CosmosPageResponse), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+	out1 := *abi.ConvertType(out[1], new(CosmosPageResponse)).(*CosmosPageResponse)
+
+	return out0, out1, err
+
+}
+
+// GetAllSpendableBalances0 is a free data retrieval call binding the contract method 0xcf89cfe2.
+//
+// Solidity: function getAllSpendableBalances(address accountAddress, (string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleSession) GetAllSpendableBalances0(accountAddress common.Address, pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetAllSpendableBalances0(&_BankModule.CallOpts, accountAddress, pagination)
+}
+
+// GetAllSpendableBalances0 is a free data retrieval call binding the contract method 0xcf89cfe2.
+//
+// Solidity: function getAllSpendableBalances(address accountAddress, (string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleCallerSession) GetAllSpendableBalances0(accountAddress common.Address, pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetAllSpendableBalances0(&_BankModule.CallOpts, accountAddress, pagination)
+}
+
+// GetAllSupply is a free data retrieval call binding the contract method 0x6e384736.
+//
+// Solidity: function getAllSupply((string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleCaller) GetAllSupply(opts *bind.CallOpts, pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getAllSupply", pagination)
+
+	if err != nil {
+		return *new([]CosmosCoin), *new(CosmosPageResponse), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+	out1 := *abi.ConvertType(out[1], new(CosmosPageResponse)).(*CosmosPageResponse)
+
+	return out0, out1, err
+
+}
+
+// GetAllSupply is a free data retrieval call binding the contract method 0x6e384736.
+//
+// Solidity: function getAllSupply((string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleSession) GetAllSupply(pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetAllSupply(&_BankModule.CallOpts, pagination)
+}
+
+// GetAllSupply is a free data retrieval call binding the contract method 0x6e384736.
+//
+// Solidity: function getAllSupply((string,uint64,uint64,bool,bool) pagination) view returns((uint256,string)[], (string,uint64))
+func (_BankModule *BankModuleCallerSession) GetAllSupply(pagination CosmosPageRequest) ([]CosmosCoin, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetAllSupply(&_BankModule.CallOpts, pagination)
+}
+
+// GetAllSupply0 is a free data retrieval call binding the contract method 0xf01c9474.
 //
 // Solidity: function getAllSupply() view returns((uint256,string)[])
-func (_BankModule *BankModuleCaller) GetAllSupply(opts *bind.CallOpts) ([]CosmosCoin, error) {
+func (_BankModule *BankModuleCaller) GetAllSupply0(opts *bind.CallOpts) ([]CosmosCoin, error) {
 	var out []interface{}
-	err := _BankModule.contract.Call(opts, &out, "getAllSupply")
+	err := _BankModule.contract.Call(opts, &out, "getAllSupply0")
 
 	if err != nil {
 		return *new([]CosmosCoin), err
@@ -282,18 +552,49 @@ func (_BankModule *BankModuleCaller) GetAllSupply(opts *bind.CallOpts) ([]Cosmos
 
 }
 
-// GetAllSupply is a free data retrieval call binding the contract method 0xf01c9474.
+// GetAllSupply0 is a free data retrieval call binding the contract method 0xf01c9474.
 //
 // Solidity: function getAllSupply() view returns((uint256,string)[])
-func (_BankModule *BankModuleSession) GetAllSupply() ([]CosmosCoin, error) {
-	return _BankModule.Contract.GetAllSupply(&_BankModule.CallOpts)
+func (_BankModule *BankModuleSession) GetAllSupply0() ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetAllSupply0(&_BankModule.CallOpts)
 }
 
-// GetAllSupply is a free data retrieval call binding the contract method 0xf01c9474.
+// GetAllSupply0 is a free data retrieval call binding the contract method 0xf01c9474.
 //
 // Solidity: function getAllSupply() view returns((uint256,string)[])
-func (_BankModule *BankModuleCallerSession) GetAllSupply() ([]CosmosCoin, error) {
-	return _BankModule.Contract.GetAllSupply(&_BankModule.CallOpts)
+func (_BankModule *BankModuleCallerSession) GetAllSupply0() ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetAllSupply0(&_BankModule.CallOpts)
+}
+
+// GetAllowances is a free data retrieval call binding the contract method 0x1ce9029d.
+//
+// Solidity: function getAllowances(address owner) view returns((address,(uint256,string)[],uint64)[])
+func (_BankModule *BankModuleCaller) GetAllowances(opts *bind.CallOpts, owner common.Address) ([]IBankModuleGrant, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getAllowances", owner)
+
+	if err != nil {
+		return *new([]IBankModuleGrant), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IBankModuleGrant)).(*[]IBankModuleGrant)
+
+	return out0, err
+
+}
+
+// GetAllowances is a free data retrieval call binding the contract method 0x1ce9029d.
+//
+// Solidity: function getAllowances(address owner) view returns((address,(uint256,string)[],uint64)[])
+func (_BankModule *BankModuleSession) GetAllowances(owner common.Address) ([]IBankModuleGrant, error) {
+	return _BankModule.Contract.GetAllowances(&_BankModule.CallOpts, owner)
+}
+
+// GetAllowances is a free data retrieval call binding the contract method 0x1ce9029d.
+//
+// Solidity: function getAllowances(address owner) view returns((address,(uint256,string)[],uint64)[])
+func (_BankModule *BankModuleCallerSession) GetAllowances(owner common.Address) ([]IBankModuleGrant, error) {
+	return _BankModule.Contract.GetAllowances(&_BankModule.CallOpts, owner)
 }
 
 // GetBalance is a free data retrieval call binding the contract method 0x1dd7cecf.
@@ -327,6 +628,68 @@ func (_BankModule *BankModuleCallerSession) GetBalance(accountAddress common.Add
 	return _BankModule.Contract.GetBalance(&_BankModule.CallOpts, accountAddress, denom)
 }
 
+// GetBalanceAtHeight is a free data retrieval call binding the contract method 0x50cc7200.
+//
+// Solidity: function getBalanceAtHeight(address accountAddress, string denom, uint64 height) view returns(uint256)
+func (_BankModule *BankModuleCaller) GetBalanceAtHeight(opts *bind.CallOpts, accountAddress common.Address, denom string, height uint64) (*big.Int, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getBalanceAtHeight", accountAddress, denom, height)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBalanceAtHeight is a free data retrieval call binding the contract method 0x50cc7200.
+//
+// Solidity: function getBalanceAtHeight(address accountAddress, string denom, uint64 height) view returns(uint256)
+func (_BankModule *BankModuleSession) GetBalanceAtHeight(accountAddress common.Address, denom string, height uint64) (*big.Int, error) {
+	return _BankModule.Contract.GetBalanceAtHeight(&_BankModule.CallOpts, accountAddress, denom, height)
+}
+
+// GetBalanceAtHeight is a free data retrieval call binding the contract method 0x50cc7200.
+//
+// Solidity: function getBalanceAtHeight(address accountAddress, string denom, uint64 height) view returns(uint256)
+func (_BankModule *BankModuleCallerSession) GetBalanceAtHeight(accountAddress common.Address, denom string, height uint64) (*big.Int, error) {
+	return _BankModule.Contract.GetBalanceAtHeight(&_BankModule.CallOpts, accountAddress, denom, height)
+}
+
+// GetBalances is a free data retrieval call binding the contract method 0xc16d8c08.
+//
+// Solidity: function getBalances((address,string)[] queries) view returns(uint256[])
+func (_BankModule *BankModuleCaller) GetBalances(opts *bind.CallOpts, queries []IBankModuleBalanceQuery) ([]*big.Int, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getBalances", queries)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetBalances is a free data retrieval call binding the contract method 0xc16d8c08.
+//
+// Solidity: function getBalances((address,string)[] queries) view returns(uint256[])
+func (_BankModule *BankModuleSession) GetBalances(queries []IBankModuleBalanceQuery) ([]*big.Int, error) {
+	return _BankModule.Contract.GetBalances(&_BankModule.CallOpts, queries)
+}
+
+// GetBalances is a free data retrieval call binding the contract method 0xc16d8c08.
+//
+// Solidity: function getBalances((address,string)[] queries) view returns(uint256[])
+func (_BankModule *BankModuleCallerSession) GetBalances(queries []IBankModuleBalanceQuery) ([]*big.Int, error) {
+	return _BankModule.Contract.GetBalances(&_BankModule.CallOpts, queries)
+}
+
 // GetDenomMetadata is a free data retrieval call binding the contract method 0x52a6ea04.
 //
 // Solidity: function getDenomMetadata(string denom) view returns((string,(string,string[],uint32)[],string,string,string,string))
@@ -358,6 +721,162 @@ func (_BankModule *BankModuleCallerSession) GetDenomMetadata(denom string) (IBan
 	return _BankModule.Contract.GetDenomMetadata(&_BankModule.CallOpts, denom)
 }
 
+// GetDenomsMetadata is a free data retrieval call binding the contract method 0x9e20c161.
+//
+// Solidity: function getDenomsMetadata((string,uint64,uint64,bool,bool) pagination) view returns((string,(string,string[],uint32)[],string,string,string,string)[], (string,uint64))
+func (_BankModule *BankModuleCaller) GetDenomsMetadata(opts *bind.CallOpts, pagination CosmosPageRequest) ([]IBankModuleDenomMetadata, CosmosPageResponse, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getDenomsMetadata", pagination)
+
+	if err != nil {
+		return *new([]IBankModuleDenomMetadata), *new(CosmosPageResponse), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IBankModuleDenomMetadata)).(*[]IBankModuleDenomMetadata)
+	out1 := *abi.ConvertType(out[1], new(CosmosPageResponse)).(*CosmosPageResponse)
+
+	return out0, out1, err
+
+}
+
+// GetDenomsMetadata is a free data retrieval call binding the contract method 0x9e20c161.
+//
+// Solidity: function getDenomsMetadata((string,uint64,uint64,bool,bool) pagination) view returns((string,(string,string[],uint32)[],string,string,string,string)[], (string,uint64))
+func (_BankModule *BankModuleSession) GetDenomsMetadata(pagination CosmosPageRequest) ([]IBankModuleDenomMetadata, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetDenomsMetadata(&_BankModule.CallOpts, pagination)
+}
+
+// GetDenomsMetadata is a free data retrieval call binding the contract method 0x9e20c161.
+//
+// Solidity: function getDenomsMetadata((string,uint64,uint64,bool,bool) pagination) view returns((string,(string,string[],uint32)[],string,string,string,string)[], (string,uint64))
+func (_BankModule *BankModuleCallerSession) GetDenomsMetadata(pagination CosmosPageRequest) ([]IBankModuleDenomMetadata, CosmosPageResponse, error) {
+	return _BankModule.Contract.GetDenomsMetadata(&_BankModule.CallOpts, pagination)
+}
+
+// GetEffectiveAllowance is a free data retrieval call binding the contract method 0x2500ab44.
+//
+// Solidity: function getEffectiveAllowance(address owner, address spender, string denom) view returns(uint256)
+func (_BankModule *BankModuleCaller) GetEffectiveAllowance(opts *bind.CallOpts, owner common.Address, spender common.Address, denom string) (*big.Int, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getEffectiveAllowance", owner, spender, denom)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEffectiveAllowance is a free data retrieval call binding the contract method 0x2500ab44.
+//
+// Solidity: function getEffectiveAllowance(address owner, address spender, string denom) view returns(uint256)
+func (_BankModule *BankModuleSession) GetEffectiveAllowance(owner common.Address, spender common.Address, denom string) (*big.Int, error) {
+	return _BankModule.Contract.GetEffectiveAllowance(&_BankModule.CallOpts, owner, spender, denom)
+}
+
+// GetEffectiveAllowance is a free data retrieval call binding the contract method 0x2500ab44.
+//
+// Solidity: function getEffectiveAllowance(address owner, address spender, string denom) view returns(uint256)
+func (_BankModule *BankModuleCallerSession) GetEffectiveAllowance(owner common.Address, spender common.Address, denom string) (*big.Int, error) {
+	return _BankModule.Contract.GetEffectiveAllowance(&_BankModule.CallOpts, owner, spender, denom)
+}
+
+// GetGrantsToSpender is a free data retrieval call binding the contract method 0xe59a77e8.
+//
+// Solidity: function getGrantsToSpender(address spender) view returns((address,(uint256,string)[],uint64)[])
+func (_BankModule *BankModuleCaller) GetGrantsToSpender(opts *bind.CallOpts, spender common.Address) ([]IBankModuleGrant, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getGrantsToSpender", spender)
+
+	if err != nil {
+		return *new([]IBankModuleGrant), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IBankModuleGrant)).(*[]IBankModuleGrant)
+
+	return out0, err
+
+}
+
+// GetGrantsToSpender is a free data retrieval call binding the contract method 0xe59a77e8.
+//
+// Solidity: function getGrantsToSpender(address spender) view returns((address,(uint256,string)[],uint64)[])
+func (_BankModule *BankModuleSession) GetGrantsToSpender(spender common.Address) ([]IBankModuleGrant, error) {
+	return _BankModule.Contract.GetGrantsToSpender(&_BankModule.CallOpts, spender)
+}
+
+// GetGrantsToSpender is a free data retrieval call binding the contract method 0xe59a77e8.
+//
+// Solidity: function getGrantsToSpender(address spender) view returns((address,(uint256,string)[],uint64)[])
+func (_BankModule *BankModuleCallerSession) GetGrantsToSpender(spender common.Address) ([]IBankModuleGrant, error) {
+	return _BankModule.Contract.GetGrantsToSpender(&_BankModule.CallOpts, spender)
+}
+
+// GetLockedCoins is a free data retrieval call binding the contract method 0x86dd3820.
+//
+// Solidity: function getLockedCoins(address account) view returns((uint256,string)[])
+func (_BankModule *BankModuleCaller) GetLockedCoins(opts *bind.CallOpts, account common.Address) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getLockedCoins", account)
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetLockedCoins is a free data retrieval call binding the contract method 0x86dd3820.
+//
+// Solidity: function getLockedCoins(address account) view returns((uint256,string)[])
+func (_BankModule *BankModuleSession) GetLockedCoins(account common.Address) ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetLockedCoins(&_BankModule.CallOpts, account)
+}
+
+// GetLockedCoins is a free data retrieval call binding the contract method 0x86dd3820.
+//
+// Solidity: function getLockedCoins(address account) view returns((uint256,string)[])
+func (_BankModule *BankModuleCallerSession) GetLockedCoins(account common.Address) ([]CosmosCoin, error) {
+	return _BankModule.Contract.GetLockedCoins(&_BankModule.CallOpts, account)
+}
+
+// GetSendAllowances is a free data retrieval call binding the contract method 0x84ea87eb.
+//
+// Solidity: function getSendAllowances(address owner, address spender) view returns(((uint256,string)[],uint64,address[])[])
+func (_BankModule *BankModuleCaller) GetSendAllowances(opts *bind.CallOpts, owner common.Address, spender common.Address) ([]IBankModuleSendAllowance, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getSendAllowances", owner, spender)
+
+	if err != nil {
+		return *new([]IBankModuleSendAllowance), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IBankModuleSendAllowance)).(*[]IBankModuleSendAllowance)
+
+	return out0, err
+
+}
+
+// GetSendAllowances is a free data retrieval call binding the contract method 0x84ea87eb.
+//
+// Solidity: function getSendAllowances(address owner, address spender) view returns(((uint256,string)[],uint64,address[])[])
+func (_BankModule *BankModuleSession) GetSendAllowances(owner common.Address, spender common.Address) ([]IBankModuleSendAllowance, error) {
+	return _BankModule.Contract.GetSendAllowances(&_BankModule.CallOpts, owner, spender)
+}
+
+// GetSendAllowances is a free data retrieval call binding the contract method 0x84ea87eb.
+//
+// Solidity: function getSendAllowances(address owner, address spender) view returns(((uint256,string)[],uint64,address[])[])
+func (_BankModule *BankModuleCallerSession) GetSendAllowances(owner common.Address, spender common.Address) ([]IBankModuleSendAllowance, error) {
+	return _BankModule.Contract.GetSendAllowances(&_BankModule.CallOpts, owner, spender)
+}
+
 // GetSendEnabled is a free data retrieval call binding the contract method 0x94047166.
 //
 // Solidity: function getSendEnabled(string denom) view returns(bool)
@@ -420,6 +939,37 @@ func (_BankModule *BankModuleCallerSession) GetSpendableBalance(accountAddress c
 	return _BankModule.Contract.GetSpendableBalance(&_BankModule.CallOpts, accountAddress, denom)
 }
 
+// GetSpendableBalances is a free data retrieval call binding the contract method 0x68d602f6.
+//
+// Solidity: function getSpendableBalances(address accountAddress, string[] denoms) view returns(uint256[])
+func (_BankModule *BankModuleCaller) GetSpendableBalances(opts *bind.CallOpts, accountAddress common.Address, denoms []string) ([]*big.Int, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getSpendableBalances", accountAddress, denoms)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetSpendableBalances is a free data retrieval call binding the contract method 0x68d602f6.
+//
+// Solidity: function getSpendableBalances(address accountAddress, string[] denoms) view returns(uint256[])
+func (_BankModule *BankModuleSession) GetSpendableBalances(accountAddress common.Address, denoms []string) ([]*big.Int, error) {
+	return _BankModule.Contract.GetSpendableBalances(&_BankModule.CallOpts, accountAddress, denoms)
+}
+
+// GetSpendableBalances is a free data retrieval call binding the contract method 0x68d602f6.
+//
+// Solidity: function getSpendableBalances(address accountAddress, string[] denoms) view returns(uint256[])
+func (_BankModule *BankModuleCallerSession) GetSpendableBalances(accountAddress common.Address, denoms []string) ([]*big.Int, error) {
+	return _BankModule.Contract.GetSpendableBalances(&_BankModule.CallOpts, accountAddress, denoms)
+}
+
 // GetSupply is a free data retrieval call binding the contract method 0xfe3b2b88.
 //
 // Solidity: function getSupply(string denom) view returns(uint256)
@@ -431,24 +981,180 @@ func (_BankModule *BankModuleCaller) GetSupply(opts *bind.CallOpts, denom string
 		return *new(*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetSupply is a free data retrieval call binding the contract method 0xfe3b2b88.
+//
+// Solidity: function getSupply(string denom) view returns(uint256)
+func (_BankModule *BankModuleSession) GetSupply(denom string) (*big.Int, error) {
+	return _BankModule.Contract.GetSupply(&_BankModule.CallOpts, denom)
+}
+
+// GetSupply is a free data retrieval call binding the contract method 0xfe3b2b88.
+//
+// Solidity: function getSupply(string denom) view returns(uint256)
+func (_BankModule *BankModuleCallerSession) GetSupply(denom string) (*big.Int, error) {
+	return _BankModule.Contract.GetSupply(&_BankModule.CallOpts, denom)
+}
+
+// GetSupplyAt is a free data retrieval call binding the contract method 0xfdedaf11.
+//
+// Solidity: function getSupplyAt(string denom, int64 height) view returns(uint256)
+func (_BankModule *BankModuleCaller) GetSupplyAt(opts *bind.CallOpts, denom string, height int64) (*big.Int, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getSupplyAt", denom, height)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetSupplyAt is a free data retrieval call binding the contract method 0xfdedaf11.
+//
+// Solidity: function getSupplyAt(string denom, int64 height) view returns(uint256)
+func (_BankModule *BankModuleSession) GetSupplyAt(denom string, height int64) (*big.Int, error) {
+	return _BankModule.Contract.GetSupplyAt(&_BankModule.CallOpts, denom, height)
+}
+
+// GetSupplyAt is a free data retrieval call binding the contract method 0xfdedaf11.
+//
+// Solidity: function getSupplyAt(string denom, int64 height) view returns(uint256)
+func (_BankModule *BankModuleCallerSession) GetSupplyAt(denom string, height int64) (*big.Int, error) {
+	return _BankModule.Contract.GetSupplyAt(&_BankModule.CallOpts, denom, height)
+}
+
+// GetVestingSchedule is a free data retrieval call binding the contract method 0x9f829063.
+//
+// Solidity: function getVestingSchedule(address account) view returns((uint64,uint64,(uint256,string)[],(uint256,string)[],(uint64,(uint256,string)[])[]))
+func (_BankModule *BankModuleCaller) GetVestingSchedule(opts *bind.CallOpts, account common.Address) (IBankModuleVestingSchedule, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "getVestingSchedule", account)
+
+	if err != nil {
+		return *new(IBankModuleVestingSchedule), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IBankModuleVestingSchedule)).(*IBankModuleVestingSchedule)
+
+	return out0, err
+
+}
+
+// GetVestingSchedule is a free data retrieval call binding the contract method 0x9f829063.
+//
+// Solidity: function getVestingSchedule(address account) view returns((uint64,uint64,(uint256,string)[],(uint256,string)[],(uint64,(uint256,string)[])[]))
+func (_BankModule *BankModuleSession) GetVestingSchedule(account common.Address) (IBankModuleVestingSchedule, error) {
+	return _BankModule.Contract.GetVestingSchedule(&_BankModule.CallOpts, account)
+}
+
+// GetVestingSchedule is a free data retrieval call binding the contract method 0x9f829063.
+//
+// Solidity: function getVestingSchedule(address account) view returns((uint64,uint64,(uint256,string)[],(uint256,string)[],(uint64,(uint256,string)[])[]))
+func (_BankModule *BankModuleCallerSession) GetVestingSchedule(account common.Address) (IBankModuleVestingSchedule, error) {
+	return _BankModule.Contract.GetVestingSchedule(&_BankModule.CallOpts, account)
+}
+
+// IsVestingAccount is a free data retrieval call binding the contract method 0x65dcacd8.
+//
+// Solidity: function isVestingAccount(address account) view returns(bool)
+func (_BankModule *BankModuleCaller) IsVestingAccount(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "isVestingAccount", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsVestingAccount is a free data retrieval call binding the contract method 0x65dcacd8.
+//
+// Solidity: function isVestingAccount(address account) view returns(bool)
+func (_BankModule *BankModuleSession) IsVestingAccount(account common.Address) (bool, error) {
+	return _BankModule.Contract.IsVestingAccount(&_BankModule.CallOpts, account)
+}
+
+// IsVestingAccount is a free data retrieval call binding the contract method 0x65dcacd8.
+//
+// Solidity: function isVestingAccount(address account) view returns(bool)
+func (_BankModule *BankModuleCallerSession) IsVestingAccount(account common.Address) (bool, error) {
+	return _BankModule.Contract.IsVestingAccount(&_BankModule.CallOpts, account)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x9ea3ddc0.
+//
+// Solidity: function burn((uint256,string)[] amount) returns(bool)
+func (_BankModule *BankModuleTransactor) Burn(opts *bind.TransactOpts, amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.contract.Transact(opts, "burn", amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x9ea3ddc0.
+//
+// Solidity: function burn((uint256,string)[] amount) returns(bool)
+func (_BankModule *BankModuleSession) Burn(amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.Contract.Burn(&_BankModule.TransactOpts, amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x9ea3ddc0.
+//
+// Solidity: function burn((uint256,string)[] amount) returns(bool)
+func (_BankModule *BankModuleTransactorSession) Burn(amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.Contract.Burn(&_BankModule.TransactOpts, amount)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0x9e32346f.
+//
+// Solidity: function decreaseAllowance(address spender, (uint256,string)[] amount) returns(bool)
+func (_BankModule *BankModuleTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.contract.Transact(opts, "decreaseAllowance", spender, amount)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0x9e32346f.
+//
+// Solidity: function decreaseAllowance(address spender, (uint256,string)[] amount) returns(bool)
+func (_BankModule *BankModuleSession) DecreaseAllowance(spender common.Address, amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.Contract.DecreaseAllowance(&_BankModule.TransactOpts, spender, amount)
+}
 
-	return out0, err
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0x9e32346f.
+//
+// Solidity: function decreaseAllowance(address spender, (uint256,string)[] amount) returns(bool)
+func (_BankModule *BankModuleTransactorSession) DecreaseAllowance(spender common.Address, amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.Contract.DecreaseAllowance(&_BankModule.TransactOpts, spender, amount)
+}
 
+// Revoke is a paid mutator transaction binding the contract method 0x74a8f103.
+//
+// Solidity: function revoke(address spender) returns(bool)
+func (_BankModule *BankModuleTransactor) Revoke(opts *bind.TransactOpts, spender common.Address) (*types.Transaction, error) {
+	return _BankModule.contract.Transact(opts, "revoke", spender)
 }
 
-// GetSupply is a free data retrieval call binding the contract method 0xfe3b2b88.
+// Revoke is a paid mutator transaction binding the contract method 0x74a8f103.
 //
-// Solidity: function getSupply(string denom) view returns(uint256)
-func (_BankModule *BankModuleSession) GetSupply(denom string) (*big.Int, error) {
-	return _BankModule.Contract.GetSupply(&_BankModule.CallOpts, denom)
+// Solidity: function revoke(address spender) returns(bool)
+func (_BankModule *BankModuleSession) Revoke(spender common.Address) (*types.Transaction, error) {
+	return _BankModule.Contract.Revoke(&_BankModule.TransactOpts, spender)
 }
 
-// GetSupply is a free data retrieval call binding the contract method 0xfe3b2b88.
+// Revoke is a paid mutator transaction binding the contract method 0x74a8f103.
 //
-// Solidity: function getSupply(string denom) view returns(uint256)
-func (_BankModule *BankModuleCallerSession) GetSupply(denom string) (*big.Int, error) {
-	return _BankModule.Contract.GetSupply(&_BankModule.CallOpts, denom)
+// Solidity: function revoke(address spender) returns(bool)
+func (_BankModule *BankModuleTransactorSession) Revoke(spender common.Address) (*types.Transaction, error) {
+	return _BankModule.Contract.Revoke(&_BankModule.TransactOpts, spender)
 }
 
 // Send is a paid mutator transaction binding the contract method 0x7e075f07.
@@ -472,6 +1178,182 @@ func (_BankModule *BankModuleTransactorSession) Send(toAddress common.Address, a
 	return _BankModule.Contract.Send(&_BankModule.TransactOpts, toAddress, amount)
 }
 
+// Send0 is a paid mutator transaction binding the contract method 0x9d2e26fe.
+//
+// Solidity: function send(string toAddress, (uint256,string)[] amount) payable returns(bool)
+func (_BankModule *BankModuleTransactor) Send0(opts *bind.TransactOpts, toAddress string, amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.contract.Transact(opts, "send0", toAddress, amount)
+}
+
+// Send0 is a paid mutator transaction binding the contract method 0x9d2e26fe.
+//
+// Solidity: function send(string toAddress, (uint256,string)[] amount) payable returns(bool)
+func (_BankModule *BankModuleSession) Send0(toAddress string, amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.Contract.Send0(&_BankModule.TransactOpts, toAddress, amount)
+}
+
+// Send0 is a paid mutator transaction binding the contract method 0x9d2e26fe.
+//
+// Solidity: function send(string toAddress, (uint256,string)[] amount) payable returns(bool)
+func (_BankModule *BankModuleTransactorSession) Send0(toAddress string, amount []CosmosCoin) (*types.Transaction, error) {
+	return _BankModule.Contract.Send0(&_BankModule.TransactOpts, toAddress, amount)
+}
+
+// BankModuleApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the BankModule contract.
+type BankModuleApprovalIterator struct {
+	Event *BankModuleApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BankModuleApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BankModuleApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BankModuleApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BankModuleApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BankModuleApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BankModuleApproval represents a Approval event raised by the BankModule contract.
+type BankModuleApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Denom   string
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0xfa07013316165a7c424e6be19a166ba4784477c405b341b4bb535985391e7baa.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value, string denom)
+func (_BankModule *BankModuleFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*BankModuleApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _BankModule.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BankModuleApprovalIterator{contract: _BankModule.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0xfa07013316165a7c424e6be19a166ba4784477c405b341b4bb535985391e7baa.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value, string denom)
+func (_BankModule *BankModuleFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *BankModuleApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _BankModule.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BankModuleApproval)
+				if err := _BankModule.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0xfa07013316165a7c424e6be19a166ba4784477c405b341b4bb535985391e7baa.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value, string denom)
+func (_BankModule *BankModuleFilterer) ParseApproval(log types.Log) (*BankModuleApproval, error) {
+	event := new(BankModuleApproval)
+	if err := _BankModule.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // BankModuleBurnIterator is returned from FilterBurn and is used to iterate over the raw logs and unpacked data for Burn events raised by the BankModule contract.
 type BankModuleBurnIterator struct {
 	Event *BankModuleBurn // Event containing the contract specifics and raw log
@@ -1340,3 +2222,158 @@ func (_BankModule *BankModuleFilterer) ParseTransfer(log types.Log) (*BankModule
 	event.Raw = log
 	return event, nil
 }
+
+// BankModuleTransfer0Iterator is returned from FilterTransfer0 and is used to iterate over the raw logs and unpacked data for Transfer0 events raised by the BankModule contract.
+type BankModuleTransfer0Iterator struct {
+	Event *BankModuleTransfer0 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BankModuleTransfer0Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BankModuleTransfer0)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BankModuleTransfer0)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BankModuleTransfer0Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BankModuleTransfer0Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BankModuleTransfer0 represents a Transfer0 event raised by the BankModule contract.
+type BankModuleTransfer0 struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Denom string
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer0 is a free log retrieval operation binding the contract event 0xcd6e659e4c2e75c3bfe47fecaccf39aeb368116a0ee52afb532e07f6cba6c0d1.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value, string denom)
+func (_BankModule *BankModuleFilterer) FilterTransfer0(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*BankModuleTransfer0Iterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _BankModule.contract.FilterLogs(opts, "Transfer0", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BankModuleTransfer0Iterator{contract: _BankModule.contract, event: "Transfer0", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer0 is a free log subscription operation binding the contract event 0xcd6e659e4c2e75c3bfe47fecaccf39aeb368116a0ee52afb532e07f6cba6c0d1.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value, string denom)
+func (_BankModule *BankModuleFilterer) WatchTransfer0(opts *bind.WatchOpts, sink chan<- *BankModuleTransfer0, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _BankModule.contract.WatchLogs(opts, "Transfer0", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BankModuleTransfer0)
+				if err := _BankModule.contract.UnpackLog(event, "Transfer0", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer0 is a log parse operation binding the contract event 0xcd6e659e4c2e75c3bfe47fecaccf39aeb368116a0ee52afb532e07f6cba6c0d1.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value, string denom)
+func (_BankModule *BankModuleFilterer) ParseTransfer0(log types.Log) (*BankModuleTransfer0, error) {
+	event := new(BankModuleTransfer0)
+	if err := _BankModule.contract.UnpackLog(event, "Transfer0", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
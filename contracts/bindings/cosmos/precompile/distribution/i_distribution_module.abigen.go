@@ -43,7 +43,7 @@ type IDistributionModuleValidatorReward struct {
 
 // DistributionModuleMetaData contains all meta data concerning the DistributionModule contract.
 var DistributionModuleMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"SetWithdrawAddress\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawRewards\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"}],\"name\":\"getAllDelegatorRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"rewards\",\"type\":\"tuple[]\"}],\"internalType\":\"structIDistributionModule.ValidatorReward[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"}],\"name\":\"getTotalDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getWithdrawEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"withdrawDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"SetWithdrawAddress\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawRewards\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"fundCommunityPool\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"}],\"name\":\"getAllDelegatorRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"rewards\",\"type\":\"tuple[]\"}],\"internalType\":\"structIDistributionModule.ValidatorReward[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getDelegatorRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"}],\"name\":\"getTotalDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getValidatorCommission\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getWithdrawEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"withdrawDelegatorReward\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawDelegatorRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // DistributionModuleABI is the input ABI used to generate the binding from.
@@ -223,6 +223,37 @@ func (_DistributionModule *DistributionModuleCallerSession) GetAllDelegatorRewar
 	return _DistributionModule.Contract.GetAllDelegatorRewards(&_DistributionModule.CallOpts, delegator)
 }
 
+// GetDelegatorRewards is a free data retrieval call binding the contract method 0x6b979846.
+//
+// Solidity: function getDelegatorRewards(address delegator, address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCaller) GetDelegatorRewards(opts *bind.CallOpts, delegator common.Address, validator common.Address) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _DistributionModule.contract.Call(opts, &out, "getDelegatorRewards", delegator, validator)
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetDelegatorRewards is a free data retrieval call binding the contract method 0x6b979846.
+//
+// Solidity: function getDelegatorRewards(address delegator, address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) GetDelegatorRewards(delegator common.Address, validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetDelegatorRewards(&_DistributionModule.CallOpts, delegator, validator)
+}
+
+// GetDelegatorRewards is a free data retrieval call binding the contract method 0x6b979846.
+//
+// Solidity: function getDelegatorRewards(address delegator, address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCallerSession) GetDelegatorRewards(delegator common.Address, validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetDelegatorRewards(&_DistributionModule.CallOpts, delegator, validator)
+}
+
 // GetTotalDelegatorReward is a free data retrieval call binding the contract method 0xce3341b4.
 //
 // Solidity: function getTotalDelegatorReward(address delegator) view returns((uint256,string)[])
@@ -254,6 +285,37 @@ func (_DistributionModule *DistributionModuleCallerSession) GetTotalDelegatorRew
 	return _DistributionModule.Contract.GetTotalDelegatorReward(&_DistributionModule.CallOpts, delegator)
 }
 
+// GetValidatorCommission is a free data retrieval call binding the contract method 0x6ec01b27.
+//
+// Solidity: function getValidatorCommission(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCaller) GetValidatorCommission(opts *bind.CallOpts, validator common.Address) ([]CosmosCoin, error) {
+	var out []interface{}
+	err := _DistributionModule.contract.Call(opts, &out, "getValidatorCommission", validator)
+
+	if err != nil {
+		return *new([]CosmosCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]CosmosCoin)).(*[]CosmosCoin)
+
+	return out0, err
+
+}
+
+// GetValidatorCommission is a free data retrieval call binding the contract method 0x6ec01b27.
+//
+// Solidity: function getValidatorCommission(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) GetValidatorCommission(validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetValidatorCommission(&_DistributionModule.CallOpts, validator)
+}
+
+// GetValidatorCommission is a free data retrieval call binding the contract method 0x6ec01b27.
+//
+// Solidity: function getValidatorCommission(address validator) view returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleCallerSession) GetValidatorCommission(validator common.Address) ([]CosmosCoin, error) {
+	return _DistributionModule.Contract.GetValidatorCommission(&_DistributionModule.CallOpts, validator)
+}
+
 // GetWithdrawEnabled is a free data retrieval call binding the contract method 0x39cc4c86.
 //
 // Solidity: function getWithdrawEnabled() view returns(bool)
@@ -285,6 +347,27 @@ func (_DistributionModule *DistributionModuleCallerSession) GetWithdrawEnabled()
 	return _DistributionModule.Contract.GetWithdrawEnabled(&_DistributionModule.CallOpts)
 }
 
+// FundCommunityPool is a paid mutator transaction binding the contract method 0x49f13049.
+//
+// Solidity: function fundCommunityPool((uint256,string)[] amount) returns(bool)
+func (_DistributionModule *DistributionModuleTransactor) FundCommunityPool(opts *bind.TransactOpts, amount []CosmosCoin) (*types.Transaction, error) {
+	return _DistributionModule.contract.Transact(opts, "fundCommunityPool", amount)
+}
+
+// FundCommunityPool is a paid mutator transaction binding the contract method 0x49f13049.
+//
+// Solidity: function fundCommunityPool((uint256,string)[] amount) returns(bool)
+func (_DistributionModule *DistributionModuleSession) FundCommunityPool(amount []CosmosCoin) (*types.Transaction, error) {
+	return _DistributionModule.Contract.FundCommunityPool(&_DistributionModule.TransactOpts, amount)
+}
+
+// FundCommunityPool is a paid mutator transaction binding the contract method 0x49f13049.
+//
+// Solidity: function fundCommunityPool((uint256,string)[] amount) returns(bool)
+func (_DistributionModule *DistributionModuleTransactorSession) FundCommunityPool(amount []CosmosCoin) (*types.Transaction, error) {
+	return _DistributionModule.Contract.FundCommunityPool(&_DistributionModule.TransactOpts, amount)
+}
+
 // SetWithdrawAddress is a paid mutator transaction binding the contract method 0x3ab1a494.
 //
 // Solidity: function setWithdrawAddress(address withdrawAddress) returns(bool)
@@ -327,6 +410,27 @@ func (_DistributionModule *DistributionModuleTransactorSession) WithdrawDelegato
 	return _DistributionModule.Contract.WithdrawDelegatorReward(&_DistributionModule.TransactOpts, delegator, validator)
 }
 
+// WithdrawDelegatorRewards is a paid mutator transaction binding the contract method 0xb880660b.
+//
+// Solidity: function withdrawDelegatorRewards() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleTransactor) WithdrawDelegatorRewards(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DistributionModule.contract.Transact(opts, "withdrawDelegatorRewards")
+}
+
+// WithdrawDelegatorRewards is a paid mutator transaction binding the contract method 0xb880660b.
+//
+// Solidity: function withdrawDelegatorRewards() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleSession) WithdrawDelegatorRewards() (*types.Transaction, error) {
+	return _DistributionModule.Contract.WithdrawDelegatorRewards(&_DistributionModule.TransactOpts)
+}
+
+// WithdrawDelegatorRewards is a paid mutator transaction binding the contract method 0xb880660b.
+//
+// Solidity: function withdrawDelegatorRewards() returns((uint256,string)[])
+func (_DistributionModule *DistributionModuleTransactorSession) WithdrawDelegatorRewards() (*types.Transaction, error) {
+	return _DistributionModule.Contract.WithdrawDelegatorRewards(&_DistributionModule.TransactOpts)
+}
+
 // DistributionModuleSetWithdrawAddressIterator is returned from FilterSetWithdrawAddress and is used to iterate over the raw logs and unpacked data for SetWithdrawAddress events raised by the DistributionModule contract.
 type DistributionModuleSetWithdrawAddressIterator struct {
 	Event *DistributionModuleSetWithdrawAddress // Event containing the contract specifics and raw log
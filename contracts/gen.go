@@ -26,9 +26,22 @@
 package contracts
 
 //go:generate abigen --pkg staking --abi ./out/Staking.sol/IStakingModule.abi.json --bin ./out/Staking.sol/IStakingModule.bin --out ./bindings/cosmos/precompile/staking/i_staking_module.abigen.go --type StakingModule
+//go:generate abigen --pkg bech32 --abi ./out/Bech32.sol/IBech32.abi.json --bin ./out/Bech32.sol/IBech32.bin --out ./bindings/cosmos/precompile/bech32/i_bech32.abigen.go --type Bech32
 //go:generate abigen --pkg bank --abi ./out/Bank.sol/IBankModule.abi.json --bin ./out/Bank.sol/IBankModule.bin --out ./bindings/cosmos/precompile/bank/i_bank_module.abigen.go --type BankModule
+//go:generate abigen --pkg consensus --abi ./out/Consensus.sol/IConsensusModule.abi.json --bin ./out/Consensus.sol/IConsensusModule.bin --out ./bindings/cosmos/precompile/consensus/i_consensus_module.abigen.go --type ConsensusModule
 //go:generate abigen --pkg distribution --abi ./out/Distribution.sol/IDistributionModule.abi.json --bin ./out/Distribution.sol/IDistributionModule.bin --out ./bindings/cosmos/precompile/distribution/i_distribution_module.abigen.go --type DistributionModule --exc "IBankModuleCoin"
+//go:generate abigen --pkg auth --abi ./out/Auth.sol/IAuthModule.abi.json --bin ./out/Auth.sol/IAuthModule.bin --out ./bindings/cosmos/precompile/auth/i_auth_module.abigen.go --type AuthModule
+//go:generate abigen --pkg authz --abi ./out/Authz.sol/IAuthzModule.abi.json --bin ./out/Authz.sol/IAuthzModule.bin --out ./bindings/cosmos/precompile/authz/i_authz_module.abigen.go --type AuthzModule
+//go:generate abigen --pkg mint --abi ./out/Mint.sol/IMintModule.abi.json --bin ./out/Mint.sol/IMintModule.bin --out ./bindings/cosmos/precompile/mint/i_mint_module.abigen.go --type MintModule
+//go:generate abigen --pkg group --abi ./out/Group.sol/IGroupModule.abi.json --bin ./out/Group.sol/IGroupModule.bin --out ./bindings/cosmos/precompile/group/i_group_module.abigen.go --type GroupModule
+//go:generate abigen --pkg ed25519 --abi ./out/Ed25519.sol/IEd25519.abi.json --bin ./out/Ed25519.sol/IEd25519.bin --out ./bindings/cosmos/precompile/ed25519/i_ed25519.abigen.go --type Ed25519
+//go:generate abigen --pkg grpcquery --abi ./out/GRPCQuery.sol/IGRPCQuery.abi.json --bin ./out/GRPCQuery.sol/IGRPCQuery.bin --out ./bindings/cosmos/precompile/grpcquery/i_grpc_query.abigen.go --type GRPCQuery
 //go:generate abigen --pkg governance --abi ./out/Governance.sol/IGovernanceModule.abi.json --bin ./out/Governance.sol/IGovernanceModule.bin --out ./bindings/cosmos/precompile/governance/i_governance_module.abigen.go --type GovernanceModule
+//go:generate abigen --pkg msgexecutor --abi ./out/MsgExecutor.sol/IMsgExecutor.abi.json --bin ./out/MsgExecutor.sol/IMsgExecutor.bin --out ./bindings/cosmos/precompile/msgexecutor/i_msg_executor.abigen.go --type MsgExecutor
+//go:generate abigen --pkg multicall --abi ./out/Multicall.sol/IMulticall.abi.json --bin ./out/Multicall.sol/IMulticall.bin --out ./bindings/cosmos/precompile/multicall/i_multicall.abigen.go --type Multicall
+//go:generate abigen --pkg randomness --abi ./out/Randomness.sol/IRandomness.abi.json --bin ./out/Randomness.sol/IRandomness.bin --out ./bindings/cosmos/precompile/randomness/i_randomness.abigen.go --type Randomness
+//go:generate abigen --pkg registry --abi ./out/PrecompileRegistry.sol/IPrecompileRegistry.abi.json --bin ./out/PrecompileRegistry.sol/IPrecompileRegistry.bin --out ./bindings/cosmos/precompile/registry/i_precompile_registry.abigen.go --type PrecompileRegistry
+//go:generate abigen --pkg upgrade --abi ./out/Upgrade.sol/IUpgradeModule.abi.json --bin ./out/Upgrade.sol/IUpgradeModule.bin --out ./bindings/cosmos/precompile/upgrade/i_upgrade_module.abigen.go --type UpgradeModule
 //go:generate abigen --pkg lib --abi ./out/CosmosTypes.sol/CosmosTypes.abi.json --bin ./out/CosmosTypes.sol/CosmosTypes.bin --out ./bindings/cosmos/lib/cosmos_types.abigen.go --type CosmosTypes
 //go:generate abigen --pkg testing --abi ./out/SolmateERC20.sol/SolmateERC20.abi.json --bin ./out/SolmateERC20.sol/SolmateERC20.bin --out ./bindings/testing/solmate_erc20.abigen.go --type SolmateERC20
 //go:generate abigen --pkg testing --abi ./out/MockPrecompileInterface.sol/MockPrecompileInterface.abi.json --out ./bindings/testing/mock_precompile_interface.abigen.go --type MockPrecompile
@@ -37,6 +37,10 @@ library Cosmos {
         string denom;
     }
 
+    /**
+     * @dev Represents a cosmos pagination request. The `key` is the `nextKey` of a previous
+     * `PageResponse`, which is base64 encoded; hex keys prefixed with "0x" are also accepted.
+     */
     struct PageRequest {
         string key;
         uint64 offset;
@@ -45,6 +49,10 @@ library Cosmos {
         bool reverse;
     }
 
+    /**
+     * @dev Represents a cosmos pagination response. The `nextKey` is base64 encoded and is empty
+     * on the last page.
+     */
     struct PageResponse {
         string nextKey;
         uint64 total;
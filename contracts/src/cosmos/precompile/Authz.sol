@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+import {Cosmos} from "../CosmosTypes.sol";
+
+/**
+ * @dev Interface of the authz module's precompiled contract
+ */
+interface IAuthzModule {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Thrown by `execute` when the message at `index` has a type URL that is not allowed.
+     */
+    error MsgNotAllowed(uint256 index, string msgTypeUrl);
+
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Returns a page of the grants given by `granter` to `grantee`.
+     */
+    function getGrants(address granter, address grantee, Cosmos.PageRequest calldata pagination)
+        external
+        view
+        returns (Grant[] memory, Cosmos.PageResponse memory);
+
+    /**
+     * @dev Returns whether `execute` can dispatch messages with the given type URL.
+     */
+    function isMsgAllowed(string calldata msgTypeUrl) external view returns (bool);
+
+    ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Grants `grantee` a generic authorization to execute messages with the given type URL
+     * on behalf of msg.sender, until the unix timestamp `expiry` (0 for no expiration).
+     */
+    function grant(address grantee, string calldata msgTypeUrl, uint64 expiry)
+        external
+        returns (bool);
+
+    /**
+     * @dev Revokes the authorization given by msg.sender to `grantee` for the given type URL.
+     */
+    function revoke(address grantee, string calldata msgTypeUrl) external returns (bool);
+
+    /**
+     * @dev Executes the given messages with msg.sender as the grantee, where each message is a
+     * protobuf encoded `google.protobuf.Any`. Messages signed by msg.sender are executed directly,
+     * the others need an authorization from their signer. Reverts with `MsgNotAllowed` if a
+     * message type is not allowed by the precompile. Returns the result of each message.
+     */
+    function execute(bytes[] calldata msgs) external returns (bytes[] memory);
+
+    /**
+     * @dev Represents an authorization given by a granter to a grantee, where `authorization` is
+     * the type URL of the authorization and `expiration` is a unix timestamp (0 if none).
+     * Note: this struct is generated in generated/i_authz_module.abigen.go
+     */
+    struct Grant {
+        string authorization;
+        string msgTypeUrl;
+        uint64 expiration;
+    }
+}
@@ -68,6 +68,39 @@ interface IBankModule {
      */
     event Burn(address indexed burner, Cosmos.Coin[] amount);
 
+    /**
+     * @dev Emitted by `send` once per denomination sent, in the shape of the ERC20 `Transfer`
+     * event so that indexers and wallets can track native coin movements.
+     *
+     * Note: unlike the events above, this is not translated from a Cosmos event.
+     */
+    event Transfer(address indexed from, address indexed to, uint256 value, string denom);
+
+    /**
+     * @dev Emitted by `decreaseAllowance` and `revoke` once per denomination of the send grant
+     * given by `owner` to `spender`, where `value` is the new spend limit.
+     *
+     * Note: unlike the events above, this is not translated from a Cosmos event.
+     */
+    event Approval(address indexed owner, address indexed spender, uint256 value, string denom);
+
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Thrown when the given coins are invalid, e.g. when all of their amounts are zero.
+     */
+    error InvalidCoins();
+
+    /**
+     * @dev Thrown when sending `denom` is disabled.
+     */
+    error SendDisabled(string denom);
+
+    /**
+     * @dev Thrown when the spendable balance of `denom` is lower than the `required` amount.
+     */
+    error InsufficientSpendableBalance(string denom, uint256 spendable, uint256 required);
+
     /////////////////////////////////////// READ METHODS //////////////////////////////////////////
 
     /**
@@ -75,41 +108,172 @@ interface IBankModule {
      */
     function getBalance(address accountAddress, string calldata denom) external view returns (uint256);
 
+    /**
+     * @dev Returns the `amount` of account balance for each of the given (account, denomination)
+     * pairs, in order.
+     */
+    function getBalances(BalanceQuery[] calldata queries)
+        external
+        view
+        returns (uint256[] memory);
+
+    /**
+     * @dev Returns the `amount` of account balance by address for a given denomination at the given
+     * past or current block height. Past heights can only be queried by calls outside of txs, e.g.
+     * `eth_call`, as transactions revert on them.
+     */
+    function getBalanceAtHeight(address accountAddress, string calldata denom, uint64 height)
+        external
+        view
+        returns (uint256);
+
     /**
      * @dev Returns account balance by address for all denominations.
      */
     function getAllBalances(address accountAddress) external view returns (Cosmos.Coin[] memory);
 
+    /**
+     * @dev Returns a page of the account balance by address for all denominations.
+     */
+    function getAllBalances(address accountAddress, Cosmos.PageRequest calldata pagination)
+        external
+        view
+        returns (Cosmos.Coin[] memory, Cosmos.PageResponse memory);
+
     /**
      * @dev Returns the `amount` of account balance by address for a given denomination.
      */
     function getSpendableBalance(address accountAddress, string calldata denom) external view returns (uint256);
 
+    /**
+     * @dev Returns the spendable `amount` of account balance by address for each of the given
+     * denominations, in order.
+     */
+    function getSpendableBalances(address accountAddress, string[] calldata denoms)
+        external
+        view
+        returns (uint256[] memory);
+
     /**
      * @dev Returns account balance by address for all denominations.
      */
     function getAllSpendableBalances(address accountAddress) external view returns (Cosmos.Coin[] memory);
 
+    /**
+     * @dev Returns a page of the spendable account balance by address for all denominations.
+     */
+    function getAllSpendableBalances(address accountAddress, Cosmos.PageRequest calldata pagination)
+        external
+        view
+        returns (Cosmos.Coin[] memory, Cosmos.PageResponse memory);
+
     /**
      * @dev Returns the total supply of a single coin.
      */
     function getSupply(string calldata denom) external view returns (uint256);
 
+    /**
+     * @dev Returns the total supply of a single coin at the given past or current block height.
+     * Past heights can only be queried by calls outside of txs, e.g. `eth_call`, as transactions
+     * revert on them.
+     */
+    function getSupplyAt(string calldata denom, int64 height) external view returns (uint256);
+
     /**
      * @dev Returns the total supply of a all coins.
      */
     function getAllSupply() external view returns (Cosmos.Coin[] memory);
 
+    /**
+     * @dev Returns a page of the total supply of all coins.
+     */
+    function getAllSupply(Cosmos.PageRequest calldata pagination)
+        external
+        view
+        returns (Cosmos.Coin[] memory, Cosmos.PageResponse memory);
+
     /**
      * @dev Returns the denomination's metadata.
      */
     function getDenomMetadata(string calldata denom) external view returns (DenomMetadata memory);
 
+    /**
+     * @dev Returns a page of the metadata of all registered denominations.
+     */
+    function getDenomsMetadata(Cosmos.PageRequest calldata pagination)
+        external
+        view
+        returns (DenomMetadata[] memory, Cosmos.PageResponse memory);
+
     /**
      * @dev Returns if the denom is enabled to send
      */
     function getSendEnabled(string calldata denom) external view returns (bool);
 
+    /**
+     * @dev Returns the send grants given by `owner`, one entry per spender.
+     */
+    function getAllowances(address owner) external view returns (Grant[] memory);
+
+    /**
+     * @dev Returns the send grants received by `spender`.
+     */
+    function getGrantsToSpender(address spender) external view returns (Grant[] memory);
+
+    /**
+     * @dev Returns the amount of `denom` that `spender` can actually send on behalf of `owner`,
+     * i.e. the lesser of the send grant's spend limit and `owner`'s spendable balance. Returns 0 if
+     * there is no send grant.
+     */
+    function getEffectiveAllowance(address owner, address spender, string calldata denom)
+        external
+        view
+        returns (uint256);
+
+    /**
+     * @dev Returns the details of the send grants given by `owner` to `spender`, including when
+     * they expire (0 if never) and the recipients they are restricted to (empty if any).
+     */
+    function getSendAllowances(address owner, address spender)
+        external
+        view
+        returns (SendAllowance[] memory);
+
+    /**
+     * @dev Returns whether `account` is a vesting account. Returns false for normal and module
+     * accounts, and for accounts that do not exist.
+     */
+    function isVestingAccount(address account) external view returns (bool);
+
+    /**
+     * @dev Returns the locked portion of every denom held by the vesting account `account` at the
+     * current block time, i.e. the amount still locked by its vesting schedule capped by its
+     * balance, which is the part of its balance that is not spendable. Returns an empty array for
+     * non-vesting accounts.
+     */
+    function getAllLockedBalances(address account) external view returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev Alias of `getAllLockedBalances`.
+     */
+    function getLockedCoins(address account) external view returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev Returns the vesting schedule of the vesting account `account`. Returns an empty
+     * schedule for non-vesting accounts.
+     */
+    function getVestingSchedule(address account) external view returns (VestingSchedule memory);
+
+    /**
+     * @dev Returns the bech32 representations of the given hex addresses, in order.
+     */
+    function batchHexToBech32(address[] calldata addrs) external view returns (string[] memory);
+
+    /**
+     * @dev Returns the hex representations of the given bech32 addresses, in order.
+     */
+    function batchBech32ToHex(string[] calldata bech32s) external view returns (address[] memory);
+
     ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
 
     /**
@@ -117,8 +281,46 @@ interface IBankModule {
      */
     function send(address toAddress, Cosmos.Coin[] calldata amount) external payable returns (bool);
 
+    /**
+     * @dev Send coins from msg.sender to the account with the given bech32 address, which can be
+     * of any length (e.g. a module account) and use any bech32 prefix. The ERC20-style `Transfer`
+     * events are only emitted if the recipient is 20 bytes long.
+     */
+    function send(string calldata toAddress, Cosmos.Coin[] calldata amount)
+        external
+        payable
+        returns (bool);
+
+    /**
+     * @dev Burns `amount` of msg.sender's coins, emitting a `Burn` event with msg.sender as
+     * `burner`. The coins are moved to the evm module account and burned there, which also emits
+     * the `Burn` event of the bank module with the module account as `burner`.
+     */
+    function burn(Cosmos.Coin[] calldata amount) external returns (bool);
+
+    /**
+     * @dev Revokes the send grant given by msg.sender to `spender`.
+     */
+    function revoke(address spender) external returns (bool);
+
+    /**
+     * @dev Lowers the spend limit of the send grant given by msg.sender to `spender` by `amount`,
+     * keeping its expiration. The grant is revoked if its spend limit reaches zero. Reverts if
+     * `amount` exceeds the current spend limit.
+     */
+    function decreaseAllowance(address spender, Cosmos.Coin[] calldata amount)
+        external
+        returns (bool);
+
     //////////////////////////////////////////// UTILS ////////////////////////////////////////////
 
+    /**
+     * @dev Returns whether `caller` may call the method with the given selector, i.e. the method is
+     * not restricted in the x/evm params or `caller` is one of its allowed callers. Returns false
+     * for unknown selectors.
+     */
+    function canCall(address caller, bytes4 methodSelector) external view returns (bool);
+
     /**
      * @dev Represents a denom unit.
      * Note: this struct is generated in generated/i_bank_module.abigen.go
@@ -141,4 +343,58 @@ interface IBankModule {
         string name;
         string symbol;
     }
+
+    /**
+     * @dev Represents an (account, denomination) pair to query the balance of.
+     * Note: this struct is generated in generated/i_bank_module.abigen.go
+     */
+    struct BalanceQuery {
+        address account;
+        string denom;
+    }
+
+    /**
+     * @dev Represents a send grant, where `expiration` is a unix timestamp (0 if none).
+     * Note: this struct is generated in generated/i_bank_module.abigen.go
+     */
+    struct Grant {
+        address spender;
+        Cosmos.Coin[] coins;
+        uint64 expiration;
+    }
+
+    /**
+     * @dev Represents a vesting period, where `length` is its duration in seconds.
+     * Note: this struct is generated in generated/i_bank_module.abigen.go
+     */
+    struct VestingPeriod {
+        uint64 length;
+        Cosmos.Coin[] amount;
+    }
+
+    /**
+     * @dev Represents the vesting schedule of a vesting account, where `startTime` and `endTime`
+     * are unix timestamps (`startTime` is 0 for delayed vesting accounts), `vestedCoins` are the
+     * coins vested at the current block time and `periods` are only set for periodic vesting
+     * accounts.
+     * Note: this struct is generated in generated/i_bank_module.abigen.go
+     */
+    struct VestingSchedule {
+        uint64 startTime;
+        uint64 endTime;
+        Cosmos.Coin[] originalVesting;
+        Cosmos.Coin[] vestedCoins;
+        VestingPeriod[] periods;
+    }
+
+    /**
+     * @dev Represents the details of a send grant, where `expiration` is a unix timestamp (0 if
+     * none) and `allowList` is the list of allowed recipients (empty if any recipient is allowed).
+     * Note: this struct is generated in generated/i_bank_module.abigen.go
+     */
+    struct SendAllowance {
+        Cosmos.Coin[] coins;
+        uint64 expiration;
+        address[] allowList;
+    }
 }
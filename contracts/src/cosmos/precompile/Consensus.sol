@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the consensus module's precompiled contract
+ */
+interface IConsensusModule {
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Returns the block size limits of the CometBFT consensus params.
+     */
+    function getBlockParams() external view returns (BlockParams memory);
+
+    /**
+     * @dev Returns the evidence limits of the CometBFT consensus params.
+     */
+    function getEvidenceParams() external view returns (EvidenceParams memory);
+
+    //////////////////////////////////////////// UTILS ////////////////////////////////////////////
+
+    /**
+     * @dev Represents the CometBFT block params.
+     */
+    struct BlockParams {
+        // maxBytes is the max size of a block, in bytes
+        int64 maxBytes;
+        // maxGas is the max gas of a block, -1 if unlimited
+        int64 maxGas;
+    }
+
+    /**
+     * @dev Represents the CometBFT evidence params.
+     */
+    struct EvidenceParams {
+        // maxAgeNumBlocks is the max age of evidence, in blocks
+        int64 maxAgeNumBlocks;
+        // maxAgeDuration is the max age of evidence, in seconds
+        int64 maxAgeDuration;
+        // maxBytes is the max size of the evidence of a block, in bytes
+        int64 maxBytes;
+    }
+}
@@ -41,6 +41,37 @@ interface IDistributionModule {
      */
     function withdrawDelegatorReward(address delegator, address validator) external returns (Cosmos.Coin[] memory);
 
+    /**
+     * @dev Withdraws the rewards accumulated by the caller (msg.sender) from all of its
+     * delegations. Returns the rewards claimed.
+     */
+    function withdrawDelegatorRewards() external returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev Sends `amount` of the caller's (msg.sender) coins to the community pool.
+     * @param amount The amount of coins to fund the community pool with.
+     */
+    function fundCommunityPool(Cosmos.Coin[] calldata amount) external returns (bool);
+
+    /**
+     * @dev Returns the rewards accumulated by the delegator from its delegation to the validator.
+     * @param delegator The delegator to retrieve the rewards for.
+     * @param validator The validator to retrieve the rewards from.
+     */
+    function getDelegatorRewards(address delegator, address validator)
+        external
+        view
+        returns (Cosmos.Coin[] memory);
+
+    /**
+     * @dev Returns the commission accumulated by the validator.
+     * @param validator The validator to retrieve the commission for.
+     */
+    function getValidatorCommission(address validator)
+        external
+        view
+        returns (Cosmos.Coin[] memory);
+
     /**
      * @dev Returns the all rewards accumulated by the delegator.
      * @param delegator The delegator to retrieve the totalRewards for.
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the gRPC query precompiled contract, which routes calls to the Cosmos gRPC
+ * query services whose paths are in the whitelist the chain configures the precompile with.
+ */
+interface IGRPCQuery {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Thrown by `query` when the handler of the query fails.
+     * @param codespace The codespace of the Cosmos error, e.g. `sdk`.
+     * @param code The code of the Cosmos error within its codespace.
+     * @param message The message of the Cosmos error.
+     */
+    error CosmosError(string codespace, uint32 code, string message);
+
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Runs a whitelisted gRPC query and returns the protobuf-encoded response. Reverts with
+     * `CosmosError` if the handler of the query fails.
+     * @param path The full gRPC method path, e.g. `/cosmos.bank.v1beta1.Query/Balance`.
+     * @param request The protobuf-encoded request.
+     */
+    function query(string calldata path, bytes calldata request)
+        external
+        view
+        returns (bytes memory);
+
+    /**
+     * @dev Returns true if the query path is whitelisted.
+     * @param path The full gRPC method path.
+     */
+    function isQueryAllowed(string calldata path) external view returns (bool);
+}
@@ -35,6 +35,23 @@ interface IGovernanceModule {
      */
     function submitProposal(bytes calldata proposalMsg) external returns (uint64);
 
+    /**
+     * @dev Submit a proposal to the governance module on behalf of the caller. Returns the
+     * proposal id.
+     * @param messages The protobuf-encoded `Any`s of the messages to execute if the proposal passes.
+     * @param initialDeposit The deposit to transfer from the caller.
+     * @param metadata The metadata of the proposal.
+     * @param title The title of the proposal.
+     * @param summary The summary of the proposal.
+     */
+    function submitProposal(
+        bytes[] calldata messages,
+        Cosmos.Coin[] calldata initialDeposit,
+        string calldata metadata,
+        string calldata title,
+        string calldata summary
+    ) external returns (uint64);
+
     /**
      * @dev Cancel a proposal. Returns the cancled time and height.
      *   burned.
@@ -60,6 +77,13 @@ interface IGovernanceModule {
         external
         returns (bool);
 
+    /**
+     * @dev Deposit coins from the caller to a proposal.
+     * @param proposalId The id of the proposal to deposit to.
+     * @param amount The coins to deposit.
+     */
+    function deposit(uint64 proposalId, Cosmos.Coin[] calldata amount) external returns (bool);
+
     ////////////////////////////////////////// Read Methods /////////////////////////////////////////////
 
     /**
@@ -80,6 +104,14 @@ interface IGovernanceModule {
         view
         returns (Cosmos.Coin[] memory);
 
+    /**
+     * @dev Get the deposits of each depositor of the proposal with the given id.
+     */
+    function getDeposits(uint64 proposalId, Cosmos.PageRequest calldata pagination)
+        external
+        view
+        returns (Deposit[] memory, Cosmos.PageResponse memory);
+
     /**
      * @dev Get proposals with a given status.
      * @param proposalStatus The status of the proposals to get.
@@ -107,6 +139,12 @@ interface IGovernanceModule {
      */
     function getProposalVotesByVoter(uint64 proposalId, address voter) external view returns (Vote memory);
 
+    /**
+     * @dev Get the number of seconds until voting ends on the proposal with the given id. Returns
+     * zero if voting has already ended.
+     */
+    function getVotingTimeRemaining(uint64 proposalId) external view returns (uint64);
+
     /**
      * @dev Get the governance module parameters.
      */
@@ -127,6 +165,14 @@ interface IGovernanceModule {
      */
     function getTallyParams() external view returns (TallyParams memory);
 
+    /**
+     * @dev Get the governance module tally parameters as fixed-point numbers with 18 decimals.
+     */
+    function getScaledTallyParams()
+        external
+        view
+        returns (uint256 quorum, uint256 threshold, uint256 vetoThreshold);
+
     /**
      * @dev Get the constitution of the chain.
      */
@@ -153,13 +199,23 @@ interface IGovernanceModule {
         string metadata;
     }
 
+    /**
+     * @dev Represents a governance module `Deposit`.
+     * Note: this struct is generated in generated/i_staking_module.abigen.go
+     */
+    struct Deposit {
+        uint64 proposalId;
+        address depositor;
+        Cosmos.Coin[] amount;
+    }
+
     /**
      * @dev Represents a governance module `Proposal`.
      * Note: this struct is generated in generated/i_staking_module.abigen.go
      */
     struct Proposal {
         uint64 id;
-        bytes message;
+        ProposalMessage[] messages;
         int32 status;
         TallyResult finalTallyResult;
         uint64 submitTime;
@@ -173,6 +229,17 @@ interface IGovernanceModule {
         string proposer;
     }
 
+    /**
+     * @dev Represents a message of a governance module `Proposal`. The `value` is the ABI encoding
+     * of the message for the message types registered with the precompile, and the protobuf
+     * encoding of the message otherwise.
+     * Note: this struct is generated in generated/i_staking_module.abigen.go
+     */
+    struct ProposalMessage {
+        string typeUrl;
+        bytes value;
+    }
+
     /**
      * @dev Represents the governance module's parameters.
      * Note: this struct is generated in generated/i_staking_module.abigen.go
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the group module's precompiled contract
+ *
+ * Note: group policy accounts have 32 byte addresses, so they are represented by their bech32
+ * string instead of an `address`.
+ */
+interface IGroupModule {
+    ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Creates a group with msg.sender as admin. Returns the id of the group.
+     * @param members The members of the group.
+     * @param metadata The metadata of the group.
+     */
+    function createGroup(Member[] calldata members, string calldata metadata) external returns (uint64);
+
+    /**
+     * @dev Creates a group policy with a threshold decision policy for a group administered by
+     * msg.sender. Returns the bech32 address of the group policy account.
+     * @param groupId The id of the group.
+     * @param metadata The metadata of the group policy.
+     * @param threshold The minimum weight of yes votes for a proposal to pass.
+     * @param votingPeriod The duration of the voting period of proposals, in seconds.
+     * @param minExecutionPeriod The minimum duration after submission before a proposal can be
+     * executed, in seconds.
+     */
+    function createGroupPolicy(
+        uint64 groupId,
+        string calldata metadata,
+        string calldata threshold,
+        uint64 votingPeriod,
+        uint64 minExecutionPeriod
+    ) external returns (string memory);
+
+    /**
+     * @dev Submits a proposal to a group policy with msg.sender as the proposer. Returns the id of
+     * the proposal.
+     * @param groupPolicy The bech32 address of the group policy account.
+     * @param messages The protobuf-encoded `Any`s of the messages to execute if the proposal passes.
+     * @param metadata The metadata of the proposal.
+     * @param title The title of the proposal.
+     * @param summary The summary of the proposal.
+     * @param tryExec Whether to try to execute the proposal right after submission.
+     */
+    function submitProposal(
+        string calldata groupPolicy,
+        bytes[] calldata messages,
+        string calldata metadata,
+        string calldata title,
+        string calldata summary,
+        bool tryExec
+    ) external returns (uint64);
+
+    /**
+     * @dev Votes on a group proposal as msg.sender.
+     * @param proposalId The id of the proposal.
+     * @param option The vote option.
+     * @param metadata The metadata of the vote.
+     * @param tryExec Whether to try to execute the proposal right after the vote.
+     */
+    function vote(uint64 proposalId, int32 option, string calldata metadata, bool tryExec)
+        external
+        returns (bool);
+
+    /**
+     * @dev Executes an accepted group proposal as msg.sender. Returns true if the messages of the
+     * proposal were executed successfully.
+     * @param proposalId The id of the proposal.
+     */
+    function exec(uint64 proposalId) external returns (bool);
+
+    //////////////////////////////////////////// UTILS ////////////////////////////////////////////
+
+    /**
+     * @dev Represents a member of a group.
+     */
+    struct Member {
+        address member;
+        string weight;
+        string metadata;
+    }
+}
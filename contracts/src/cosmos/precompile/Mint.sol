@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the mint module's precompiled contract
+ */
+interface IMintModule {
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Returns the current annual inflation rate, scaled by 1e18.
+     */
+    function getInflation() external view returns (uint256);
+
+    /**
+     * @dev Returns the current annual provisions of the mint denom, scaled by 1e18.
+     */
+    function getAnnualProvisions() external view returns (uint256);
+
+    /**
+     * @dev Returns the mint module parameters.
+     */
+    function getParams() external view returns (Params memory);
+
+    //////////////////////////////////////////// UTILS ////////////////////////////////////////////
+
+    /**
+     * @dev Represents the mint module parameters. Rates are scaled by 1e18.
+     */
+    struct Params {
+        string mintDenom;
+        // inflationRateChange is the maximum annual change in the inflation rate
+        uint256 inflationRateChange;
+        uint256 inflationMax;
+        uint256 inflationMin;
+        // goalBonded is the goal of the percentage of bonded tokens
+        uint256 goalBonded;
+        uint64 blocksPerYear;
+    }
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the msg executor precompiled contract, which executes Cosmos messages signed
+ * by the caller, if their type URL is in the whitelist the chain configures the precompile with.
+ */
+interface IMsgExecutor {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Thrown by `execute` when the type URL of the message is not whitelisted.
+     */
+    error MsgNotAllowed(string msgTypeUrl);
+
+    /**
+     * @dev Thrown by `execute` when the handler of the message fails.
+     * @param codespace The codespace of the Cosmos error, e.g. `sdk`.
+     * @param code The code of the Cosmos error within its codespace.
+     * @param message The message of the Cosmos error.
+     */
+    error CosmosError(string codespace, uint32 code, string message);
+
+    ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Executes the given message, which is a protobuf encoded `google.protobuf.Any`, and
+     * returns the protobuf encoded `google.protobuf.Any` of its response. The message must be
+     * signed by msg.sender only. Reverts with `MsgNotAllowed` if its type URL is not whitelisted
+     * and with `CosmosError` if the message is invalid or its handler fails.
+     * @param message The protobuf encoded `google.protobuf.Any` of the message.
+     */
+    function execute(bytes calldata message) external returns (bytes memory);
+
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Returns true if messages with the given type URL can be executed.
+     * @param msgTypeUrl The type URL of the message.
+     */
+    function isMsgAllowed(string calldata msgTypeUrl) external view returns (bool);
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the multicall precompiled contract, which executes a batch of calls, including
+ * calls to other precompiles, within one EVM message.
+ */
+interface IMulticall {
+    ////////////////////////////////////////// ERRORS /////////////////////////////////////////////
+
+    /**
+     * @dev Thrown by `aggregate` when a call fails and failures are not allowed.
+     * @param index The index of the failed call.
+     * @param returnData The revert data of the failed call.
+     */
+    error CallFailed(uint256 index, bytes returnData);
+
+    ////////////////////////////////////////// STRUCTS ////////////////////////////////////////////
+
+    /**
+     * @dev A call of the batch.
+     * @param target The address of the called contract or precompile.
+     * @param callData The calldata of the call.
+     */
+    struct Call {
+        address target;
+        bytes callData;
+    }
+
+    /**
+     * @dev The result of a call of the batch.
+     * @param success Whether the call succeeded.
+     * @param returnData The return data of the call, or its revert data if it failed.
+     * @param gasUsed The gas used by the call.
+     */
+    struct Result {
+        bool success;
+        bytes returnData;
+        uint64 gasUsed;
+    }
+
+    ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Executes the calls in order, with msg.sender as the caller of every call. If
+     * `allowFailure` is false, the batch is atomic: a failed call reverts all of the calls with
+     * `CallFailed`. Otherwise, only the state changes of the failed call are reverted.
+     * @param calls The calls to execute.
+     * @param allowFailure Whether the batch continues if a call fails.
+     * @return results The results of the calls, in order.
+     * @return gasUsed The total gas used by the calls.
+     */
+    function aggregate(
+        Call[] calldata calls,
+        bool allowFailure
+    ) external returns (Result[] memory results, uint64 gasUsed);
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the precompile registry precompiled contract, which reports the gated stateful
+ * precompiles of the chain that are enabled and the precompile methods that are restricted. Gated
+ * precompiles are shipped disabled and revert when called until governance enables them in the
+ * x/evm params. Governance can also restrict single precompile methods to a set of allowed
+ * callers in the x/evm params.
+ */
+interface IPrecompileRegistry {
+    /////////////////////////////////////// READ METHODS //////////////////////////////////////////
+
+    /**
+     * @dev Returns true if the gated precompile is enabled. Precompiles that are not gated can
+     * always be called, regardless of this value.
+     * @param precompile The address of the gated precompile.
+     */
+    function isPrecompileEnabled(address precompile) external view returns (bool);
+
+    /**
+     * @dev Returns true if the precompile method can only be called by its allowed callers.
+     * @param precompile The address of the precompile.
+     * @param method The selector of the precompile method.
+     */
+    function isMethodRestricted(address precompile, bytes4 method) external view returns (bool);
+
+    /**
+     * @dev Returns true if the caller can call the precompile method, i.e. the method is not
+     * restricted or the caller is allowed to call it.
+     * @param precompile The address of the precompile.
+     * @param method The selector of the precompile method.
+     * @param caller The address of the caller.
+     */
+    function isMethodCallerAllowed(
+        address precompile,
+        bytes4 method,
+        address caller
+    ) external view returns (bool);
+}
@@ -120,6 +120,33 @@ interface IStakingModule {
      */
     function getDelegation(address delegatorAddress, address validatorAddress) external view returns (uint256);
 
+    /**
+     * @dev Returns the amount of `denom` tokens bonded by `delegatorAddress` to `validatorAddress`,
+     * converting the delegation's shares to tokens at the validator's exchange rate. Returns 0 if
+     * there is no delegation or `denom` is not the bond denom.
+     */
+    function getDelegation(address delegatorAddress, address validatorAddress, string calldata denom)
+        external
+        view
+        returns (uint256);
+
+    /**
+     * @dev Returns the total amount of `denom` tokens bonded by `delegatorAddress` across all of its
+     * delegations, converting each delegation's shares to tokens at its validator's exchange rate.
+     * Returns 0 if there are no delegations or `denom` is not the bond denom.
+     */
+    function getStakedBalance(address delegatorAddress, string calldata denom) external view returns (uint256);
+
+    /**
+     * @dev Returns the number of blocks `validatorAddress` missed in the current signed blocks
+     * window, along with the size of the window. The validator is jailed for downtime once it misses
+     * more than the allowed share of the window.
+     */
+    function getValidatorUptime(address validatorAddress)
+        external
+        view
+        returns (uint64 missedBlocks, uint64 signedBlocksWindow);
+
     /**
      * @dev Returns a time-ordered list of all UnbondingDelegationEntries between
      * `delegatorAddress` and `validatorAddress`
@@ -148,6 +175,26 @@ interface IStakingModule {
         Cosmos.PageRequest calldata pagination
     ) external view returns (RedelegationEntry[] memory, Cosmos.PageResponse memory);
 
+    /**
+     * @dev Returns all of `delegatorAddress`'s redelegation entries from `srcValidator` to
+     * `dstValidator`. The zero address can be used as `srcValidator` and/or `dstValidator` to match
+     * any validator.
+     */
+    function getRedelegations(address delegatorAddress, address srcValidator, address dstValidator)
+        external
+        view
+        returns (RedelegationEntry[] memory);
+
+    /**
+     * @dev Returns the amounts of bond denom tokens that are not bonded and bonded.
+     */
+    function getPool() external view returns (uint256 notBondedTokens, uint256 bondedTokens);
+
+    /**
+     * @dev Returns the staking module parameters.
+     */
+    function getParams() external view returns (Params memory);
+
     ////////////////////////////////////// WRITE METHODS //////////////////////////////////////////
 
     /**
@@ -187,9 +234,15 @@ interface IStakingModule {
     struct Validator {
         address operatorAddr;
         bytes consAddr;
+        // consPubKeyType is the algorithm of the consensus public key, e.g. "ed25519" or "secp256k1"
+        string consPubKeyType;
+        // consPubKey is the raw consensus public key, i.e. 32 bytes for ed25519 and 33 bytes
+        // (compressed) for secp256k1
+        bytes consPubKey;
         bool jailed;
         string status;
         uint256 tokens;
+        // delegatorShares is the total shares issued to delegators, scaled by 1e18
         uint256 delegatorShares;
         Description description;
         int64 unbondingHeight;
@@ -209,7 +262,8 @@ interface IStakingModule {
     }
 
     /**
-     * @dev Represents the initial commission rates to be used for creating a validator.
+     * @dev Represents the initial commission rates to be used for creating a validator. The rates
+     * are fixed-point numbers with 18 decimals, e.g. 5e16 is a 5% rate.
      */
     struct CommissionRates {
         uint256 rate;
@@ -237,8 +291,8 @@ interface IStakingModule {
     struct UnbondingDelegationEntry {
         // creationHeight is the height which the unbonding took place
         int64 creationHeight;
-        // completionTime is the unix time for unbonding completion, formatted as a string
-        string completionTime;
+        // completionTime is the unix time (in seconds) for unbonding completion
+        uint64 completionTime;
         // initialBalance defines the tokens initially scheduled to receive at completion
         uint256 initialBalance;
         // balance defines the tokens to receive at completion
@@ -268,16 +322,32 @@ interface IStakingModule {
     struct RedelegationEntry {
         // creationHeight is the height which the redelegation took place
         int64 creationHeight;
-        // completionTime is the unix time for redelegation completion, formatted as a string
-        string completionTime;
+        // completionTime is the unix time (in seconds) for redelegation completion
+        uint64 completionTime;
         // initialBalance defines the initial balance when redelegation started
         uint256 initialBalance;
-        // sharesDst is the amount of destination-validatorAddress shares created by redelegation
+        // sharesDst is the amount of destination-validatorAddress shares created by redelegation,
+        // scaled by 1e18
         uint256 sharesDst;
         // unbondingId is the incrementing id that uniquely identifies this entry
         uint64 unbondingId;
     }
 
+    /**
+     * @dev Represents the staking module parameters.
+     */
+    struct Params {
+        // unbondingTime is the time duration of unbonding, in seconds
+        uint64 unbondingTime;
+        uint32 maxValidators;
+        // maxEntries is the max entries for either unbonding delegation or redelegation (per pair)
+        uint32 maxEntries;
+        uint32 historicalEntries;
+        string bondDenom;
+        // minCommissionRate is the minimum commission rate of a validator, scaled by 1e18
+        uint256 minCommissionRate;
+    }
+
     /**
      * @dev Represents a single delegation.
      */
@@ -285,7 +355,7 @@ interface IStakingModule {
         address delegator;
         // tokens
         uint256 balance;
-        // shares
+        // shares is the amount of shares of the delegation, scaled by 1e18
         uint256 shares;
     }
 }
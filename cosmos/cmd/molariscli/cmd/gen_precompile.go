@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"pkg.berachain.dev/polaris/cosmos/cmd/molariscli/precompilegen"
+)
+
+const (
+	flagMsg   = "msg"
+	flagQuery = "query"
+	flagOut   = "out"
+	flagForce = "force"
+)
+
+// ErrNotAService is returned when a given Protobuf name does not resolve to a service.
+var ErrNotAService = errors.New("not a Protobuf service")
+
+// NewGenPrecompileCmd returns the command that generates a precompile from the Msg and Query
+// Protobuf services of a Cosmos module.
+func NewGenPrecompileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gen-precompile [name]",
+		Short: "Generate a precompile from the Msg and Query services of a Cosmos module",
+		Long: `Generate the Solidity interface, Go bindings, and a skeletal Go precompile contract
+of a Cosmos module from its Msg and Query Protobuf services. The files are written relative to the
+root of the Polaris repository, which defaults to the current directory:
+
+  contracts/src/cosmos/precompile/<Name>.sol
+  contracts/bindings/cosmos/precompile/<name>/i_<name>_module.abigen.go
+  cosmos/precompile/<name>/<name>.go
+
+The generated contract dispatches transactions to the Msg server and view functions to the Query
+server. Conversions that cannot be derived from the Protobuf types are left as TODOs.`,
+		Example: "molariscli gen-precompile bank --msg cosmos.bank.v1beta1.Msg " +
+			"--query cosmos.bank.v1beta1.Query",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msgName, _ := cmd.Flags().GetString(flagMsg)
+			queryName, _ := cmd.Flags().GetString(flagQuery)
+			out, _ := cmd.Flags().GetString(flagOut)
+			force, _ := cmd.Flags().GetBool(flagForce)
+
+			cfg := precompilegen.Config{Name: args[0]}
+			var err error
+			if cfg.Msg, err = findService(msgName); err != nil {
+				return err
+			}
+			if cfg.Query, err = findService(queryName); err != nil {
+				return err
+			}
+
+			gen, err := precompilegen.Generate(cfg)
+			if err != nil {
+				return err
+			}
+			files := map[string]string{
+				filepath.Join("contracts", "src", "cosmos", "precompile",
+					precompilegen.Title(cfg.Name)+".sol"): gen.Solidity,
+				filepath.Join("contracts", "bindings", "cosmos", "precompile", cfg.Name,
+					"i_"+cfg.Name+"_module.abigen.go"): gen.Bindings,
+				filepath.Join("cosmos", "precompile", cfg.Name, cfg.Name+".go"): gen.Contract,
+			}
+			for path, content := range files {
+				if err = writeFile(filepath.Join(out, path), content, force); err != nil {
+					return err
+				}
+				cmd.Printf("wrote %s\n", path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagMsg, "", "full name of the Msg service, e.g. cosmos.bank.v1beta1.Msg")
+	cmd.Flags().String(flagQuery, "", "full name of the Query service, e.g. cosmos.bank.v1beta1.Query")
+	cmd.Flags().String(flagOut, ".", "root of the Polaris repository to write the files to")
+	cmd.Flags().Bool(flagForce, false, "overwrite existing files")
+	return cmd
+}
+
+// findService returns the Protobuf service with the given full name from the registered files, or
+// nil if the name is empty.
+func findService(name string) (protoreflect.ServiceDescriptor, error) {
+	if name == "" {
+		return nil, nil //nolint:nilnil // no service.
+	}
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotAService, name)
+	}
+	return sd, nil
+}
+
+// writeFile writes the given content to the file at the given path, creating its directory. It
+// does not overwrite an existing file unless force is set.
+func writeFile(path, content string, force bool) error {
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("%s already exists, use --%s to overwrite it", path, flagForce)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:gomnd // dir perms.
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0o600) //nolint:gomnd // file perms.
+}
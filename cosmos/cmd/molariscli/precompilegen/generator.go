@@ -0,0 +1,596 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompilegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+var (
+	// ErrNoServices is returned when neither a Msg nor a Query service is given.
+	ErrNoServices = errors.New("no Msg or Query service to generate the precompile from")
+	// ErrInvalidName is returned when the module name is not a valid Go package name.
+	ErrInvalidName = errors.New("module name must be a lowercase Go package name")
+	// ErrUnknownMessage is returned when the Go type of a request message is not registered.
+	ErrUnknownMessage = errors.New("request message has no registered Go type")
+)
+
+// Config is the input of the precompile generator.
+type Config struct {
+	// Name is the name of the module, e.g. `bank`, which is used as the Go package name and, in
+	// CamelCase, for the Solidity interface and Go binding types.
+	Name string
+	// Msg is the Msg service of the module, whose methods are generated as transactions. It may be
+	// nil if Query is set.
+	Msg protoreflect.ServiceDescriptor
+	// Query is the Query service of the module, whose methods are generated as view functions. It
+	// may be nil if Msg is set.
+	Query protoreflect.ServiceDescriptor
+}
+
+// Output is the code generated for a precompile.
+type Output struct {
+	// Solidity is the Solidity interface of the precompile.
+	Solidity string
+	// ABI is the JSON ABI of the Solidity interface.
+	ABI string
+	// Bindings are the Go bindings of the Solidity interface.
+	Bindings string
+	// Contract is the skeletal Go precompile contract, which dispatches the transactions to the Msg
+	// server and the view functions to the Query server.
+	Contract string
+}
+
+// Generate generates the Solidity interface, Go bindings, and skeletal Go precompile contract of
+// the module with the given config.
+func Generate(cfg Config) (*Output, error) {
+	if cfg.Msg == nil && cfg.Query == nil {
+		return nil, ErrNoServices
+	}
+	if cfg.Name == "" || cfg.Name != strings.ToLower(cfg.Name) ||
+		strings.ContainsAny(cfg.Name, "_- .") {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidName, cfg.Name)
+	}
+
+	g := &generator{
+		name:    cfg.Name,
+		title:   Title(cfg.Name),
+		imports: map[string]string{},
+	}
+	if err := g.addService(cfg.Msg, false); err != nil {
+		return nil, err
+	}
+	if err := g.addService(cfg.Query, true); err != nil {
+		return nil, err
+	}
+	return g.output()
+}
+
+// Title returns the CamelCase name of the module with the given name, which is used for the
+// Solidity interface and Go binding types.
+func Title(name string) string {
+	return camelCase(name)
+}
+
+// method is a precompile method generated from a Protobuf RPC.
+type method struct {
+	// rpc is the name of the RPC, e.g. `Send`.
+	rpc string
+	// name is the name of the Solidity function, e.g. `send`.
+	name string
+	// query is true for view functions generated from Query RPCs.
+	query bool
+	// request is the Go type name of the request message, e.g. `MsgSend`.
+	request string
+	// signer is the Go field name of the request signer, which is set to the caller.
+	signer string
+	// inputs are the arguments of the Solidity function, from the request fields.
+	inputs []param
+	// outputs are the return values of the Solidity function, from the response fields.
+	outputs []param
+}
+
+// param is an argument or return value of a precompile method, generated from a Protobuf field.
+type param struct {
+	// name is the name of the Solidity argument, e.g. `toAddress`.
+	name string
+	// field is the Go field name in the Protobuf message, e.g. `ToAddress`.
+	field string
+	// typ is the Solidity type of the field.
+	typ solType
+	// goType is the type of the field in the Go message struct, if known.
+	goType reflect.Type
+}
+
+// generator accumulates the methods and Go imports of a precompile.
+type generator struct {
+	name  string
+	title string
+	// types is the Go package alias of the module types, e.g. `banktypes`.
+	types string
+	// imports are the Go imports of the contract, keyed by path with their alias.
+	imports map[string]string
+	methods []method
+}
+
+// addService adds a method for every RPC of the given service.
+func (g *generator) addService(sd protoreflect.ServiceDescriptor, query bool) error {
+	if sd == nil {
+		return nil
+	}
+	for i := 0; i < sd.Methods().Len(); i++ {
+		rpc := sd.Methods().Get(i)
+		reqType := gogoproto.MessageType(string(rpc.Input().FullName()))
+		if reqType == nil {
+			return fmt.Errorf("%w: %s", ErrUnknownMessage, rpc.Input().FullName())
+		}
+		g.useTypes(reqType)
+
+		m := method{
+			rpc:     string(rpc.Name()),
+			name:    lowerCamelCase(string(rpc.Name())),
+			query:   query,
+			request: reqType.Elem().Name(),
+		}
+		if query && !strings.HasPrefix(m.name, "get") {
+			m.name = "get" + string(rpc.Name())
+		}
+
+		signers := map[string]struct{}{}
+		if !query {
+			opts := rpc.Input().Options()
+			if names, ok := proto.GetExtension(opts, msgv1.E_Signer).([]string); ok {
+				for _, name := range names {
+					signers[name] = struct{}{}
+				}
+			}
+		}
+		fields := rpc.Input().Fields()
+		for j := 0; j < fields.Len(); j++ {
+			fd := fields.Get(j)
+			if _, isSigner := signers[string(fd.Name())]; isSigner && m.signer == "" {
+				m.signer = goFieldName(fd.Name())
+				continue
+			}
+			m.inputs = append(m.inputs, newParam(fd, goFieldType(reqType, fd.Name())))
+		}
+
+		if query {
+			resType := gogoproto.MessageType(string(rpc.Output().FullName()))
+			fields = rpc.Output().Fields()
+			for j := 0; j < fields.Len(); j++ {
+				fd := fields.Get(j)
+				m.outputs = append(m.outputs, newParam(fd, goFieldType(resType, fd.Name())))
+			}
+		}
+		g.methods = append(g.methods, m)
+	}
+	return nil
+}
+
+// useTypes sets the Go package of the given request type as the module types package.
+func (g *generator) useTypes(reqType reflect.Type) {
+	if g.types != "" {
+		return
+	}
+	g.types = g.name + "types"
+	g.imports[reqType.Elem().PkgPath()] = g.types
+}
+
+// newParam returns the param of the given Protobuf field. Integers and decimals with a Cosmos math
+// type are mapped to 256-bit integers, where decimals are scaled by their 18 decimal places.
+func newParam(fd protoreflect.FieldDescriptor, goType reflect.Type) param {
+	p := param{
+		name:   paramName(string(fd.Name())),
+		field:  goFieldName(fd.Name()),
+		typ:    mapField(fd),
+		goType: goType,
+	}
+	if goType != nil && !fd.IsList() {
+		switch goType.String() {
+		case "math.Int":
+			p.typ = elementary("uint256", "*big.Int")
+		case "math.LegacyDec":
+			p.typ = elementary("uint256", "*big.Int")
+		}
+	}
+	return p
+}
+
+// output renders the generated code of the precompile.
+func (g *generator) output() (*Output, error) {
+	abiJSON, err := g.abi()
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := bind.Bind(
+		[]string{g.title + "Module"}, []string{abiJSON}, []string{""}, nil, g.name, nil, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := g.contract()
+	if err != nil {
+		return nil, err
+	}
+	return &Output{
+		Solidity: g.solidity(),
+		ABI:      abiJSON,
+		Bindings: bindings,
+		Contract: contract,
+	}, nil
+}
+
+// abi returns the JSON ABI of the Solidity interface, with the functions sorted by name like the
+// output of the Solidity compiler.
+func (g *generator) abi() (string, error) {
+	type abiFunction struct {
+		Inputs          []abiArg `json:"inputs"`
+		Name            string   `json:"name"`
+		Outputs         []abiArg `json:"outputs"`
+		StateMutability string   `json:"stateMutability"`
+		Type            string   `json:"type"`
+	}
+
+	functions := make([]abiFunction, 0, len(g.methods))
+	for _, m := range g.methods {
+		fn := abiFunction{
+			Inputs:          abiArgs(m.inputs),
+			Name:            m.name,
+			Outputs:         abiArgs(m.outputs),
+			StateMutability: "nonpayable",
+			Type:            "function",
+		}
+		if m.query {
+			fn.StateMutability = "view"
+		} else {
+			fn.Outputs = []abiArg{{Name: "", Type: "bool", InternalType: "bool"}}
+		}
+		functions = append(functions, fn)
+	}
+	sort.SliceStable(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+
+	bz, err := json.Marshal(functions)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// abiArgs returns the JSON ABI arguments of the given params.
+func abiArgs(params []param) []abiArg {
+	args := make([]abiArg, 0, len(params))
+	for _, p := range params {
+		args = append(args, abiArg{
+			Name:         p.name,
+			Type:         p.typ.abi,
+			InternalType: p.typ.internal,
+			Components:   p.typ.components,
+		})
+	}
+	return args
+}
+
+// solidity returns the Solidity interface of the precompile.
+func (g *generator) solidity() string {
+	var sb strings.Builder
+	sb.WriteString(solidityHeader)
+	fmt.Fprintf(&sb, "\n/**\n * @dev Interface of the %s module's precompiled contract\n */\n", g.name)
+	fmt.Fprintf(&sb, "interface I%sModule {\n", g.title)
+
+	writeSection := func(title string, query bool) {
+		first := true
+		for _, m := range g.methods {
+			if m.query != query {
+				continue
+			}
+			if first {
+				fmt.Fprintf(&sb, "    %s\n", title)
+				first = false
+			}
+			fmt.Fprintf(&sb, "\n    /**\n     * @dev Implements the %s RPC of the %s module.\n",
+				m.rpc, g.name)
+			for _, p := range m.inputs {
+				if p.typ.encoded {
+					fmt.Fprintf(&sb, "     * @param %s The Protobuf encoded `%s`.\n", p.name, p.field)
+				}
+			}
+			sb.WriteString("     */\n")
+			fmt.Fprintf(&sb, "    function %s(%s) external", m.name, solParams(m.inputs))
+			if query {
+				fmt.Fprintf(&sb, " view returns (%s);\n", solParams(m.outputs))
+			} else {
+				sb.WriteString(" returns (bool);\n")
+			}
+		}
+		if !first {
+			sb.WriteString("\n")
+		}
+	}
+	writeSection(readMethodsTitle, true)
+	writeSection(writeMethodsTitle, false)
+
+	return strings.TrimSuffix(sb.String(), "\n") + "}\n"
+}
+
+// solParams returns the Solidity parameter list of the given params.
+func solParams(params []param) string {
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		part := p.typ.sol
+		if p.typ.location != "" {
+			part += " " + p.typ.location
+		}
+		parts = append(parts, part+" "+p.name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// contract returns the skeletal Go precompile contract, formatted with gofmt.
+func (g *generator) contract() (string, error) {
+	var body bytes.Buffer
+	for _, m := range g.methods {
+		if m.query {
+			g.writeQuery(&body, m)
+		} else {
+			g.writeMsg(&body, m)
+		}
+	}
+
+	g.useImport("context", "")
+	g.useImport("cosmossdk.io/core/address", "")
+	g.useImport("github.com/cosmos/cosmos-sdk/x/auth/types", "authtypes")
+	g.useImport("pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/"+g.name, "generated")
+	g.useImport("pkg.berachain.dev/polaris/eth/common", "")
+	g.useImport("pkg.berachain.dev/polaris/eth/core/precompile", "ethprecompile")
+
+	var src bytes.Buffer
+	src.WriteString(goHeader)
+	fmt.Fprintf(&src, "package %s\n\nimport (\n", g.name)
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if alias := g.imports[path]; alias != "" {
+			fmt.Fprintf(&src, "\t%s %q\n", alias, path)
+		} else {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+	}
+	if err := template.Must(template.New("contract").Parse(contractTemplate)).Execute(
+		&src, struct{ Name, Title, Types string }{g.name, g.title, g.types},
+	); err != nil {
+		return "", err
+	}
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// writeMsg writes the precompile method that dispatches the Msg RPC to the Msg server.
+func (g *generator) writeMsg(w *bytes.Buffer, m method) {
+	g.writeSignature(w, m, "bool")
+	fmt.Fprintf(w, "\tmsg := &%s.%s{}\n", g.types, m.request)
+	if m.signer != "" {
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		g.useImport("pkg.berachain.dev/polaris/eth/core/vm", "")
+		fmt.Fprintf(w, "\tmsg.%s = cosmlib.MustStringFromEthAddress(\n"+
+			"\t\tc.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),\n\t)\n", m.signer)
+	}
+	g.writeInputs(w, m, "false")
+	fmt.Fprintf(w,
+		"\n\tif _, err := c.msgServer.%s(ctx, msg); err != nil {\n\t\treturn false, err\n\t}\n",
+		m.rpc,
+	)
+	w.WriteString("\treturn true, nil\n}\n\n")
+}
+
+// writeQuery writes the precompile method that calls the Query RPC on the Query server.
+func (g *generator) writeQuery(w *bytes.Buffer, m method) {
+	outs := make([]string, 0, len(m.outputs))
+	zeros := make([]string, 0, len(m.outputs))
+	for _, p := range m.outputs {
+		outs = append(outs, g.goType(p.typ.goOut))
+		zeros = append(zeros, zeroValue(p.typ.goOut))
+	}
+	zero := strings.Join(zeros, ", ")
+	if zero != "" {
+		zero += ", "
+	}
+
+	g.writeSignature(w, m, strings.Join(outs, ", "))
+	fmt.Fprintf(w, "\treq := &%s.%s{}\n", g.types, m.request)
+	g.writeInputs(w, m, strings.TrimSuffix(zero, ", "))
+	fmt.Fprintf(w, "\n\tres, err := c.querier.%s(ctx, req)\n", m.rpc)
+	fmt.Fprintf(w, "\tif err != nil {\n\t\treturn %serr\n\t}\n", zero)
+
+	rets := make([]string, 0, len(m.outputs))
+	for _, p := range m.outputs {
+		if expr, ok := g.outputExpr(p); ok {
+			rets = append(rets, expr)
+			continue
+		}
+		fmt.Fprintf(w, "\t// TODO: return res.%s as %s.\n", p.field, p.typ.sol)
+		rets = append(rets, zeroValue(p.typ.goOut))
+	}
+	rets = append(rets, "nil")
+	if len(m.outputs) == 0 {
+		w.WriteString("\t_ = res\n")
+	}
+	fmt.Fprintf(w, "\treturn %s\n}\n\n", strings.Join(rets, ", "))
+}
+
+// writeSignature writes the doc comment and signature of the Go method of the given precompile
+// method.
+func (g *generator) writeSignature(w *bytes.Buffer, m method, outs string) {
+	if outs != "" {
+		outs += ", "
+	}
+	args := make([]string, 0, len(m.inputs))
+	for _, p := range m.inputs {
+		args = append(args, p.name+" "+g.goType(p.typ.goIn))
+	}
+	fmt.Fprintf(w, "// %s implements the `%s` method.\n", camelCase(m.name), m.name)
+	fmt.Fprintf(w, "func (c *Contract) %s(\n\tctx context.Context,\n", camelCase(m.name))
+	for _, arg := range args {
+		fmt.Fprintf(w, "\t%s,\n", arg)
+	}
+	fmt.Fprintf(w, ") (%serror) {\n", outs)
+}
+
+// writeInputs writes the statements that set the request fields from the method arguments. The
+// given zero values are returned with any conversion error.
+func (g *generator) writeInputs(w *bytes.Buffer, m method, zero string) {
+	if zero != "" {
+		zero += ", "
+	}
+	target := "msg"
+	if m.query {
+		target = "req"
+	}
+	for _, p := range m.inputs {
+		expr, conv, ok := g.inputExpr(p)
+		if !ok {
+			fmt.Fprintf(w, "\t// TODO: set %s.%s from %s.\n\t_ = %s\n", target, p.field, p.name, p.name)
+			continue
+		}
+		if conv {
+			fmt.Fprintf(w, "\t%sValue, err := %s\n\tif err != nil {\n\t\treturn %serr\n\t}\n",
+				p.name, expr, zero)
+			expr = p.name + "Value"
+		}
+		fmt.Fprintf(w, "\t%s.%s = %s\n", target, p.field, expr)
+	}
+}
+
+// inputExpr returns the Go expression that converts the argument of the given param to its request
+// field, and whether the conversion returns an error. It returns false if the conversion is not
+// known.
+func (g *generator) inputExpr(p param) (string, bool, bool) {
+	if p.goType == nil || p.typ.encoded {
+		return "", false, false
+	}
+	switch field := p.goType.String(); {
+	case p.typ.abi == "address" && field == "string":
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		return fmt.Sprintf("cosmlib.MustStringFromEthAddress(c.addressCodec, %s)", p.name), false, true
+	case p.typ.abi == "uint256" && field == "math.Int":
+		g.useImport("cosmossdk.io/math", "sdkmath")
+		return fmt.Sprintf("sdkmath.NewIntFromBigInt(%s)", p.name), false, true
+	case p.typ.internal == "struct Cosmos.Coin[]" && field == "types.Coins":
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		return fmt.Sprintf("cosmlib.ExtractCoinsFromInput(%s)", p.name), true, true
+	case p.typ.internal == "struct Cosmos.Coin" && field == "types.Coin":
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		return fmt.Sprintf("cosmlib.ExtractCoinFromInputToCoin(%s)", p.name), true, true
+	case p.typ.internal == "struct Cosmos.PageRequest" && field == "*query.PageRequest":
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		return fmt.Sprintf("cosmlib.ExtractPageRequestFromInput(%s)", p.name), true, true
+	case field == strings.ReplaceAll(p.typ.goIn, "[]byte", "[]uint8"):
+		return p.name, false, true
+	default:
+		return "", false, false
+	}
+}
+
+// outputExpr returns the Go expression that converts the response field of the given param to its
+// return value. It returns false if the conversion is not known.
+func (g *generator) outputExpr(p param) (string, bool) {
+	if p.goType == nil || p.typ.encoded {
+		return "", false
+	}
+	res := "res." + p.field
+	switch field := p.goType.String(); {
+	case p.typ.abi == "uint256" && (field == "math.Int" || field == "math.LegacyDec"):
+		return res + ".BigInt()", true
+	case p.typ.internal == "struct Cosmos.Coin[]" && field == "types.Coins":
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		return fmt.Sprintf("cosmlib.SdkCoinsToEvmCoins(%s)", res), true
+	case p.typ.internal == "struct Cosmos.Coin" && field == "types.Coin":
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		return fmt.Sprintf("cosmlib.SdkCoinToEvmCoin(%s)", res), true
+	case p.typ.internal == "struct Cosmos.PageResponse" && field == "*query.PageResponse":
+		g.useImport("pkg.berachain.dev/polaris/cosmos/lib", "cosmlib")
+		return fmt.Sprintf("cosmlib.SdkPageResponseToEvmPageResponse(%s)", res), true
+	case p.typ.abi != "address" && field == strings.ReplaceAll(p.typ.goOut, "[]byte", "[]uint8"):
+		return res, true
+	default:
+		return "", false
+	}
+}
+
+// goType returns the given Go type of a method argument or return value, adding its import.
+func (g *generator) goType(typ string) string {
+	switch {
+	case strings.Contains(typ, "big.Int"):
+		g.useImport("math/big", "")
+	case strings.Contains(typ, "common.Address"):
+		g.useImport("pkg.berachain.dev/polaris/eth/common", "")
+	case strings.Contains(typ, "lib.Cosmos"):
+		g.useImport("pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib", "")
+	}
+	return typ
+}
+
+// useImport adds the given import with the given alias, which is empty for no alias.
+func (g *generator) useImport(path, alias string) {
+	g.imports[path] = alias
+}
+
+// zeroValue returns the Go zero value of the given Go type.
+func zeroValue(typ string) string {
+	switch {
+	case strings.HasPrefix(typ, "[]"), strings.HasPrefix(typ, "*"), typ == "any":
+		return "nil"
+	case typ == "bool":
+		return "false"
+	case typ == "string":
+		return `""`
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"):
+		return "0"
+	default:
+		return typ + "{}"
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompilegen_test
+
+import (
+	"strings"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	_ "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"pkg.berachain.dev/polaris/cosmos/cmd/molariscli/precompilegen"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPrecompileGen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/cmd/molariscli/precompilegen")
+}
+
+var _ = Describe("Precompile Generator", func() {
+	var cfg precompilegen.Config
+
+	findService := func(name string) protoreflect.ServiceDescriptor {
+		desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+		Expect(err).ToNot(HaveOccurred())
+		return desc.(protoreflect.ServiceDescriptor)
+	}
+
+	BeforeEach(func() {
+		cfg = precompilegen.Config{
+			Name:  "bank",
+			Msg:   findService("cosmos.bank.v1beta1.Msg"),
+			Query: findService("cosmos.bank.v1beta1.Query"),
+		}
+	})
+
+	It("should reject invalid configs", func() {
+		_, err := precompilegen.Generate(precompilegen.Config{Name: "bank"})
+		Expect(err).To(MatchError(precompilegen.ErrNoServices))
+
+		cfg.Name = "Bank-Module"
+		_, err = precompilegen.Generate(cfg)
+		Expect(err).To(MatchError(precompilegen.ErrInvalidName))
+	})
+
+	It("should generate the Solidity interface", func() {
+		out, err := precompilegen.Generate(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(out.Solidity).To(HavePrefix("// SPDX-License-Identifier: MIT"))
+		Expect(out.Solidity).To(ContainSubstring("interface IBankModule {"))
+		// the signer of the msg is the caller, so it is not an argument
+		Expect(out.Solidity).To(ContainSubstring(
+			"function send(address toAddress, Cosmos.Coin[] memory amount) external returns (bool);",
+		))
+		Expect(out.Solidity).To(ContainSubstring(
+			"function getBalance(address address_, string memory denom) external view " +
+				"returns (Cosmos.Coin memory balance);",
+		))
+	})
+
+	It("should generate a JSON ABI and Go bindings", func() {
+		out, err := precompilegen.Generate(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		contractABI, err := abi.JSON(strings.NewReader(out.ABI))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contractABI.Methods).To(HaveKey("send"))
+		Expect(contractABI.Methods["getBalance"].IsConstant()).To(BeTrue())
+		Expect(contractABI.Methods["send"].IsConstant()).To(BeFalse())
+
+		Expect(out.Bindings).To(ContainSubstring("package bank"))
+		Expect(out.Bindings).To(ContainSubstring("var BankModuleMetaData = &bind.MetaData{"))
+	})
+
+	It("should generate the skeletal Go contract", func() {
+		out, err := precompilegen.Generate(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(out.Contract).To(ContainSubstring("package bank"))
+		Expect(out.Contract).To(ContainSubstring(
+			`banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"`,
+		))
+		Expect(out.Contract).To(ContainSubstring("func (c *Contract) Send("))
+		Expect(out.Contract).To(ContainSubstring(
+			"msg.FromAddress = cosmlib.MustStringFromEthAddress(\n" +
+				"\t\tc.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),\n\t)",
+		))
+		Expect(out.Contract).To(ContainSubstring(
+			"amountValue, err := cosmlib.ExtractCoinsFromInput(amount)",
+		))
+		Expect(out.Contract).To(ContainSubstring("c.msgServer.Send(ctx, msg)"))
+		Expect(out.Contract).To(ContainSubstring("func (c *Contract) GetBalance("))
+		Expect(out.Contract).To(ContainSubstring("c.querier.Balance(ctx, req)"))
+	})
+})
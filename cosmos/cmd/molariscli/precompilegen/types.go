@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompilegen
+
+import (
+	"reflect"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// coinName, pageRequestName, and pageResponseName are the full names of the Protobuf messages
+	// that are mapped to the structs of the Cosmos Solidity library.
+	coinName         = "cosmos.base.v1beta1.Coin"
+	pageRequestName  = "cosmos.base.query.v1beta1.PageRequest"
+	pageResponseName = "cosmos.base.query.v1beta1.PageResponse"
+
+	// addressScalar and validatorAddressScalar are the Cosmos scalars of address strings.
+	addressScalar          = "cosmos.AddressString"
+	validatorAddressScalar = "cosmos.ValidatorAddressString"
+)
+
+// abiArg is an argument of a method in the JSON ABI.
+type abiArg struct {
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	InternalType string   `json:"internalType"`
+	Components   []abiArg `json:"components,omitempty"`
+}
+
+// solType is the Solidity type that a Protobuf field is mapped to.
+type solType struct {
+	// sol is the type in the Solidity interface, e.g. `Cosmos.Coin[]`.
+	sol string
+	// abi is the type in the JSON ABI, e.g. `tuple[]`.
+	abi string
+	// internal is the internal type in the JSON ABI, e.g. `struct Cosmos.Coin[]`.
+	internal string
+	// components are the components of a tuple type in the JSON ABI.
+	components []abiArg
+	// goIn is the Go type of the argument when unpacked by a precompile method.
+	goIn string
+	// goOut is the Go type of the value when returned by a precompile method.
+	goOut string
+	// location is the data location of the type in a Solidity function, if any.
+	location string
+	// encoded is true if the Protobuf message is not mapped to a Solidity type, but passed as its
+	// Protobuf encoding.
+	encoded bool
+}
+
+var (
+	coinComponents = []abiArg{
+		{Name: "amount", Type: "uint256", InternalType: "uint256"},
+		{Name: "denom", Type: "string", InternalType: "string"},
+	}
+	pageRequestComponents = []abiArg{
+		{Name: "key", Type: "string", InternalType: "string"},
+		{Name: "offset", Type: "uint64", InternalType: "uint64"},
+		{Name: "limit", Type: "uint64", InternalType: "uint64"},
+		{Name: "countTotal", Type: "bool", InternalType: "bool"},
+		{Name: "reverse", Type: "bool", InternalType: "bool"},
+	}
+	pageResponseComponents = []abiArg{
+		{Name: "nextKey", Type: "string", InternalType: "string"},
+		{Name: "total", Type: "uint64", InternalType: "uint64"},
+	}
+)
+
+// elementary returns the Solidity type of an elementary ABI type.
+func elementary(typ, goType string) solType {
+	st := solType{sol: typ, abi: typ, internal: typ, goIn: goType, goOut: goType}
+	if typ == "string" || typ == "bytes" {
+		st.location = "memory"
+	}
+	return st
+}
+
+// library returns the Solidity type of a struct of the Cosmos Solidity library.
+func library(name string, components []abiArg) solType {
+	return solType{
+		sol:        "Cosmos." + name,
+		abi:        "tuple",
+		internal:   "struct Cosmos." + name,
+		components: components,
+		goIn:       "any",
+		goOut:      "lib.Cosmos" + name,
+		location:   "memory",
+	}
+}
+
+// mapField returns the Solidity type of the given Protobuf field. Address strings are mapped to
+// `address`, and Protobuf messages without a Solidity equivalent are passed as their encoding.
+func mapField(fd protoreflect.FieldDescriptor) solType {
+	var st solType
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		st = elementary("bool", "bool")
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.EnumKind:
+		st = elementary("int32", "int32")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		st = elementary("uint32", "uint32")
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		st = elementary("int64", "int64")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		st = elementary("uint64", "uint64")
+	case protoreflect.StringKind:
+		if isAddress(fd) {
+			st = elementary("address", "common.Address")
+		} else {
+			st = elementary("string", "string")
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch {
+		case fd.IsMap():
+			st = elementary("bytes", "[]byte")
+			st.encoded = true
+		case fd.Message().FullName() == coinName:
+			st = library("Coin", coinComponents)
+		case fd.Message().FullName() == pageRequestName:
+			st = library("PageRequest", pageRequestComponents)
+		case fd.Message().FullName() == pageResponseName:
+			st = library("PageResponse", pageResponseComponents)
+		default:
+			st = elementary("bytes", "[]byte")
+			st.encoded = true
+		}
+	default: // protoreflect.BytesKind and any other kind are passed as bytes
+		st = elementary("bytes", "[]byte")
+	}
+
+	if fd.IsList() {
+		st.sol += "[]"
+		st.abi += "[]"
+		st.internal += "[]"
+		st.goIn = sliceOf(st.goIn)
+		st.goOut = "[]" + st.goOut
+		st.location = "memory"
+	}
+	return st
+}
+
+// sliceOf returns the Go type of a slice of the given argument type.
+func sliceOf(goType string) string {
+	if goType == "any" {
+		return "any"
+	}
+	return "[]" + goType
+}
+
+// isAddress returns whether the given string field holds a bech32 address, either by its Cosmos
+// scalar or, for fields without one, by its name.
+func isAddress(fd protoreflect.FieldDescriptor) bool {
+	if scalar, ok := proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string); ok &&
+		scalar != "" {
+		return scalar == addressScalar || scalar == validatorAddressScalar
+	}
+	name := string(fd.Name())
+	return name == "address" || strings.HasSuffix(name, "_address") ||
+		name == "authority" || name == "sender" || name == "signer"
+}
+
+// goFieldType returns the type of the field with the given Protobuf name in the given Go message
+// struct, or nil if it has no such field.
+func goFieldType(msgType reflect.Type, protoName protoreflect.Name) reflect.Type {
+	if msgType == nil {
+		return nil
+	}
+	for msgType.Kind() == reflect.Ptr {
+		msgType = msgType.Elem()
+	}
+	if msgType.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < msgType.NumField(); i++ {
+		for _, part := range strings.Split(msgType.Field(i).Tag.Get("protobuf"), ",") {
+			if part == "name="+string(protoName) {
+				return msgType.Field(i).Type
+			}
+		}
+	}
+	return nil
+}
+
+// goFieldName returns the name of the Go struct field generated for the given Protobuf field.
+func goFieldName(protoName protoreflect.Name) string {
+	return camelCase(string(protoName))
+}
+
+// camelCase converts a snake_case Protobuf name to CamelCase, like the Protobuf Go generators.
+func camelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// lowerCamelCase converts a snake_case or CamelCase name to lowerCamelCase.
+func lowerCamelCase(name string) string {
+	name = camelCase(name)
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// reserved are the Solidity and Go keywords and the locals of the generated Go methods, which
+// cannot be used as parameter names.
+var reserved = map[string]struct{}{
+	"address": {}, "bool": {}, "string": {}, "bytes": {}, "int": {}, "uint": {}, "byte": {},
+	"mapping": {}, "contract": {}, "function": {}, "event": {}, "error": {}, "type": {},
+	"delete": {}, "new": {}, "return": {}, "returns": {}, "memory": {}, "storage": {},
+	"calldata": {}, "payable": {}, "view": {}, "pure": {}, "external": {}, "internal": {},
+	"public": {}, "private": {}, "constant": {}, "immutable": {}, "override": {}, "virtual": {},
+	"struct": {}, "enum": {}, "modifier": {}, "emit": {}, "if": {}, "else": {}, "for": {},
+	"while": {}, "do": {}, "break": {}, "continue": {}, "try": {}, "catch": {}, "var": {},
+	"case": {}, "chan": {}, "const": {}, "default": {}, "defer": {}, "fallthrough": {}, "func": {},
+	"go": {}, "goto": {}, "import": {}, "interface": {}, "map": {}, "package": {}, "range": {},
+	"select": {}, "switch": {}, "ctx": {}, "msg": {}, "req": {}, "res": {}, "err": {}, "c": {},
+}
+
+// paramName returns the lowerCamelCase parameter name of the given Protobuf field name, with a
+// trailing underscore if it is reserved.
+func paramName(name string) string {
+	name = lowerCamelCase(name)
+	if _, isReserved := reserved[name]; isReserved {
+		return name + "_"
+	}
+	return name
+}
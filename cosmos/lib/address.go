@@ -21,22 +21,65 @@
 package lib
 
 import (
+	"reflect"
+
 	"cosmossdk.io/core/address"
 
+	"github.com/ethereum/go-ethereum/common/lru"
+
 	"pkg.berachain.dev/polaris/eth/common"
 )
 
+// addressCacheSize is the maximum number of conversions that are cached in each direction.
+const addressCacheSize = 4096
+
+type (
+	// stringCacheKey is the key of a cached conversion from a Cosmos SDK address string.
+	stringCacheKey struct {
+		codec address.Codec
+		addr  string
+	}
+
+	// ethAddressCacheKey is the key of a cached conversion from an Ethereum `Address`.
+	ethAddressCacheKey struct {
+		codec      address.Codec
+		ethAddress common.Address
+	}
+)
+
+var (
+	// ethAddressCache caches the results of `EthAddressFromString`, as bech32 decoding is called
+	// multiple times per precompile invocation. The cache is bounded and safe for concurrent use.
+	ethAddressCache = lru.NewCache[stringCacheKey, common.Address](addressCacheSize)
+
+	// stringCache caches the results of `StringFromEthAddress`, as bech32 encoding is called
+	// multiple times per precompile invocation. The cache is bounded and safe for concurrent use.
+	stringCache = lru.NewCache[ethAddressCacheKey, string](addressCacheSize)
+)
+
 ///////////////////////////////////////////////////////////////////////////////
 // AccAddress, ValAddress, ConsAddress
 ///////////////////////////////////////////////////////////////////////////////
 
 // EthAddressFromString converts a Cosmos SDK address string to an Ethereum `Address`.
 func EthAddressFromString(codec address.Codec, addr string) (common.Address, error) {
+	cacheable := isCacheable(codec)
+	key := stringCacheKey{codec, addr}
+	if cacheable {
+		if ethAddress, found := ethAddressCache.Get(key); found {
+			return ethAddress, nil
+		}
+	}
+
 	bz, err := codec.StringToBytes(addr)
 	if err != nil {
 		return common.Address{}, err
 	}
-	return common.BytesToAddress(bz), nil
+	ethAddress := common.BytesToAddress(bz)
+	if cacheable {
+		ethAddressCache.Add(key, ethAddress)
+	}
+	return ethAddress, nil
 }
 
 // MustEthAddressFromString converts a Cosmos SDK address string to an Ethereum `Address`. It
@@ -51,7 +94,22 @@ func MustEthAddressFromString(codec address.Codec, addr string) common.Address {
 
 // StringFromEthAddress converts an Ethereum `Address` to a Cosmos SDK address string.
 func StringFromEthAddress(codec address.Codec, ethAddress common.Address) (string, error) {
-	return codec.BytesToString(ethAddress.Bytes())
+	cacheable := isCacheable(codec)
+	key := ethAddressCacheKey{codec, ethAddress}
+	if cacheable {
+		if addr, found := stringCache.Get(key); found {
+			return addr, nil
+		}
+	}
+
+	addr, err := codec.BytesToString(ethAddress.Bytes())
+	if err != nil {
+		return "", err
+	}
+	if cacheable {
+		stringCache.Add(key, addr)
+	}
+	return addr, nil
 }
 
 // MustStringFromEthAddress converts an Ethereum `Address` to a Cosmos SDK address string. It
@@ -63,3 +121,10 @@ func MustStringFromEthAddress(codec address.Codec, ethAddress common.Address) st
 	}
 	return addr
 }
+
+// isCacheable returns true if conversions with the given codec can be cached. The codec is part of
+// the cache key, so it must be comparable (e.g. a bech32 codec, which is compared by its prefix);
+// conversions with any other codec are not cached.
+func isCacheable(codec address.Codec) bool {
+	return codec != nil && reflect.TypeOf(codec).Comparable()
+}
@@ -72,4 +72,20 @@ var _ = Describe("Address", func() {
 		)
 		Expect(bech32Str).To(Equal("cosmosvaloper1ekxyevx8lyazka9nu532r3a7xklpl0rnhhvl3k"))
 	})
+	It("should return the same results for cached conversions", func() {
+		accCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+		valCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+
+		for i := 0; i < 2; i++ {
+			Expect(cosmlib.MustStringFromEthAddress(accCodec, addr)).To(Equal(bech32))
+			Expect(cosmlib.MustEthAddressFromString(accCodec, bech32)).To(Equal(addr))
+
+			// the same address with a different codec must not hit the cached conversion.
+			Expect(cosmlib.MustStringFromEthAddress(valCodec, addr)).To(
+				Equal("cosmosvaloper1ekxyevx8lyazka9nu532r3a7xklpl0rnhhvl3k"),
+			)
+			_, err := cosmlib.EthAddressFromString(valCodec, bech32)
+			Expect(err).To(HaveOccurred())
+		}
+	})
 })
@@ -24,8 +24,6 @@ import (
 	"context"
 	"math/big"
 
-	sdkmath "cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/eth/common"
@@ -41,8 +39,12 @@ func MintCoinsToAddress(
 	amount *big.Int,
 ) error {
 	// Mint the corresponding bank denom.
-	coins := sdk.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)}}
-	if err := bk.MintCoins(ctx, moduleAcc, coins); err != nil {
+	coin, err := NewSdkCoin(denom, amount)
+	if err != nil {
+		return err
+	}
+	coins := sdk.Coins{coin}
+	if err = bk.MintCoins(ctx, moduleAcc, coins); err != nil {
 		return err
 	}
 
@@ -60,8 +62,12 @@ func BurnCoinsFromAddress(
 	amount *big.Int,
 ) error {
 	// Burn the corresponding bank denom.
-	coins := sdk.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)}}
-	if err := bk.SendCoinsFromAccountToModule(ctx, sender.Bytes(), moduleAcc, coins); err != nil {
+	coin, err := NewSdkCoin(denom, amount)
+	if err != nil {
+		return err
+	}
+	coins := sdk.Coins{coin}
+	if err = bk.SendCoinsFromAccountToModule(ctx, sender.Bytes(), moduleAcc, coins); err != nil {
 		return err
 	}
 
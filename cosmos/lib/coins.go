@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib
+
+import (
+	"math/big"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// AmountPolicy is the policy for extracting coins with a certain kind of amount (e.g. zero).
+type AmountPolicy uint8
+
+const (
+	// RejectAmount returns an error for a coin with the amount.
+	RejectAmount AmountPolicy = iota
+	// RemoveAmount removes a coin with the amount from the extracted coins.
+	RemoveAmount
+)
+
+// CoinPolicy configures how `ExtractCoins` handles coins with zero and negative amounts.
+type CoinPolicy struct {
+	// Zero is the policy for coins with a zero amount.
+	Zero AmountPolicy
+	// Negative is the policy for coins with a negative amount.
+	Negative AmountPolicy
+}
+
+// DefaultCoinPolicy removes coins with a zero amount, as Cosmos expects, and rejects coins with a
+// negative amount.
+var DefaultCoinPolicy = CoinPolicy{Zero: RemoveAmount, Negative: RejectAmount}
+
+// ExtractCoins converts coins from input into sdk.Coins. The input can be a slice or array of any
+// struct (or pointer to struct) with an `Amount *big.Int` and a `Denom string` field, such as the
+// anonymous structs unpacked by the ABI and the coin structs of the generated bindings. Coins with
+// zero or negative amounts are handled according to the given policy, and the coins are sorted
+// by denom. An error is returned if any coin has an invalid denom or amount, if a denom is
+// repeated, or if no coins remain.
+func ExtractCoins(coins any, policy CoinPolicy) (sdk.Coins, error) {
+	rv := reflect.ValueOf(coins)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, precompile.ErrInvalidCoin
+	}
+
+	sdkCoins := make(sdk.Coins, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		denom, amount, ok := coinFields(rv.Index(i))
+		if !ok {
+			return nil, precompile.ErrInvalidCoin
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, errorslib.Wrap(precompile.ErrInvalidDenom, err.Error())
+		}
+
+		// apply the policy for zero and negative amounts.
+		if amount != nil {
+			switch {
+			case amount.Sign() == 0 && policy.Zero == RemoveAmount:
+				continue
+			case amount.Sign() == 0:
+				return nil, errorslib.Wrap(precompile.ErrZeroAmount, denom)
+			case amount.Sign() < 0 && policy.Negative == RemoveAmount:
+				continue
+			}
+		}
+
+		coin, err := NewSdkCoin(denom, amount)
+		if err != nil {
+			return nil, err
+		}
+		sdkCoins = append(sdkCoins, coin)
+	}
+	if len(sdkCoins) == 0 {
+		return nil, precompile.ErrInvalidCoin
+	}
+
+	// sort the coins by denom, as Cosmos expects, and reject repeated denoms.
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, errorslib.Wrap(precompile.ErrInvalidCoin, err.Error())
+	}
+	return sdkCoins, nil
+}
+
+// ExtractCoin converts a coin from input into an sdk.Coin. The input can be any struct (or pointer
+// to struct) with an `Amount *big.Int` and a `Denom string` field. An error is returned if the
+// coin has an invalid denom or amount.
+func ExtractCoin(coin any) (sdk.Coin, error) {
+	denom, amount, ok := coinFields(reflect.ValueOf(coin))
+	if !ok {
+		return sdk.Coin{}, precompile.ErrInvalidCoin
+	}
+	return NewSdkCoin(denom, amount)
+}
+
+// coinFields returns the `Denom` and `Amount` fields of the given coin struct value. It returns
+// false if the value is not a struct with these fields.
+func coinFields(v reflect.Value) (string, *big.Int, bool) {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return "", nil, false
+	}
+
+	denomField := v.FieldByName("Denom")
+	amountField := v.FieldByName("Amount")
+	if !denomField.IsValid() || denomField.Kind() != reflect.String ||
+		!amountField.IsValid() || !amountField.CanInterface() {
+		return "", nil, false
+	}
+	amount, ok := amountField.Interface().(*big.Int)
+	if !ok {
+		return "", nil, false
+	}
+	return denomField.String(), amount, true
+}
@@ -21,7 +21,10 @@
 package lib
 
 import (
+	"encoding/base64"
+	"math"
 	"math/big"
+	"time"
 
 	"cosmossdk.io/core/address"
 	sdkmath "cosmossdk.io/math"
@@ -35,6 +38,7 @@ import (
 	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/governance"
 	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/staking"
 	"pkg.berachain.dev/polaris/cosmos/precompile"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
@@ -60,44 +64,27 @@ func SdkCoinToEvmCoin(coin sdk.Coin) libgenerated.CosmosCoin {
 	return evmCoin
 }
 
+// SdkPageResponseToEvmPageResponse converts a Cosmos SDK page response into
+// libgenerated.CosmosPageResponse. The next key is base64 encoded (see `NextPageKeyToString`).
 func SdkPageResponseToEvmPageResponse(pageResponse *query.PageResponse) libgenerated.CosmosPageResponse {
 	if pageResponse == nil {
 		return libgenerated.CosmosPageResponse{}
 	}
 	return libgenerated.CosmosPageResponse{
-		NextKey: string(pageResponse.GetNextKey()),
+		NextKey: NextPageKeyToString(pageResponse.GetNextKey()),
 		Total:   pageResponse.GetTotal(),
 	}
 }
 
-// ExtractCoinsFromInput converts coins from input (of type any) into sdk.Coins.
+// ExtractCoinsFromInput converts coins from input (of type any) into sdk.Coins, with the
+// `DefaultCoinPolicy` (see `ExtractCoins`).
 func ExtractCoinsFromInput(coins any) (sdk.Coins, error) {
-	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
-	// the any type input into IBankModuleCoin.
-	amounts, ok := utils.GetAs[[]struct {
-		Amount *big.Int `json:"amount"`
-		Denom  string   `json:"denom"`
-	}](coins)
-	if !ok {
-		return nil, precompile.ErrInvalidCoin
-	}
-
-	sdkCoins := sdk.Coins{}
-	for _, evmCoin := range amounts {
-		sdkCoins = append(sdkCoins, sdk.Coin{
-			Denom: evmCoin.Denom, Amount: sdkmath.NewIntFromBigInt(evmCoin.Amount),
-		})
-	}
-	// sort the coins by denom, as Cosmos expects and remove any 0 amounts.
-	sdkCoins = sdk.NewCoins(sdkCoins...)
-	if len(sdkCoins) == 0 {
-		return nil, precompile.ErrInvalidCoin
-	}
-
-	return sdkCoins, nil
+	return ExtractCoins(coins, DefaultCoinPolicy)
 }
 
-func ExtractPageRequestFromInput(pageRequest any) *query.PageRequest {
+// ExtractPageRequestFromInput converts a page request from input (of type any) into a Cosmos SDK
+// page request. The key is decoded with `PageKeyFromString`.
+func ExtractPageRequestFromInput(pageRequest any) (*query.PageRequest, error) {
 	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
 	// the any type input into the contract's generated type.
 	pageReq, ok := utils.GetAs[struct {
@@ -108,32 +95,79 @@ func ExtractPageRequestFromInput(pageRequest any) *query.PageRequest {
 		Reverse    bool   `json:"reverse"`
 	}](pageRequest)
 	if !ok {
-		return nil
+		return nil, nil
 	}
 
+	key, err := PageKeyFromString(pageReq.Key)
+	if err != nil {
+		return nil, err
+	}
 	return &query.PageRequest{
-		Key:        []byte(pageReq.Key),
+		Key:        key,
 		Offset:     pageReq.Offset,
 		Limit:      pageReq.Limit,
 		CountTotal: pageReq.CountTotal,
 		Reverse:    pageReq.Reverse,
+	}, nil
+}
+
+// NextPageKeyToString encodes the raw bytes of a Cosmos SDK page response's next key as a base64
+// string, so that it is safe to handle as a Solidity string (the raw bytes are generally not valid
+// UTF-8). An empty key is encoded as the empty string, which marks the last page.
+func NextPageKeyToString(nextKey []byte) string {
+	if len(nextKey) == 0 {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(nextKey)
+}
+
+// PageKeyFromString decodes the key of a page request passed by a contract into raw bytes. The key
+// must be empty or the base64 encoding of a next key returned by `NextPageKeyToString`, otherwise
+// an `ErrInvalidPageKey` error is returned.
+func PageKeyFromString(key string) ([]byte, error) {
+	if key == "" {
+		return nil, nil
+	}
+	bz, err := base64.StdEncoding.Strict().DecodeString(key)
+	if err != nil {
+		return nil, errorslib.Wrap(precompile.ErrInvalidPageKey, err.Error())
 	}
+	return bz, nil
 }
 
-// ExtractCoinFromInputToCoin converts a coin from input (of type any) into sdk.Coins.
+// ExtractCoinFromInputToCoin converts a coin from input (of type any) into sdk.Coin (see
+// `ExtractCoin`).
 func ExtractCoinFromInputToCoin(coin any) (sdk.Coin, error) {
-	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
-	// the any type input into IBankModuleCoin.
-	amounts, ok := utils.GetAs[struct {
-		Amount *big.Int `json:"amount"`
-		Denom  string   `json:"denom"`
-	}](coin)
-	if !ok {
-		return sdk.Coin{}, precompile.ErrInvalidCoin
+	return ExtractCoin(coin)
+}
+
+// NewSdkCoin creates an sdk.Coin from a denom and amount passed by a contract. Unlike
+// `sdk.NewCoin`, it returns an error instead of panicking if the denom is invalid or the amount
+// cannot be an `sdkmath.Int`.
+func NewSdkCoin(denom string, amount *big.Int) (sdk.Coin, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.Coin{}, errorslib.Wrap(precompile.ErrInvalidDenom, err.Error())
 	}
+	sdkAmount, err := SdkIntFromBigInt(amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.Coin{Denom: denom, Amount: sdkAmount}, nil
+}
 
-	sdkCoin := sdk.NewCoin(amounts.Denom, sdkmath.NewIntFromBigInt(amounts.Amount))
-	return sdkCoin, nil
+// SdkIntFromBigInt converts an amount passed by a contract into an `sdkmath.Int`. Unlike
+// `sdkmath.NewIntFromBigInt`, it returns an error instead of panicking if the amount exceeds the
+// 256 bit bound of `sdkmath.Int`, and it rejects nil and negative amounts.
+func SdkIntFromBigInt(amount *big.Int) (sdkmath.Int, error) {
+	switch {
+	case amount == nil:
+		return sdkmath.Int{}, precompile.ErrInvalidBigInt
+	case amount.Sign() < 0:
+		return sdkmath.Int{}, errorslib.Wrap(precompile.ErrNegativeAmount, amount.String())
+	case amount.BitLen() > sdkmath.MaxBitLen:
+		return sdkmath.Int{}, precompile.ErrAmountOverflow
+	}
+	return sdkmath.NewIntFromBigInt(amount), nil
 }
 
 // SdkUDEToStakingUDE converts a Cosmos SDK Unbonding Delegation Entry list to a geth compatible
@@ -143,7 +177,7 @@ func SdkUDEToStakingUDE(ude []stakingtypes.UnbondingDelegationEntry) []staking.I
 	for i, entry := range ude {
 		entries[i] = staking.IStakingModuleUnbondingDelegationEntry{
 			CreationHeight: entry.CreationHeight,
-			CompletionTime: entry.CompletionTime.String(),
+			CompletionTime: TimeToUnix(entry.CompletionTime),
 			InitialBalance: entry.InitialBalance.BigInt(),
 			Balance:        entry.Balance.BigInt(),
 		}
@@ -158,14 +192,33 @@ func SdkREToStakingRE(re []stakingtypes.RedelegationEntry) []staking.IStakingMod
 	for i, entry := range re {
 		entries[i] = staking.IStakingModuleRedelegationEntry{
 			CreationHeight: entry.CreationHeight,
-			CompletionTime: entry.CompletionTime.String(),
+			CompletionTime: TimeToUnix(entry.CompletionTime),
 			InitialBalance: entry.InitialBalance.BigInt(),
-			SharesDst:      entry.SharesDst.BigInt(),
+			SharesDst:      DecToBigIntScaled(entry.SharesDst, DecDecimals),
 		}
 	}
 	return entries
 }
 
+// TimeToUnix converts a time into the unix time in seconds that is returned to contracts. Times
+// before the unix epoch are converted to 0.
+func TimeToUnix(t time.Time) uint64 {
+	if unix := t.Unix(); unix > 0 {
+		return uint64(unix)
+	}
+	return 0
+}
+
+// UnixToTimeString converts a unix time in seconds, as returned to contracts, into the string that
+// was returned to contracts before timestamps were returned as unix times. It is a compatibility
+// shim for consumers of the previous string timestamps, e.g. `CompletionTime`.
+func UnixToTimeString(unix uint64) string {
+	if unix > math.MaxInt64 {
+		unix = math.MaxInt64
+	}
+	return time.Unix(int64(unix), 0).UTC().String()
+}
+
 // SdkValidatorsToStakingValidators converts a Cosmos SDK Validator list to a geth compatible list
 // of Validators.
 func SdkValidatorsToStakingValidators(valAddrCodec address.Codec, vals []stakingtypes.Validator) (
@@ -181,21 +234,24 @@ func SdkValidatorsToStakingValidators(valAddrCodec address.Codec, vals []staking
 		if err != nil {
 			return nil, err
 		}
+		// the key type tells contracts which algorithm to verify the validator's signatures with.
 		valsOut[i] = staking.IStakingModuleValidator{
 			OperatorAddr:    operEthAddr,
 			ConsAddr:        pubKey.Address(),
+			ConsPubKeyType:  pubKey.Type(),
+			ConsPubKey:      pubKey.Bytes(),
 			Jailed:          val.Jailed,
 			Status:          val.Status.String(),
 			Tokens:          val.Tokens.BigInt(),
-			DelegatorShares: val.DelegatorShares.BigInt(),
+			DelegatorShares: DecToBigIntScaled(val.DelegatorShares, DecDecimals),
 			Description:     staking.IStakingModuleDescription(val.Description),
 			UnbondingHeight: val.UnbondingHeight,
 			UnbondingTime:   val.UnbondingTime.String(),
 			Commission: staking.IStakingModuleCommission{
 				CommissionRates: staking.IStakingModuleCommissionRates{
-					Rate:          val.Commission.CommissionRates.Rate.BigInt(),
-					MaxRate:       val.Commission.CommissionRates.MaxRate.BigInt(),
-					MaxChangeRate: val.Commission.CommissionRates.MaxChangeRate.BigInt(),
+					Rate:          DecToBigIntScaled(val.Commission.CommissionRates.Rate, DecDecimals),
+					MaxRate:       DecToBigIntScaled(val.Commission.CommissionRates.MaxRate, DecDecimals),
+					MaxChangeRate: DecToBigIntScaled(val.Commission.CommissionRates.MaxChangeRate, DecDecimals),
 				},
 			},
 			MinSelfDelegation:       val.MinSelfDelegation.BigInt(),
@@ -207,11 +263,13 @@ func SdkValidatorsToStakingValidators(valAddrCodec address.Codec, vals []staking
 }
 
 // SdkProposalToGovProposal is a helper function to transform a `v1.Proposal` to an
-// `IGovernanceModule.Proposal`.
-func SdkProposalToGovProposal(proposal v1.Proposal) governance.IGovernanceModuleProposal {
-	message := make([]byte, 0)
-	for _, msg := range proposal.Messages {
-		message = append(message, msg.Value...)
+// `IGovernanceModule.Proposal`. The proposal messages are decoded with the given decoder.
+func SdkProposalToGovProposal(
+	proposal v1.Proposal, decoder *ProposalMsgDecoder,
+) (governance.IGovernanceModuleProposal, error) {
+	messages, err := decoder.Decode(proposal.Messages)
+	if err != nil {
+		return governance.IGovernanceModuleProposal{}, err
 	}
 
 	totalDeposit := make([]governance.CosmosCoin, 0)
@@ -223,9 +281,9 @@ func SdkProposalToGovProposal(proposal v1.Proposal) governance.IGovernanceModule
 	}
 
 	return governance.IGovernanceModuleProposal{
-		Id:      proposal.Id,
-		Message: message,
-		Status:  int32(proposal.Status), // Status is an alias for int32.
+		Id:       proposal.Id,
+		Messages: messages,
+		Status:   int32(proposal.Status), // Status is an alias for int32.
 		FinalTallyResult: governance.IGovernanceModuleTallyResult{
 			YesCount:        proposal.FinalTallyResult.YesCount,
 			AbstainCount:    proposal.FinalTallyResult.AbstainCount,
@@ -241,5 +299,5 @@ func SdkProposalToGovProposal(proposal v1.Proposal) governance.IGovernanceModule
 		Title:           proposal.Title,
 		Summary:         proposal.Summary,
 		Proposer:        proposal.Proposer,
-	}
+	}, nil
 }
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib_test
+
+import (
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	libgenerated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Conversions", func() {
+	type evmCoin = struct {
+		Amount *big.Int `json:"amount"`
+		Denom  string   `json:"denom"`
+	}
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	When("extracting coins from input", func() {
+		It("should sort the coins and remove 0 amounts", func() {
+			coins, err := cosmlib.ExtractCoinsFromInput([]evmCoin{
+				{Amount: big.NewInt(2), Denom: "bbb"},
+				{Amount: big.NewInt(0), Denom: "ccc"},
+				{Amount: maxUint256, Denom: "aaa"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coins).To(Equal(sdk.Coins{
+				sdk.NewCoin("aaa", sdkmath.NewIntFromBigInt(maxUint256)),
+				sdk.NewInt64Coin("bbb", 2),
+			}))
+		})
+
+		It("should reject invalid coins without panicking", func() {
+			_, err := cosmlib.ExtractCoinsFromInput([]evmCoin{{Amount: big.NewInt(-1), Denom: "abera"}})
+			Expect(err).To(MatchError(precompile.ErrNegativeAmount))
+
+			_, err = cosmlib.ExtractCoinsFromInput([]evmCoin{
+				{Amount: new(big.Int).Lsh(big.NewInt(1), 256), Denom: "abera"},
+			})
+			Expect(err).To(MatchError(precompile.ErrAmountOverflow))
+
+			_, err = cosmlib.ExtractCoinsFromInput([]evmCoin{{Amount: nil, Denom: "abera"}})
+			Expect(err).To(MatchError(precompile.ErrInvalidBigInt))
+
+			for _, denom := range []string{"", "a", "1abc", "abc def", string(make([]byte, 1024))} {
+				_, err = cosmlib.ExtractCoinsFromInput([]evmCoin{{Amount: big.NewInt(1), Denom: denom}})
+				Expect(err).To(MatchError(precompile.ErrInvalidDenom))
+			}
+
+			_, err = cosmlib.ExtractCoinsFromInput([]evmCoin{
+				{Amount: big.NewInt(1), Denom: "abera"},
+				{Amount: big.NewInt(2), Denom: "abera"},
+			})
+			Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+
+			_, err = cosmlib.ExtractCoinsFromInput([]evmCoin{{Amount: big.NewInt(0), Denom: "abera"}})
+			Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+		})
+	})
+
+	When("extracting coins of any shape with a policy", func() {
+		It("should extract coins from named binding structs and pointers", func() {
+			coins, err := cosmlib.ExtractCoins([]libgenerated.CosmosCoin{
+				{Amount: big.NewInt(2), Denom: "bbb"},
+				{Amount: big.NewInt(1), Denom: "aaa"},
+			}, cosmlib.DefaultCoinPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coins).To(Equal(sdk.Coins{sdk.NewInt64Coin("aaa", 1), sdk.NewInt64Coin("bbb", 2)}))
+
+			coins, err = cosmlib.ExtractCoins([]*libgenerated.CosmosCoin{
+				{Amount: big.NewInt(1), Denom: "aaa"},
+			}, cosmlib.DefaultCoinPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coins).To(Equal(sdk.Coins{sdk.NewInt64Coin("aaa", 1)}))
+
+			coin, err := cosmlib.ExtractCoin(libgenerated.CosmosCoin{Amount: big.NewInt(1), Denom: "aaa"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coin).To(Equal(sdk.NewInt64Coin("aaa", 1)))
+		})
+
+		It("should apply the zero and negative amount policies", func() {
+			input := []libgenerated.CosmosCoin{
+				{Amount: big.NewInt(0), Denom: "aaa"},
+				{Amount: big.NewInt(-1), Denom: "bbb"},
+				{Amount: big.NewInt(1), Denom: "ccc"},
+			}
+
+			_, err := cosmlib.ExtractCoins(input, cosmlib.DefaultCoinPolicy)
+			Expect(err).To(MatchError(precompile.ErrNegativeAmount))
+
+			_, err = cosmlib.ExtractCoins(input, cosmlib.CoinPolicy{
+				Zero: cosmlib.RejectAmount, Negative: cosmlib.RemoveAmount,
+			})
+			Expect(err).To(MatchError(precompile.ErrZeroAmount))
+
+			coins, err := cosmlib.ExtractCoins(input, cosmlib.CoinPolicy{
+				Zero: cosmlib.RemoveAmount, Negative: cosmlib.RemoveAmount,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coins).To(Equal(sdk.Coins{sdk.NewInt64Coin("ccc", 1)}))
+		})
+
+		It("should reject input that is not a list of coins", func() {
+			for _, input := range []any{
+				nil,
+				libgenerated.CosmosCoin{Amount: big.NewInt(1), Denom: "aaa"},
+				[]string{"1aaa"},
+				[]struct{ Denom string }{{Denom: "aaa"}},
+				[]struct {
+					Amount int64
+					Denom  string
+				}{{Amount: 1, Denom: "aaa"}},
+				[]*libgenerated.CosmosCoin{nil},
+			} {
+				_, err := cosmlib.ExtractCoins(input, cosmlib.DefaultCoinPolicy)
+				Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+			}
+		})
+	})
+
+	When("extracting a coin from input", func() {
+		It("should extract a valid coin", func() {
+			coin, err := cosmlib.ExtractCoinFromInputToCoin(evmCoin{Amount: big.NewInt(1), Denom: "abera"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coin).To(Equal(sdk.NewInt64Coin("abera", 1)))
+		})
+
+		It("should reject an invalid coin without panicking", func() {
+			_, err := cosmlib.ExtractCoinFromInputToCoin(evmCoin{Amount: big.NewInt(-1), Denom: "abera"})
+			Expect(err).To(MatchError(precompile.ErrNegativeAmount))
+
+			_, err = cosmlib.ExtractCoinFromInputToCoin(evmCoin{Amount: big.NewInt(1), Denom: "!"})
+			Expect(err).To(MatchError(precompile.ErrInvalidDenom))
+		})
+	})
+	When("converting page keys", func() {
+		It("should round-trip the next key of a page response", func() {
+			nextKey := []byte{0x14, 0xff, 0x00, 0xfe, 'a'}
+			pageRes := cosmlib.SdkPageResponseToEvmPageResponse(&query.PageResponse{NextKey: nextKey})
+			Expect(pageRes.NextKey).To(Equal("FP8A/mE="))
+
+			pageReq, err := cosmlib.ExtractPageRequestFromInput(struct {
+				Key        string `json:"key"`
+				Offset     uint64 `json:"offset"`
+				Limit      uint64 `json:"limit"`
+				CountTotal bool   `json:"count_total"`
+				Reverse    bool   `json:"reverse"`
+			}{Key: pageRes.NextKey, Limit: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pageReq.Key).To(Equal(nextKey))
+			Expect(pageReq.Limit).To(Equal(uint64(1)))
+		})
+
+		It("should only decode base64 keys", func() {
+			Expect(cosmlib.PageKeyFromString("")).To(BeNil())
+			Expect(cosmlib.PageKeyFromString("FP8A")).To(Equal([]byte{0x14, 0xff, 0x00}))
+
+			_, err := cosmlib.PageKeyFromString("0x14ff00")
+			Expect(err).To(MatchError(precompile.ErrInvalidPageKey))
+			_, err = cosmlib.PageKeyFromString("not base64!")
+			Expect(err).To(MatchError(precompile.ErrInvalidPageKey))
+		})
+
+		It("should encode an empty next key as an empty string", func() {
+			Expect(cosmlib.NextPageKeyToString(nil)).To(BeEmpty())
+			Expect(cosmlib.SdkPageResponseToEvmPageResponse(nil).NextKey).To(BeEmpty())
+		})
+	})
+	When("converting validators", func() {
+		It("should expose the consensus public key and its algorithm", func() {
+			valCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+			pubKeys := []cryptotypes.PubKey{
+				ed25519.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+			}
+			vals := make([]stakingtypes.Validator, len(pubKeys))
+			for i, pubKey := range pubKeys {
+				operator, err := valCodec.BytesToString(pubKey.Address())
+				Expect(err).ToNot(HaveOccurred())
+				vals[i], err = stakingtypes.NewValidator(operator, pubKey, stakingtypes.Description{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			res, err := cosmlib.SdkValidatorsToStakingValidators(valCodec, vals)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(2))
+			Expect(res[0].ConsPubKeyType).To(Equal("ed25519"))
+			Expect(res[0].ConsPubKey).To(Equal(pubKeys[0].Bytes()))
+			Expect(res[0].ConsPubKey).To(HaveLen(32))
+			Expect(res[1].ConsPubKeyType).To(Equal("secp256k1"))
+			Expect(res[1].ConsPubKey).To(Equal(pubKeys[1].Bytes()))
+			Expect(res[1].ConsPubKey).To(HaveLen(33))
+		})
+	})
+	When("converting timestamps", func() {
+		It("should return unbonding and redelegation completion times as unix seconds", func() {
+			completion := time.Unix(1700000000, 500).UTC()
+			udes := cosmlib.SdkUDEToStakingUDE([]stakingtypes.UnbondingDelegationEntry{
+				stakingtypes.NewUnbondingDelegationEntry(1, completion, sdkmath.NewInt(10), 1),
+			})
+			Expect(udes).To(HaveLen(1))
+			Expect(udes[0].CompletionTime).To(Equal(uint64(1700000000)))
+
+			res := cosmlib.SdkREToStakingRE([]stakingtypes.RedelegationEntry{
+				stakingtypes.NewRedelegationEntry(
+					1, completion, sdkmath.NewInt(10), sdkmath.LegacyOneDec(), 1,
+				),
+			})
+			Expect(res).To(HaveLen(1))
+			Expect(res[0].CompletionTime).To(Equal(uint64(1700000000)))
+		})
+
+		It("should convert times before the unix epoch to 0", func() {
+			Expect(cosmlib.TimeToUnix(time.Time{})).To(BeZero())
+		})
+
+		It("should convert unix seconds to the previous string timestamps", func() {
+			completion := time.Unix(1700000000, 0).UTC()
+			Expect(cosmlib.UnixToTimeString(cosmlib.TimeToUnix(completion))).To(Equal(completion.String()))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+)
+
+// DecDecimals is the number of decimals of the fixed-point integers that represent decimals (e.g.
+// commission rates and delegator shares) in the precompile ABIs. It is the precision of
+// `sdkmath.LegacyDec`, so that 0.05 is represented as 5e16.
+const DecDecimals = sdkmath.LegacyPrecision
+
+// DecToBigIntScaled converts the given decimal to a fixed-point integer with the given number of
+// decimals, e.g. 0.05 to 5e16 with 18 decimals. If the number of decimals is lower than the
+// precision of the decimal, the digits that do not fit are truncated towards zero.
+func DecToBigIntScaled(dec sdkmath.LegacyDec, decimals uint8) *big.Int {
+	if dec.IsNil() {
+		return new(big.Int)
+	}
+
+	i := dec.BigInt()
+	switch {
+	case int(decimals) < DecDecimals:
+		return i.Quo(i, pow10(DecDecimals-int(decimals)))
+	case int(decimals) > DecDecimals:
+		return i.Mul(i, pow10(int(decimals)-DecDecimals))
+	default:
+		return i
+	}
+}
+
+// BigIntToDec converts the given fixed-point integer with the given number of decimals to a
+// decimal, e.g. 5e16 with 18 decimals to 0.05. It returns an error if the integer has more
+// significant decimals than the precision of the decimal, instead of silently truncating them.
+func BigIntToDec(i *big.Int, decimals uint8) (sdkmath.LegacyDec, error) {
+	if i == nil {
+		return sdkmath.LegacyDec{}, precompile.ErrInvalidBigInt
+	}
+
+	if int(decimals) <= DecDecimals {
+		return sdkmath.LegacyNewDecFromBigIntWithPrec(i, int64(decimals)), nil
+	}
+
+	quo, rem := new(big.Int).QuoRem(i, pow10(int(decimals)-DecDecimals), new(big.Int))
+	if rem.Sign() != 0 {
+		return sdkmath.LegacyDec{}, precompile.ErrPrecisionLoss
+	}
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(quo, DecDecimals), nil
+}
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	//nolint:gomnd // base 10.
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
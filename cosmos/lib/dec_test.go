@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dec", func() {
+	rate := sdkmath.LegacyNewDecWithPrec(5, 2) // 0.05
+
+	It("should scale decimals to fixed-point integers", func() {
+		Expect(cosmlib.DecToBigIntScaled(rate, cosmlib.DecDecimals).String()).
+			To(Equal("50000000000000000"))
+		Expect(cosmlib.DecToBigIntScaled(rate, 6).String()).To(Equal("50000"))
+		Expect(cosmlib.DecToBigIntScaled(rate, 0).String()).To(Equal("0"))
+		Expect(cosmlib.DecToBigIntScaled(rate, 20).String()).To(Equal("5000000000000000000"))
+		Expect(cosmlib.DecToBigIntScaled(sdkmath.LegacyDec{}, 18).String()).To(Equal("0"))
+
+		// the digits beyond the given decimals are truncated towards zero
+		Expect(cosmlib.DecToBigIntScaled(sdkmath.LegacyMustNewDecFromStr("-1.25"), 1).String()).
+			To(Equal("-12"))
+	})
+
+	It("should convert fixed-point integers to decimals", func() {
+		dec, err := cosmlib.BigIntToDec(big.NewInt(5e16), cosmlib.DecDecimals)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dec.Equal(rate)).To(BeTrue())
+
+		dec, err = cosmlib.BigIntToDec(big.NewInt(50_000), 6)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dec.Equal(rate)).To(BeTrue())
+
+		dec, err = cosmlib.BigIntToDec(new(big.Int).Mul(big.NewInt(5e16), big.NewInt(100)), 20)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dec.Equal(rate)).To(BeTrue())
+	})
+
+	It("should not silently lose precision", func() {
+		_, err := cosmlib.BigIntToDec(big.NewInt(1), 19)
+		Expect(err).To(MatchError(precompile.ErrPrecisionLoss))
+
+		_, err = cosmlib.BigIntToDec(nil, cosmlib.DecDecimals)
+		Expect(err).To(MatchError(precompile.ErrInvalidBigInt))
+	})
+
+	It("should round-trip decimals", func() {
+		for _, s := range []string{"0", "1", "0.000000000000000001", "123.456", "-7.5"} {
+			dec := sdkmath.LegacyMustNewDecFromStr(s)
+			res, err := cosmlib.BigIntToDec(
+				cosmlib.DecToBigIntScaled(dec, cosmlib.DecDecimals), cosmlib.DecDecimals,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Equal(dec)).To(BeTrue())
+		}
+	})
+})
@@ -82,25 +82,41 @@ func CallEVMFromPrecompile(
 	methodName string,
 	args ...any,
 ) ([]byte, error) {
+	input, err := contract.Pack(methodName, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret, _, err := CallEVMFromPrecompileRaw(ctx, plugin, evm, caller, address, input, value)
+	return ret, err
+}
+
+// CallEVMFromPrecompileRaw calls into the EVM from a precompile contract with the given calldata,
+// and returns the raw return data and the gas used by the call.
+func CallEVMFromPrecompileRaw(
+	ctx sdk.Context,
+	plugin ethprecompile.Plugin,
+	evm vm.PrecompileEVM,
+	caller common.Address,
+	address common.Address,
+	input []byte,
+	value *big.Int,
+) ([]byte, uint64, error) {
 	if utils.MustGetAs[precompile.MultiStore](ctx.MultiStore()).IsReadOnly() {
-		return nil, vm.ErrWriteProtection
+		return nil, 0, vm.ErrWriteProtection
 	}
 
 	plugin.EnableReentrancy(evm)
 	defer plugin.DisableReentrancy(evm)
 
-	input, err := contract.Pack(methodName, args...)
-	if err != nil {
-		return nil, err
-	}
 	suppliedGas := ctx.GasMeter().GasRemaining()
 	ret, gasRemaining, err := evm.Call(
 		vm.AccountRef(caller), address, input, suppliedGas, value,
 	)
 
 	// consume gas used by EVM during contract call
-	ctx.GasMeter().ConsumeGas(suppliedGas-gasRemaining, methodName)
-	return ret, err
+	gasUsed := suppliedGas - gasRemaining
+	ctx.GasMeter().ConsumeGas(gasUsed, "EVM call "+address.Hex())
+	return ret, gasUsed, err
 }
 
 // CallEVMFromPrecompileUnpackArgs calls into the EVM from a precompile contract and returns the
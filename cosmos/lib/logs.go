@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+const (
+	// EventTypeEvmLog is the type of the Cosmos events of EVM logs that are not decoded with an
+	// ABI.
+	EventTypeEvmLog = "evm_log"
+	// AttributeKeyLogAddress is the attribute key of the address of the contract that emitted an
+	// EVM log.
+	AttributeKeyLogAddress = "address"
+	// AttributeKeyLogTopicPrefix is the prefix of the attribute keys of the topics of an EVM log,
+	// which is followed by the index of the topic, e.g. `topic_0`.
+	AttributeKeyLogTopicPrefix = "topic_"
+	// AttributeKeyLogData is the attribute key of the data of an EVM log.
+	AttributeKeyLogData = "data"
+
+	// attributeIntBase is the base that integer attribute values are formatted in, 10.
+	attributeIntBase = 10
+)
+
+// EmitLogsAsEvents converts the given EVM logs into Cosmos events (see `LogToEvent`) and emits
+// them, in order, on the event manager of the given context. The logs of a contract are decoded
+// with its ABI in `abis`, if any.
+func EmitLogsAsEvents(ctx sdk.Context, logs []*coretypes.Log, abis map[common.Address]*abi.ABI) {
+	events := make(sdk.Events, len(logs))
+	for i, log := range logs {
+		events[i] = LogToEvent(log, abis[log.Address])
+	}
+	ctx.EventManager().EmitEvents(events)
+}
+
+// LogToEvent converts an EVM log into a Cosmos event, which is the reverse of the translation of
+// precompile events into logs. If the log was emitted by an event of the given contract ABI, the
+// event type is the name of the event and every argument of the event is an attribute, both in
+// under_score format. Otherwise, or if the log does not decode with the ABI, the event is of type
+// `evm_log` and every topic and the data of the log are hex attributes. In both cases, the first
+// attribute is the address of the contract that emitted the log.
+func LogToEvent(log *coretypes.Log, contractABI *abi.ABI) sdk.Event {
+	if contractABI != nil && len(log.Topics) > 0 {
+		if abiEvent, err := contractABI.EventByID(log.Topics[0]); err == nil && !abiEvent.Anonymous {
+			if event, err := decodeLog(log, abiEvent); err == nil {
+				return event
+			}
+		}
+	}
+
+	attrs := make([]sdk.Attribute, 0, len(log.Topics)+2) //nolint:gomnd // address and data.
+	attrs = append(attrs, sdk.NewAttribute(AttributeKeyLogAddress, log.Address.Hex()))
+	for i, topic := range log.Topics {
+		attrs = append(attrs, sdk.NewAttribute(
+			AttributeKeyLogTopicPrefix+strconv.Itoa(i), topic.Hex(),
+		))
+	}
+	attrs = append(attrs, sdk.NewAttribute(AttributeKeyLogData, hexutil.Encode(log.Data)))
+	return sdk.NewEvent(EventTypeEvmLog, attrs...)
+}
+
+// decodeLog decodes an EVM log, which was emitted by the given ABI event, into a Cosmos event with
+// an attribute for every argument of the event. Indexed arguments of dynamic types are only
+// available as the hash stored in the topic.
+func decodeLog(log *coretypes.Log, abiEvent *abi.Event) (sdk.Event, error) {
+	values := make(map[string]any, len(abiEvent.Inputs))
+	if err := abiEvent.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+		return sdk.Event{}, err
+	}
+	indexed := abi.GetIndexed(abiEvent.Inputs)
+	if err := abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+		return sdk.Event{}, err
+	}
+
+	attrs := make([]sdk.Attribute, 0, len(abiEvent.Inputs)+1)
+	attrs = append(attrs, sdk.NewAttribute(AttributeKeyLogAddress, log.Address.Hex()))
+	for _, input := range abiEvent.Inputs {
+		// the values of unnamed arguments cannot be told apart.
+		if input.Name == "" {
+			return sdk.Event{}, fmt.Errorf("unnamed argument of event %s", abiEvent.Name)
+		}
+		attrs = append(attrs, sdk.NewAttribute(
+			abi.ToUnderScore(input.Name), attributeValue(values[input.Name]),
+		))
+	}
+	return sdk.NewEvent(abi.ToUnderScore(abiEvent.Name), attrs...), nil
+}
+
+// attributeValue returns the string representation of a decoded event argument, which can be
+// decoded again by the default attribute value decoders of the precompile log factory. Values of
+// elementary types are formatted as base 10 integers, hex addresses and bytes, strings, and bools;
+// all other values (i.e. arrays and tuples) are JSON encoded.
+func attributeValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() { //nolint:exhaustive // all other kinds are JSON encoded.
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), attributeIntBase)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), attributeIntBase)
+	case reflect.Array:
+		// fixed size bytes are hex encoded.
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bz), rv)
+			return hexutil.Encode(bz)
+		}
+	}
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(bz)
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tbindings "pkg.berachain.dev/polaris/contracts/bindings/testing"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logs", func() {
+	var (
+		erc20ABI *abi.ABI
+		token    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		from     = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		to       = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		log      *coretypes.Log
+	)
+
+	BeforeEach(func() {
+		var err error
+		erc20ABI, err = tbindings.SolmateERC20MetaData.GetAbi()
+		Expect(err).ToNot(HaveOccurred())
+
+		transfer := erc20ABI.Events["Transfer"]
+		topics, err := abi.MakeTopics([]any{transfer.ID}, []any{from}, []any{to})
+		Expect(err).ToNot(HaveOccurred())
+		data, err := transfer.Inputs.NonIndexed().Pack(big.NewInt(100))
+		Expect(err).ToNot(HaveOccurred())
+		log = &coretypes.Log{
+			Address: token,
+			Topics:  []common.Hash{topics[0][0], topics[1][0], topics[2][0]},
+			Data:    data,
+		}
+	})
+
+	It("should convert a log into an event with the ABI", func() {
+		Expect(cosmlib.LogToEvent(log, erc20ABI)).To(Equal(sdk.NewEvent(
+			"transfer",
+			sdk.NewAttribute(cosmlib.AttributeKeyLogAddress, token.Hex()),
+			sdk.NewAttribute("from", from.Hex()),
+			sdk.NewAttribute("to", to.Hex()),
+			sdk.NewAttribute("value", "100"),
+		)))
+	})
+
+	It("should convert a log into a raw event without the ABI", func() {
+		Expect(cosmlib.LogToEvent(log, nil)).To(Equal(sdk.NewEvent(
+			cosmlib.EventTypeEvmLog,
+			sdk.NewAttribute(cosmlib.AttributeKeyLogAddress, token.Hex()),
+			sdk.NewAttribute("topic_0", log.Topics[0].Hex()),
+			sdk.NewAttribute("topic_1", log.Topics[1].Hex()),
+			sdk.NewAttribute("topic_2", log.Topics[2].Hex()),
+			sdk.NewAttribute(cosmlib.AttributeKeyLogData, "0x"+common.Bytes2Hex(log.Data)),
+		)))
+	})
+
+	It("should convert a log that does not decode with the ABI into a raw event", func() {
+		log.Topics = log.Topics[:2]
+		Expect(cosmlib.LogToEvent(log, erc20ABI).Type).To(Equal(cosmlib.EventTypeEvmLog))
+	})
+
+	It("should emit the events of logs in order", func() {
+		ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+		other := &coretypes.Log{Address: from, Topics: log.Topics, Data: log.Data}
+		cosmlib.EmitLogsAsEvents(
+			ctx, []*coretypes.Log{log, other}, map[common.Address]*abi.ABI{token: erc20ABI},
+		)
+
+		events := ctx.EventManager().Events()
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Type).To(Equal("transfer"))
+		Expect(events[1].Type).To(Equal(cosmlib.EventTypeEvmLog))
+	})
+})
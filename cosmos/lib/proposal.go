@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib
+
+import (
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/governance"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// MsgSendArguments are the ABI arguments that a bank `MsgSend` proposal message is encoded with
+// by `NewMsgSendEncoder`, i.e. `(address fromAddress, address toAddress, Cosmos.Coin[] amount)`.
+var MsgSendArguments = abi.Arguments{
+	{Name: "fromAddress", Type: mustNewType("address", nil)},
+	{Name: "toAddress", Type: mustNewType("address", nil)},
+	{Name: "amount", Type: mustNewType("tuple[]", []abi.ArgumentMarshaling{
+		{Name: "amount", Type: "uint256"},
+		{Name: "denom", Type: "string"},
+	})},
+}
+
+// ProposalMsgEncoder ABI encodes a proposal message of a registered type.
+type ProposalMsgEncoder func(msg sdk.Msg) ([]byte, error)
+
+// ProposalMsgDecoder decodes the messages of a governance proposal into (type URL, payload) pairs.
+// The payload of a message is ABI encoded if an encoder is registered for its type, so that
+// contracts can inspect what the proposal does, and is the protobuf encoding of the message
+// otherwise.
+type ProposalMsgDecoder struct {
+	unpacker codectypes.AnyUnpacker
+	encoders map[string]ProposalMsgEncoder
+}
+
+// NewProposalMsgDecoder creates a new `ProposalMsgDecoder`, without any registered encoders, which
+// unpacks proposal messages with the given unpacker.
+func NewProposalMsgDecoder(unpacker codectypes.AnyUnpacker) *ProposalMsgDecoder {
+	return &ProposalMsgDecoder{
+		unpacker: unpacker,
+		encoders: make(map[string]ProposalMsgEncoder),
+	}
+}
+
+// RegisterEncoder registers the encoder for messages of the same type as the given message. It
+// replaces the encoder that was previously registered for the type, if any.
+func (d *ProposalMsgDecoder) RegisterEncoder(msg sdk.Msg, encoder ProposalMsgEncoder) {
+	d.encoders[sdk.MsgTypeURL(msg)] = encoder
+}
+
+// Decode decodes the given proposal messages.
+func (d *ProposalMsgDecoder) Decode(
+	anyMsgs []*codectypes.Any,
+) ([]governance.IGovernanceModuleProposalMessage, error) {
+	messages := make([]governance.IGovernanceModuleProposalMessage, len(anyMsgs))
+	for i, anyMsg := range anyMsgs {
+		messages[i] = governance.IGovernanceModuleProposalMessage{
+			TypeUrl: anyMsg.TypeUrl,
+			Value:   anyMsg.Value,
+		}
+
+		encoder, found := d.encoders[anyMsg.TypeUrl]
+		if !found {
+			continue
+		}
+		var msg sdk.Msg
+		if err := d.unpacker.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, errorslib.Wrapf(precompile.ErrInvalidAny, "message %d: %v", i, err)
+		}
+		value, err := encoder(msg)
+		if err != nil {
+			return nil, errorslib.Wrapf(err, "message %d", i)
+		}
+		messages[i].Value = value
+	}
+	return messages, nil
+}
+
+// NewMsgSendEncoder returns the encoder of bank `MsgSend` proposal messages, which encodes them
+// with `MsgSendArguments`. The addresses are converted with the given account address codec.
+func NewMsgSendEncoder(addressCodec address.Codec) ProposalMsgEncoder {
+	return func(msg sdk.Msg) ([]byte, error) {
+		send, ok := msg.(*banktypes.MsgSend)
+		if !ok {
+			return nil, precompile.ErrInvalidAny
+		}
+		from, err := EthAddressFromString(addressCodec, send.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+		to, err := EthAddressFromString(addressCodec, send.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+		return MsgSendArguments.Pack(from, to, SdkCoinsToEvmCoins(send.Amount))
+	}
+}
+
+// mustNewType returns the ABI type with the given type and components. It panics if the type is
+// invalid.
+func mustNewType(t string, components []abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib
+
+import (
+	"context"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// EvmDecimals is the number of decimals of the amounts that precompiles present to Solidity, as
+// for ether and most ERC20 tokens.
+const EvmDecimals = 18
+
+// DenomExponent returns the exponent of the display unit of the given base denom, as registered
+// in its bank denom metadata, e.g. 6 for `uatom` with the display unit `atom`.
+func DenomExponent(ctx context.Context, dmk DenomMetadataKeeper, denom string) (uint32, error) {
+	metadata, found := dmk.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0, errorslib.Wrap(precompile.ErrNoDenomMetadata, denom)
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent, nil
+		}
+	}
+	return 0, errorslib.Wrapf(precompile.ErrNoDenomMetadata, "%s has no display unit", denom)
+}
+
+// ScaleToEvm scales the amount of the given coin, whose denom has the given exponent, to an
+// amount with `EvmDecimals` decimals, e.g. 1uatom (exponent 6) to 1e12. The amounts of denoms
+// with up to 18 decimals are scaled exactly, and the amounts of denoms with more decimals are
+// rounded down.
+func ScaleToEvm(coin sdk.Coin, exponent uint32) *big.Int {
+	amount := coin.Amount.BigInt()
+	if amount == nil {
+		return new(big.Int)
+	}
+	if exponent <= EvmDecimals {
+		return amount.Mul(amount, pow10(EvmDecimals-int(exponent)))
+	}
+	return amount.Quo(amount, pow10(int(exponent)-EvmDecimals))
+}
+
+// ScaleFromEvm scales the given amount with `EvmDecimals` decimals to an amount of a denom with
+// the given exponent, e.g. 1e12 to 1uatom (exponent 6). The amount is rounded down to the
+// precision of the denom, so that scaling the result back with `ScaleToEvm` never gives more than
+// the given amount; the remainder of less than one unit of the denom is dropped. It returns an
+// error if the amount is negative or the scaled amount does not fit in an `sdkmath.Int`.
+func ScaleFromEvm(amount *big.Int, exponent uint32) (sdkmath.Int, error) {
+	if amount == nil || amount.Sign() < 0 {
+		return sdkmath.Int{}, precompile.ErrInvalidBigInt
+	}
+
+	scaled := new(big.Int)
+	if exponent <= EvmDecimals {
+		scaled.Quo(amount, pow10(EvmDecimals-int(exponent)))
+	} else {
+		scaled.Mul(amount, pow10(int(exponent)-EvmDecimals))
+	}
+	if scaled.BitLen() > sdkmath.MaxBitLen {
+		return sdkmath.Int{}, errorslib.Wrap(precompile.ErrInvalidBigInt, "scaled amount overflows")
+	}
+	return sdkmath.NewIntFromBigInt(scaled), nil
+}
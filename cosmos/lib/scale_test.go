@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scale", func() {
+	It("should get the exponent of a denom from its metadata", func() {
+		dmk := mockDenomMetadataKeeper{
+			"uatom": {
+				Base:    "uatom",
+				Display: "atom",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "uatom", Exponent: 0},
+					{Denom: "atom", Exponent: 6},
+				},
+			},
+			"ufoo": {Base: "ufoo", Display: "foo"},
+		}
+		exponent, err := cosmlib.DenomExponent(context.Background(), dmk, "uatom")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exponent).To(Equal(uint32(6)))
+
+		_, err = cosmlib.DenomExponent(context.Background(), dmk, "ufoo")
+		Expect(err).To(MatchError(precompile.ErrNoDenomMetadata))
+		_, err = cosmlib.DenomExponent(context.Background(), dmk, "ubar")
+		Expect(err).To(MatchError(precompile.ErrNoDenomMetadata))
+	})
+
+	It("should scale amounts to 18 decimals", func() {
+		Expect(cosmlib.ScaleToEvm(sdk.NewInt64Coin("uatom", 1), 6).String()).
+			To(Equal("1000000000000"))
+		Expect(cosmlib.ScaleToEvm(sdk.NewInt64Coin("abera", 1), 18).String()).To(Equal("1"))
+		Expect(cosmlib.ScaleToEvm(sdk.NewInt64Coin("ufoo", 199), 20).String()).To(Equal("1"))
+	})
+
+	It("should scale amounts from 18 decimals, rounding down", func() {
+		amount, err := cosmlib.ScaleFromEvm(big.NewInt(1_999_999_999_999), 6)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(amount.Equal(sdkmath.NewInt(1))).To(BeTrue())
+
+		amount, err = cosmlib.ScaleFromEvm(big.NewInt(1), 20)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(amount.Equal(sdkmath.NewInt(100))).To(BeTrue())
+
+		_, err = cosmlib.ScaleFromEvm(big.NewInt(-1), 6)
+		Expect(err).To(MatchError(precompile.ErrInvalidBigInt))
+		_, err = cosmlib.ScaleFromEvm(new(big.Int).Lsh(big.NewInt(1), 256), 18)
+		Expect(err).To(MatchError(precompile.ErrInvalidBigInt))
+	})
+})
+
+// FuzzScale checks the rounding rules of scaling amounts to and from 18 decimals.
+func FuzzScale(f *testing.F) {
+	f.Add(uint64(1), uint8(6))
+	f.Add(uint64(1_999_999_999_999), uint8(6))
+	f.Add(uint64(123), uint8(18))
+	f.Add(uint64(199), uint8(20))
+	f.Fuzz(func(t *testing.T, amount uint64, exponent uint8) {
+		exp := uint32(exponent % 30)
+		evmAmount := new(big.Int).SetUint64(amount)
+
+		// scaling from 18 decimals rounds down by less than one unit of the denom
+		scaled, err := cosmlib.ScaleFromEvm(evmAmount, exp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		back := cosmlib.ScaleToEvm(sdk.NewCoin("denom", scaled), exp)
+		if back.Cmp(evmAmount) > 0 {
+			t.Fatalf("scaled %s back to %s, more than %d", scaled, back, amount)
+		}
+		if exp <= cosmlib.EvmDecimals {
+			unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(cosmlib.EvmDecimals-exp)), nil)
+			if new(big.Int).Sub(evmAmount, back).Cmp(unit) >= 0 {
+				t.Fatalf("scaled %d down to %s, dropping a unit of the denom", amount, back)
+			}
+		}
+
+		// scaling to 18 decimals is exact for denoms with up to 18 decimals
+		coin := sdk.NewCoin("denom", sdkmath.NewIntFromUint64(amount))
+		res, err := cosmlib.ScaleFromEvm(cosmlib.ScaleToEvm(coin, exp), exp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exp <= cosmlib.EvmDecimals && !res.Equal(coin.Amount) {
+			t.Fatalf("round-tripped %d to %s", amount, res)
+		}
+	})
+}
+
+type mockDenomMetadataKeeper map[string]banktypes.Metadata
+
+func (m mockDenomMetadataKeeper) GetDenomMetaData(
+	_ context.Context, denom string,
+) (banktypes.Metadata, bool) {
+	metadata, found := m[denom]
+	return metadata, found
+}
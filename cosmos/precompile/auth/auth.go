@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package auth
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/auth"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// AccountKeeper defines the account keeper methods required by the auth precompile.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// Contract is the precompile contract for the auth module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	ak      AccountKeeper
+	querier authtypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the auth module precompile contract.
+func NewPrecompileContract(ak AccountKeeper, q authtypes.QueryServer) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.AuthModuleMetaData.ABI,
+			// Precompile Address: 0xBDF49C3C3882102fc017FFb661108c63a836D065
+			common.BytesToAddress(authtypes.NewModuleAddress(authtypes.ModuleName)),
+		),
+		ak:      ak,
+		querier: q,
+	}
+}
+
+// GetAccountInfo implements `getAccountInfo(address)` method.
+func (c *Contract) GetAccountInfo(
+	ctx context.Context,
+	account common.Address,
+) (uint64, uint64, error) {
+	accAddr, err := cosmlib.StringFromEthAddress(c.ak.AddressCodec(), account)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	res, err := c.querier.AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: accAddr})
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.Info.AccountNumber, res.Info.Sequence, nil
+}
+
+// GetModuleAccountAddress implements `getModuleAccountAddress(string)` method.
+func (c *Contract) GetModuleAccountAddress(
+	_ context.Context,
+	name string,
+) (common.Address, error) {
+	moduleAddr := c.ak.GetModuleAddress(name)
+	if moduleAddr == nil {
+		return common.Address{}, errorslib.Wrap(precompile.ErrUnknownModuleAccount, name)
+	}
+	return common.BytesToAddress(moduleAddr), nil
+}
+
+// GetBech32Prefix implements `getBech32Prefix()` method.
+func (c *Contract) GetBech32Prefix(
+	ctx context.Context,
+) (string, error) {
+	res, err := c.querier.Bech32Prefix(ctx, &authtypes.Bech32PrefixRequest{})
+	if err != nil {
+		return "", err
+	}
+	return res.Bech32Prefix, nil
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package auth_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/auth"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAuthPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/auth")
+}
+
+var _ = Describe("Auth Precompile Test", func() {
+	var (
+		contract *auth.Contract
+		ak       authkeeper.AccountKeeper
+		sdkCtx   sdk.Context
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		sdkCtx, ak, _, _ = testutils.SetupMinimalKeepers()
+		contract = auth.NewPrecompileContract(ak, authkeeper.NewQueryServer(ak))
+		ctx = vm.NewPolarContext(sdkCtx, nil, common.Address{}, big.NewInt(0))
+	})
+
+	It("should have the auth module account address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(authtypes.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("GetAccountInfo", func() {
+		It("should return the account number and sequence", func() {
+			addr := sdk.AccAddress([]byte("alice"))
+			acc := ak.NewAccountWithAddress(sdkCtx, addr)
+			Expect(acc.SetSequence(7)).To(Succeed())
+			ak.SetAccount(sdkCtx, acc)
+
+			accNum, seq, err := contract.GetAccountInfo(ctx, common.BytesToAddress(addr))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accNum).To(Equal(acc.GetAccountNumber()))
+			Expect(seq).To(Equal(uint64(7)))
+		})
+
+		It("should fail if the account does not exist", func() {
+			_, _, err := contract.GetAccountInfo(ctx, common.BytesToAddress([]byte("bob")))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("GetModuleAccountAddress", func() {
+		It("should return the module account address", func() {
+			addr, err := contract.GetModuleAccountAddress(ctx, distrtypes.ModuleName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(addr).To(Equal(
+				common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName)),
+			))
+		})
+
+		It("should fail if the module account is unknown", func() {
+			_, err := contract.GetModuleAccountAddress(ctx, "unknown")
+			Expect(err).To(MatchError(precompile.ErrUnknownModuleAccount))
+		})
+	})
+
+	It("should return the bech32 prefix", func() {
+		prefix, err := contract.GetBech32Prefix(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prefix).To(Equal(types.Bech32Prefix))
+	})
+})
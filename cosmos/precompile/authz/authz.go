@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package authz
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/authz"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// Contract is the precompile contract for the authz module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	addressCodec address.Codec
+	cdc          codec.BinaryCodec
+	msgServer    sdkauthz.MsgServer
+	querier      sdkauthz.QueryServer
+
+	// allowedMsgs are the type URLs of the messages that `execute` may dispatch.
+	allowedMsgs map[string]struct{}
+}
+
+// NewPrecompileContract returns a new instance of the authz module precompile contract, which can
+// only execute messages with one of the given type URLs, e.g.
+// `/cosmos.staking.v1beta1.MsgDelegate`.
+func NewPrecompileContract(
+	ak cosmlib.CodecProvider,
+	cdc codec.BinaryCodec,
+	m sdkauthz.MsgServer,
+	q sdkauthz.QueryServer,
+	allowedMsgTypeURLs []string,
+) *Contract {
+	allowedMsgs := make(map[string]struct{}, len(allowedMsgTypeURLs))
+	for _, msgTypeURL := range allowedMsgTypeURLs {
+		allowedMsgs[msgTypeURL] = struct{}{}
+	}
+
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.AuthzModuleMetaData.ABI,
+			common.BytesToAddress(authtypes.NewModuleAddress(sdkauthz.ModuleName)),
+		),
+		addressCodec: ak.AddressCodec(),
+		cdc:          cdc,
+		msgServer:    m,
+		querier:      q,
+		allowedMsgs:  allowedMsgs,
+	}
+}
+
+// GetGrants implements `getGrants(address,address,PageRequest)` method.
+func (c *Contract) GetGrants(
+	ctx context.Context,
+	granter common.Address,
+	grantee common.Address,
+	pagination any,
+) ([]generated.IAuthzModuleGrant, lib.CosmosPageResponse, error) {
+	granterAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, granter)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+	granteeAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, grantee)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	res, err := c.querier.Grants(ctx, &sdkauthz.QueryGrantsRequest{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	grants := make([]generated.IAuthzModuleGrant, 0, len(res.Grants))
+	for _, grant := range res.Grants {
+		authorization, ok := grant.Authorization.GetCachedValue().(sdkauthz.Authorization)
+		if !ok {
+			return nil, lib.CosmosPageResponse{}, errorslib.Wrapf(
+				precompile.ErrInvalidGrantType, "%T", grant.Authorization.GetCachedValue(),
+			)
+		}
+
+		var expiration uint64
+		if grant.Expiration != nil {
+			expiration = uint64(grant.Expiration.Unix())
+		}
+
+		grants = append(grants, generated.IAuthzModuleGrant{
+			Authorization: grant.Authorization.TypeUrl,
+			MsgTypeUrl:    authorization.MsgTypeURL(),
+			Expiration:    expiration,
+		})
+	}
+
+	return grants, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
+}
+
+// IsMsgAllowed implements `isMsgAllowed(string)` method.
+func (c *Contract) IsMsgAllowed(
+	_ context.Context,
+	msgTypeURL string,
+) (bool, error) {
+	_, allowed := c.allowedMsgs[msgTypeURL]
+	return allowed, nil
+}
+
+// Grant implements `grant(address,string,uint64)` method.
+func (c *Contract) Grant(
+	ctx context.Context,
+	grantee common.Address,
+	msgTypeURL string,
+	expiry uint64,
+) (bool, error) {
+	granterAddr, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return false, err
+	}
+	granteeAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, grantee)
+	if err != nil {
+		return false, err
+	}
+
+	var expiration *time.Time
+	if expiry != 0 {
+		if expiry > math.MaxInt64 {
+			return false, errorslib.Wrapf(precompile.ErrInvalidUint64, "expiry %d", expiry)
+		}
+		expiryTime := time.Unix(int64(expiry), 0).UTC()
+		expiration = &expiryTime
+	}
+
+	grant, err := sdkauthz.NewGrant(
+		sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).BlockTime(),
+		sdkauthz.NewGenericAuthorization(msgTypeURL),
+		expiration,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = c.msgServer.Grant(ctx, &sdkauthz.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant:   grant,
+	})
+	return err == nil, err
+}
+
+// Revoke implements `revoke(address,string)` method.
+func (c *Contract) Revoke(
+	ctx context.Context,
+	grantee common.Address,
+	msgTypeURL string,
+) (bool, error) {
+	granterAddr, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return false, err
+	}
+	granteeAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, grantee)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = c.msgServer.Revoke(ctx, &sdkauthz.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	})
+	return err == nil, err
+}
+
+// Execute implements `execute(bytes[])` method.
+func (c *Contract) Execute(
+	ctx context.Context,
+	msgs [][]byte,
+) ([][]byte, error) {
+	granteeAddr, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anyMsgs := make([]*codectypes.Any, len(msgs))
+	for i, bz := range msgs {
+		anyMsg := new(codectypes.Any)
+		if err = c.cdc.Unmarshal(bz, anyMsg); err != nil {
+			return nil, errorslib.Wrapf(precompile.ErrInvalidAny, "msg %d: %v", i, err)
+		}
+		if _, allowed := c.allowedMsgs[anyMsg.TypeUrl]; !allowed {
+			return nil, ethprecompile.NewRevertError(
+				c.ABIErrors()["MsgNotAllowed"], precompile.ErrMsgNotAllowed,
+				big.NewInt(int64(i)), anyMsg.TypeUrl,
+			)
+		}
+
+		// cache the unpacked msg, which is required to dispatch it
+		var msg sdk.Msg
+		if err = c.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, errorslib.Wrapf(precompile.ErrInvalidAny, "msg %d: %v", i, err)
+		}
+		anyMsgs[i] = anyMsg
+	}
+
+	res, err := c.msgServer.Exec(ctx, &sdkauthz.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    anyMsgs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Results, nil
+}
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package authz_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	bankmodule "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/authz"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/authz"
+	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAuthzPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/authz")
+}
+
+var _ = Describe("Authz Precompile Test", func() {
+	var (
+		contract   *authz.Contract
+		cdc        codec.Codec
+		ak         authkeeper.AccountKeeper
+		bk         bankkeeper.BaseKeeper
+		sdkCtx     sdk.Context
+		granter    sdk.AccAddress
+		grantee    sdk.AccAddress
+		granterCtx context.Context
+		granteeCtx context.Context
+		sendURL    = sdk.MsgTypeURL(&banktypes.MsgSend{})
+		now        = time.Unix(1_700_000_000, 0).UTC()
+	)
+
+	BeforeEach(func() {
+		var baseCtx sdk.Context
+		baseCtx, ak, bk, _ = testutils.SetupMinimalKeepers()
+		sdkCtx = baseCtx.WithBlockTime(now)
+		encCfg := testutils.MakeTestEncodingConfig(
+			authzmodule.AppModuleBasic{},
+			bankmodule.AppModuleBasic{},
+		)
+		cdc = encCfg.Codec
+
+		// authz only accepts grants for, and executes, msgs with a registered handler
+		router := baseapp.NewMsgServiceRouter()
+		router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		banktypes.RegisterMsgServer(router, bankkeeper.NewMsgServerImpl(bk))
+		azk := authzkeeper.NewKeeper(
+			runtime.NewKVStoreService(storetypes.NewKVStoreKey(sdkauthz.ModuleName)),
+			cdc,
+			router,
+			ak,
+		)
+
+		contract = authz.NewPrecompileContract(ak, cdc, azk, azk, []string{sendURL})
+		granter = sdk.AccAddress([]byte("granter"))
+		grantee = sdk.AccAddress([]byte("grantee"))
+		granterCtx = testutil.NewCallerContext(sdkCtx, common.BytesToAddress(granter))
+		granteeCtx = testutil.NewCallerContext(sdkCtx, common.BytesToAddress(grantee))
+	})
+
+	It("should have the authz module account address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(sdkauthz.ModuleName)),
+		))
+	})
+
+	It("should report the allowed msg types", func() {
+		allowed, err := contract.IsMsgAllowed(granterCtx, sendURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		allowed, err = contract.IsMsgAllowed(granterCtx, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	When("Granting", func() {
+		It("should grant, query and revoke a generic authorization", func() {
+			expiry := uint64(now.Add(time.Hour).Unix())
+			ok, err := contract.Grant(granterCtx, common.BytesToAddress(grantee), sendURL, expiry)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			grants, _, err := contract.GetGrants(
+				granterCtx,
+				common.BytesToAddress(granter),
+				common.BytesToAddress(grantee),
+				nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grants).To(Equal([]generated.IAuthzModuleGrant{{
+				Authorization: sdk.MsgTypeURL(&sdkauthz.GenericAuthorization{}),
+				MsgTypeUrl:    sendURL,
+				Expiration:    expiry,
+			}}))
+
+			ok, err = contract.Revoke(granterCtx, common.BytesToAddress(grantee), sendURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			grants, _, err = contract.GetGrants(
+				granterCtx,
+				common.BytesToAddress(granter),
+				common.BytesToAddress(grantee),
+				nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grants).To(BeEmpty())
+		})
+
+		It("should grant without an expiration", func() {
+			_, err := contract.Grant(granterCtx, common.BytesToAddress(grantee), sendURL, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			grants, _, err := contract.GetGrants(
+				granterCtx,
+				common.BytesToAddress(granter),
+				common.BytesToAddress(grantee),
+				nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grants).To(HaveLen(1))
+			Expect(grants[0].Expiration).To(BeZero())
+		})
+
+		It("should fail if the expiry is in the past", func() {
+			expiry := uint64(now.Add(-time.Hour).Unix())
+			ok, err := contract.Grant(granterCtx, common.BytesToAddress(grantee), sendURL, expiry)
+			Expect(err).To(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should fail if the expiry overflows", func() {
+			_, err := contract.Grant(granterCtx, common.BytesToAddress(grantee), sendURL, 1<<63)
+			Expect(err).To(MatchError(precompile.ErrInvalidUint64))
+		})
+	})
+
+	When("Executing", func() {
+		var (
+			recipient = sdk.AccAddress([]byte("recipient"))
+			amount    = sdk.NewCoins(sdk.NewCoin("abera", sdkmath.NewInt(100)))
+		)
+
+		BeforeEach(func() {
+			Expect(bk.MintCoins(sdkCtx, evmtypes.ModuleName, amount)).To(Succeed())
+			Expect(bk.SendCoinsFromModuleToAccount(
+				sdkCtx, evmtypes.ModuleName, granter, amount,
+			)).To(Succeed())
+		})
+
+		marshalMsg := func(msg sdk.Msg) []byte {
+			anyMsg, err := codectypes.NewAnyWithValue(msg)
+			Expect(err).ToNot(HaveOccurred())
+			bz, err := cdc.Marshal(anyMsg)
+			Expect(err).ToNot(HaveOccurred())
+			return bz
+		}
+
+		It("should execute an allowed msg on behalf of the granter", func() {
+			_, err := contract.Grant(granterCtx, common.BytesToAddress(grantee), sendURL, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := contract.Execute(granteeCtx, [][]byte{
+				marshalMsg(banktypes.NewMsgSend(granter, recipient, amount)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(bk.GetAllBalances(sdkCtx, recipient)).To(Equal(amount))
+			Expect(bk.GetAllBalances(sdkCtx, granter)).To(BeEmpty())
+		})
+
+		It("should fail without a grant", func() {
+			_, err := contract.Execute(granteeCtx, [][]byte{
+				marshalMsg(banktypes.NewMsgSend(granter, recipient, amount)),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(bk.GetAllBalances(sdkCtx, granter)).To(Equal(amount))
+		})
+
+		It("should revert if a msg type is not allowed", func() {
+			res, err := contract.Execute(granteeCtx, [][]byte{
+				marshalMsg(banktypes.NewMsgSend(granter, recipient, amount)),
+				marshalMsg(banktypes.NewMsgMultiSend(
+					banktypes.NewInput(granter, amount),
+					[]banktypes.Output{banktypes.NewOutput(recipient, amount)},
+				)),
+			})
+			Expect(err).To(MatchError(precompile.ErrMsgNotAllowed))
+			Expect(res).To(BeNil())
+
+			name, args := customError(err)
+			Expect(name).To(Equal("MsgNotAllowed"))
+			Expect(args).To(Equal([]any{
+				big.NewInt(1), sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			}))
+		})
+
+		It("should fail if a msg is not an encoded any", func() {
+			_, err := contract.Execute(granteeCtx, [][]byte{{0xff}})
+			Expect(err).To(MatchError(precompile.ErrInvalidAny))
+		})
+	})
+})
+
+// customError returns the name and args of the custom error of the authz precompile ABI that the
+// given error reverts with.
+func customError(err error) (string, []any) {
+	var revertErr ethprecompile.RevertError
+	Expect(errors.As(err, &revertErr)).To(BeTrue())
+	data := revertErr.RevertData()
+
+	authzABI, abiErr := generated.AuthzModuleMetaData.GetAbi()
+	Expect(abiErr).ToNot(HaveOccurred())
+	for name, customErr := range authzABI.Errors {
+		if bytes.Equal(customErr.ID.Bytes()[:4], data[:4]) {
+			args, unpackErr := customErr.Inputs.Unpack(data[4:])
+			Expect(unpackErr).ToNot(HaveOccurred())
+			return name, args
+		}
+	}
+	Fail("unknown custom error")
+	return "", nil
+}
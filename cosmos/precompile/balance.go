@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+)
+
+// BalanceSyncerContextKey is the key in the base context of a PolarContext which holds the
+// `BalanceSyncer` of the precompile plugin.
+const BalanceSyncerContextKey vm.ContextKey = "balance-syncer"
+
+// BalanceSyncer syncs the EVM balance of an account with its bank balance.
+type BalanceSyncer interface {
+	SyncBalance(common.Address)
+}
+
+// SyncBalances syncs the EVM balances of the given accounts with their bank balances, which a
+// precompile must do for the accounts whose bank balances it changed. It is a no-op if the context
+// does not hold a `BalanceSyncer`, e.g. when the precompile is not run by the precompile plugin.
+func SyncBalances(ctx context.Context, addrs ...common.Address) {
+	bs, ok := ctx.Value(BalanceSyncerContextKey).(BalanceSyncer)
+	if !ok {
+		return
+	}
+	for _, addr := range addrs {
+		bs.SyncBalance(addr)
+	}
+}
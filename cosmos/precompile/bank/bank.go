@@ -21,23 +21,104 @@
 package bank
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"math"
 	"math/big"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
 	bankgenerated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/bank"
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
 	"pkg.berachain.dev/polaris/cosmos/precompile"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+const (
+	// readGas is the default fixed gas cost of the methods that read a bounded amount of state.
+	readGas uint64 = 1_000
+	// listGas is the default fixed gas cost of the methods that read or convert a list, which is
+	// charged in addition to `gasPerCoin` or `gasPerItem` for every element of the list.
+	listGas uint64 = 2_000
+	// writeGas is the default fixed gas cost of the methods that write to state.
+	writeGas uint64 = 10_000
+	// defaultGasPerCoin is the default gas cost of every coin returned by the queries over all
+	// denominations, so that their cost grows with the number of denominations.
+	defaultGasPerCoin uint64 = 500
+	// defaultGasPerItem is the default gas cost of every other element read or converted by the
+	// list and batch methods, so that their cost grows with the size of their input or result.
+	defaultGasPerItem uint64 = 500
 )
 
+const (
+	// transferEventSig and approvalEventSig are the signatures of the ERC20-style events, which
+	// are added to the StateDB directly instead of being translated from Cosmos events.
+	transferEventSig = "Transfer(address,address,uint256,string)"
+	approvalEventSig = "Approval(address,address,uint256,string)"
+	// burnEventSig is the signature of the `Burn` event, which `burn` also adds to the StateDB
+	// directly with the caller as burner.
+	burnEventSig = "Burn(address,(uint256,string)[])"
+)
+
+// defaultMethodGas is the default fixed gas cost of each method, keyed by method name. Overloads of
+// a method share the same cost.
+var defaultMethodGas = map[string]uint64{
+	"getBalance":              readGas,
+	"getBalances":             listGas,
+	"getBalanceAtHeight":      readGas,
+	"getAllBalances":          listGas,
+	"getSpendableBalance":     readGas,
+	"getSpendableBalances":    listGas,
+	"getAllSpendableBalances": listGas,
+	"getSupply":               readGas,
+	"getSupplyAt":             readGas,
+	"getAllSupply":            listGas,
+	"getDenomMetadata":        readGas,
+	"getDenomsMetadata":       listGas,
+	"getSendEnabled":          readGas,
+	"getAllowances":           listGas,
+	"getGrantsToSpender":      listGas,
+	"getEffectiveAllowance":   readGas,
+	"getSendAllowances":       readGas,
+	"isVestingAccount":        readGas,
+	"getAllLockedBalances":    listGas,
+	"getLockedCoins":          listGas,
+	"getVestingSchedule":      readGas,
+	"batchHexToBech32":        listGas,
+	"batchBech32ToHex":        listGas,
+	"send":                    writeGas,
+	"burn":                    writeGas,
+	"revoke":                  writeGas,
+	"decreaseAllowance":       writeGas,
+	"canCall":                 readGas,
+}
+
+// ParamsKeeper returns the x/evm params, which hold the restricted precompile methods.
+type ParamsKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
 // Contract is the precompile contract for the bank module.
 type Contract struct {
 	ethprecompile.BaseContract
@@ -45,13 +126,40 @@ type Contract struct {
 	addressCodec address.Codec
 	msgServer    banktypes.MsgServer
 	querier      banktypes.QueryServer
+	bankKeeper   cosmlib.BankKeeper
+	authzQuerier authz.QueryServer
+	authzServer  authz.MsgServer
+	authQuerier  authtypes.QueryServer
+
+	// transferEvent and approvalEvent are the ABI events of the ERC20-style logs.
+	transferEvent abi.Event
+	approvalEvent abi.Event
+	// burnEvent is the ABI event of the logs of `burn`.
+	burnEvent abi.Event
+
+	// getQueryContext returns the query context at a past height, used by historical queries.
+	getQueryContext func(height int64, prove bool) (sdk.Context, error)
+	// pk reads the x/evm params, which hold the restricted methods checked by `canCall`.
+	pk ParamsKeeper
+
+	// gasPerCoin is the gas cost of every coin returned by the queries over all denominations and
+	// gasPerItem is the gas cost of every other element of a list method. They are charged during
+	// execution, on top of the gas cost of the method in the gas table of the `BaseContract`.
+	gasPerCoin uint64
+	gasPerItem uint64
 }
 
 // NewPrecompileContract returns a new instance of the bank precompile contract.
 func NewPrecompileContract(
-	ak cosmlib.CodecProvider, ms banktypes.MsgServer, qs banktypes.QueryServer,
+	ak cosmlib.CodecProvider,
+	ms banktypes.MsgServer,
+	qs banktypes.QueryServer,
+	bk cosmlib.BankKeeper,
+	aqs authz.QueryServer,
+	ams authz.MsgServer,
+	authqs authtypes.QueryServer,
 ) *Contract {
-	return &Contract{
+	c := &Contract{
 		BaseContract: ethprecompile.NewBaseContract(
 			bankgenerated.BankModuleMetaData.ABI,
 			common.BytesToAddress(authtypes.NewModuleAddress(banktypes.ModuleName)),
@@ -59,7 +167,64 @@ func NewPrecompileContract(
 		addressCodec: ak.AddressCodec(),
 		msgServer:    ms,
 		querier:      qs,
+		bankKeeper:   bk,
+		authzQuerier: aqs,
+		authzServer:  ams,
+		authQuerier:  authqs,
+		gasPerCoin:   defaultGasPerCoin,
+		gasPerItem:   defaultGasPerItem,
+	}
+	for _, method := range c.ABIMethods() {
+		gas := ethprecompile.MethodGas{Flat: defaultMethodGas[method.RawName]}
+		if err := c.RegisterMethodGas(method.Sig, gas); err != nil {
+			panic(err)
+		}
 	}
+	for _, event := range c.ABIEvents() {
+		switch event.Sig {
+		case transferEventSig:
+			c.transferEvent = event
+		case approvalEventSig:
+			c.approvalEvent = event
+		case burnEventSig:
+			c.burnEvent = event
+		}
+	}
+	return c
+}
+
+// SetQueryContextFn sets the function used to get the query context at a past height.
+func (c *Contract) SetQueryContextFn(gqc func(height int64, prove bool) (sdk.Context, error)) {
+	c.getQueryContext = gqc
+}
+
+// SetParamsKeeper sets the keeper used to read the x/evm params. Without it, `canCall` does not
+// check the restricted methods.
+func (c *Contract) SetParamsKeeper(pk ParamsKeeper) {
+	c.pk = pk
+}
+
+// SetGasTable overrides the gas cost of the methods in the given gas table, which is keyed by
+// method signature (e.g. `getAllBalances(address)`). It returns an error if the ABI has no such
+// method.
+func (c *Contract) SetGasTable(gt ethprecompile.GasTable) error {
+	for sig, gas := range gt {
+		if err := c.RegisterMethodGas(sig, gas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetGasPerCoin sets the gas cost of every coin returned by the queries over all denominations.
+func (c *Contract) SetGasPerCoin(gasPerCoin uint64) {
+	c.gasPerCoin = gasPerCoin
+}
+
+// SetGasPerItem sets the gas cost of every element, other than coins, read or converted by the
+// list and batch methods (e.g. every query of `getBalances` or address of `batchHexToBech32`).
+func (c *Contract) SetGasPerItem(gasPerItem uint64) {
+	c.gasPerItem = gasPerItem
 }
 
 func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
@@ -96,6 +261,49 @@ func (c *Contract) GetBalance(
 	return balance.BigInt(), nil
 }
 
+// GetBalances implements `getBalances((address,string)[])` method.
+func (c *Contract) GetBalances(
+	ctx context.Context,
+	queries []bankgenerated.IBankModuleBalanceQuery,
+) ([]*big.Int, error) {
+	c.consumeGasPerItem(ctx, len(queries))
+	balances := make([]*big.Int, len(queries))
+	for i, query := range queries {
+		accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, query.Account)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.querier.Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: accAddr,
+			Denom:   query.Denom,
+		})
+		if err != nil {
+			return nil, err
+		}
+		balances[i] = res.GetBalance().Amount.BigInt()
+	}
+
+	return balances, nil
+}
+
+// GetBalanceAtHeight implements `getBalanceAtHeight(address,string,uint64)` method.
+func (c *Contract) GetBalanceAtHeight(
+	ctx context.Context,
+	accountAddress common.Address,
+	denom string,
+	height uint64,
+) (*big.Int, error) {
+	if height > math.MaxInt64 {
+		return nil, errorslib.Wrapf(precompile.ErrInvalidHeight, "%d", height)
+	}
+	queryCtx, err := c.queryContextAt(ctx, int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return c.GetBalance(queryCtx, accountAddress, denom)
+}
+
 // GetAllBalances implements `getAllBalances(address)` method.
 func (c *Contract) GetAllBalances(
 	ctx context.Context,
@@ -113,9 +321,39 @@ func (c *Contract) GetAllBalances(
 		return nil, err
 	}
 
+	c.consumeGasPerCoin(ctx, len(res.Balances))
 	return cosmlib.SdkCoinsToEvmCoins(res.Balances), nil
 }
 
+// GetAllBalances0 implements `getAllBalances(address,PageRequest)` method.
+func (c *Contract) GetAllBalances0(
+	ctx context.Context,
+	accountAddress common.Address,
+	pagination any,
+) ([]lib.CosmosCoin, lib.CosmosPageResponse, error) {
+	accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, accountAddress)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	res, err := c.querier.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+		Address:    accAddr,
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination)
+	c.consumeGasPerCoin(ctx, len(res.Balances))
+	return cosmlib.SdkCoinsToEvmCoins(res.Balances), pageResponse, nil
+}
+
 // GetSpendableBalanceByDenom implements `getSpendableBalanceByDenom(address,string)` method.
 func (c *Contract) GetSpendableBalance(
 	ctx context.Context,
@@ -139,7 +377,35 @@ func (c *Contract) GetSpendableBalance(
 	return balance.BigInt(), nil
 }
 
-// GetSpendableBalances implements `getAllSpendableBalances(address)` method.
+// GetSpendableBalances implements `getSpendableBalances(address,string[])` method.
+func (c *Contract) GetSpendableBalances(
+	ctx context.Context,
+	accountAddress common.Address,
+	denoms []string,
+) ([]*big.Int, error) {
+	accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, accountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	c.consumeGasPerItem(ctx, len(denoms))
+	balances := make([]*big.Int, len(denoms))
+	for i, denom := range denoms {
+		var res *banktypes.QuerySpendableBalanceByDenomResponse
+		res, err = c.querier.SpendableBalanceByDenom(ctx, &banktypes.QuerySpendableBalanceByDenomRequest{
+			Address: accAddr,
+			Denom:   denom,
+		})
+		if err != nil {
+			return nil, err
+		}
+		balances[i] = res.GetBalance().Amount.BigInt()
+	}
+
+	return balances, nil
+}
+
+// GetAllSpendableBalances implements `getAllSpendableBalances(address)` method.
 func (c *Contract) GetAllSpendableBalances(
 	ctx context.Context,
 	accountAddress common.Address,
@@ -156,9 +422,39 @@ func (c *Contract) GetAllSpendableBalances(
 		return nil, err
 	}
 
+	c.consumeGasPerCoin(ctx, len(res.Balances))
 	return cosmlib.SdkCoinsToEvmCoins(res.Balances), nil
 }
 
+// GetAllSpendableBalances0 implements `getAllSpendableBalances(address,PageRequest)` method.
+func (c *Contract) GetAllSpendableBalances0(
+	ctx context.Context,
+	accountAddress common.Address,
+	pagination any,
+) ([]lib.CosmosCoin, lib.CosmosPageResponse, error) {
+	accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, accountAddress)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	res, err := c.querier.SpendableBalances(ctx, &banktypes.QuerySpendableBalancesRequest{
+		Address:    accAddr,
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination)
+	c.consumeGasPerCoin(ctx, len(res.Balances))
+	return cosmlib.SdkCoinsToEvmCoins(res.Balances), pageResponse, nil
+}
+
 // GetSupplyOf implements `getSupply(string)` method.
 func (c *Contract) GetSupply(
 	ctx context.Context,
@@ -175,19 +471,54 @@ func (c *Contract) GetSupply(
 	return supply.BigInt(), nil
 }
 
+// GetSupplyAt implements `getSupplyAt(string,int64)` method.
+func (c *Contract) GetSupplyAt(
+	ctx context.Context,
+	denom string,
+	height int64,
+) (*big.Int, error) {
+	queryCtx, err := c.queryContextAt(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetSupply(queryCtx, denom)
+}
+
 // GetTotalSupply implements `getAllSupply()` method.
 func (c *Contract) GetAllSupply(
 	ctx context.Context,
 ) ([]lib.CosmosCoin, error) {
-	// todo: add pagination here
 	res, err := c.querier.TotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{})
 	if err != nil {
 		return nil, err
 	}
 
+	c.consumeGasPerCoin(ctx, len(res.Supply))
 	return cosmlib.SdkCoinsToEvmCoins(res.Supply), nil
 }
 
+// GetAllSupply0 implements `getAllSupply(PageRequest)` method.
+func (c *Contract) GetAllSupply0(
+	ctx context.Context,
+	pagination any,
+) ([]lib.CosmosCoin, lib.CosmosPageResponse, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	res, err := c.querier.TotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
+	}
+
+	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination)
+	c.consumeGasPerCoin(ctx, len(res.Supply))
+	return cosmlib.SdkCoinsToEvmCoins(res.Supply), pageResponse, nil
+}
+
 // GetDenomMetadata implements `getDenomMetadata(string)` method.
 func (c *Contract) GetDenomMetadata(
 	ctx context.Context,
@@ -200,24 +531,34 @@ func (c *Contract) GetDenomMetadata(
 		return bankgenerated.IBankModuleDenomMetadata{}, err
 	}
 
-	denomUnits := make([]bankgenerated.IBankModuleDenomUnit, len(res.Metadata.DenomUnits))
-	for i, d := range res.Metadata.DenomUnits {
-		denomUnits[i] = bankgenerated.IBankModuleDenomUnit{
-			Denom:    d.Denom,
-			Aliases:  d.Aliases,
-			Exponent: d.Exponent,
-		}
+	return metadataToEvmMetadata(res.Metadata), nil
+}
+
+// GetDenomsMetadata implements `getDenomsMetadata(PageRequest)` method.
+func (c *Contract) GetDenomsMetadata(
+	ctx context.Context,
+	pagination any,
+) ([]bankgenerated.IBankModuleDenomMetadata, lib.CosmosPageResponse, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
 	}
 
-	result := bankgenerated.IBankModuleDenomMetadata{
-		Description: res.Metadata.Description,
-		DenomUnits:  denomUnits,
-		Base:        res.Metadata.Base,
-		Display:     res.Metadata.Display,
-		Name:        res.Metadata.Name,
-		Symbol:      res.Metadata.Symbol,
+	res, err := c.querier.DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, lib.CosmosPageResponse{}, err
 	}
-	return result, nil
+
+	c.consumeGasPerItem(ctx, len(res.Metadatas))
+	metadatas := make([]bankgenerated.IBankModuleDenomMetadata, len(res.Metadatas))
+	for i, metadata := range res.Metadatas {
+		metadatas[i] = metadataToEvmMetadata(metadata)
+	}
+
+	pageResponse := cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination)
+	return metadatas, pageResponse, nil
 }
 
 // GetSendEnabled implements `getSendEnabled(string)` method.
@@ -238,13 +579,322 @@ func (c *Contract) GetSendEnabled(
 	return res.SendEnabled[0].Enabled, nil
 }
 
+// GetAllowances implements `getAllowances(address)` method.
+func (c *Contract) GetAllowances(
+	ctx context.Context,
+	owner common.Address,
+) ([]bankgenerated.IBankModuleGrant, error) {
+	granter, err := cosmlib.StringFromEthAddress(c.addressCodec, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.authzQuerier.GranterGrants(ctx, &authz.QueryGranterGrantsRequest{
+		Granter: granter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.consumeGasPerItem(ctx, len(res.Grants))
+	return c.sendGrantsToEvmGrants(res.Grants)
+}
+
+// GetGrantsToSpender implements `getGrantsToSpender(address)` method.
+func (c *Contract) GetGrantsToSpender(
+	ctx context.Context,
+	spender common.Address,
+) ([]bankgenerated.IBankModuleGrant, error) {
+	grantee, err := cosmlib.StringFromEthAddress(c.addressCodec, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.authzQuerier.GranteeGrants(ctx, &authz.QueryGranteeGrantsRequest{
+		Grantee: grantee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.consumeGasPerItem(ctx, len(res.Grants))
+	return c.sendGrantsToEvmGrants(res.Grants)
+}
+
+// GetEffectiveAllowance implements `getEffectiveAllowance(address,address,string)` method.
+func (c *Contract) GetEffectiveAllowance(
+	ctx context.Context,
+	owner common.Address,
+	spender common.Address,
+	denom string,
+) (*big.Int, error) {
+	granter, err := cosmlib.StringFromEthAddress(c.addressCodec, owner)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := cosmlib.StringFromEthAddress(c.addressCodec, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.authzQuerier.Grants(ctx, &authz.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	})
+	if status.Code(err) == codes.NotFound {
+		// handle the case where the grant does not exist
+		return big.NewInt(0), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	limit := sdkmath.ZeroInt()
+	for _, grant := range res.GetGrants() {
+		if sendAuth, ok := grant.Authorization.GetCachedValue().(*banktypes.SendAuthorization); ok {
+			limit = sendAuth.SpendLimit.AmountOf(denom)
+			break
+		}
+	}
+	if limit.IsZero() {
+		return big.NewInt(0), nil
+	}
+
+	spendable, err := c.querier.SpendableBalanceByDenom(ctx, &banktypes.QuerySpendableBalanceByDenomRequest{
+		Address: granter,
+		Denom:   denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkmath.MinInt(limit, spendable.GetBalance().Amount).BigInt(), nil
+}
+
+// GetSendAllowances implements `getSendAllowances(address,address)` method.
+func (c *Contract) GetSendAllowances(
+	ctx context.Context,
+	owner common.Address,
+	spender common.Address,
+) ([]bankgenerated.IBankModuleSendAllowance, error) {
+	granter, err := cosmlib.StringFromEthAddress(c.addressCodec, owner)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := cosmlib.StringFromEthAddress(c.addressCodec, spender)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.authzQuerier.Grants(ctx, &authz.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	})
+	if status.Code(err) == codes.NotFound {
+		// handle the case where the grant does not exist
+		return []bankgenerated.IBankModuleSendAllowance{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	allowances := make([]bankgenerated.IBankModuleSendAllowance, 0, len(res.GetGrants()))
+	for _, grant := range res.GetGrants() {
+		sendAuth, ok := grant.Authorization.GetCachedValue().(*banktypes.SendAuthorization)
+		if !ok {
+			continue
+		}
+
+		allowList := make([]common.Address, len(sendAuth.AllowList))
+		for i, recipient := range sendAuth.AllowList {
+			if allowList[i], err = cosmlib.EthAddressFromString(
+				c.addressCodec, recipient,
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		var expiration uint64
+		if grant.Expiration != nil {
+			expiration = uint64(grant.Expiration.Unix())
+		}
+
+		allowances = append(allowances, bankgenerated.IBankModuleSendAllowance{
+			Coins:      coinsToEvmCoins(sendAuth.SpendLimit),
+			Expiration: expiration,
+			AllowList:  allowList,
+		})
+	}
+	return allowances, nil
+}
+
+// IsVestingAccount implements `isVestingAccount(address)` method.
+func (c *Contract) IsVestingAccount(
+	ctx context.Context,
+	account common.Address,
+) (bool, error) {
+	_, isVesting, err := c.vestingAccount(ctx, account)
+	return isVesting, err
+}
+
+// GetAllLockedBalances implements `getAllLockedBalances(address)` method. It returns the locked
+// portion of every denom held by a vesting account, which is the amount still locked by its
+// vesting schedule capped by its balance, i.e. the part of its balance that is not spendable.
+func (c *Contract) GetAllLockedBalances(
+	ctx context.Context,
+	account common.Address,
+) ([]lib.CosmosCoin, error) {
+	vestingAcc, isVesting, err := c.vestingAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	} else if !isVesting {
+		return []lib.CosmosCoin{}, nil
+	}
+	accAddr, err := c.addressCodec.BytesToString(vestingAcc.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.querier.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+		Address: accAddr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the locked portion of each held denom is capped by its balance, like in `SpendableCoins`
+	blockTime := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).BlockTime()
+	lockedCoins := vestingAcc.LockedCoins(blockTime)
+	locked := make([]lib.CosmosCoin, 0, len(res.Balances))
+	for _, balance := range res.Balances {
+		locked = append(locked, lib.CosmosCoin{
+			Denom:  balance.Denom,
+			Amount: sdkmath.MinInt(balance.Amount, lockedCoins.AmountOf(balance.Denom)).BigInt(),
+		})
+	}
+	c.consumeGasPerCoin(ctx, len(locked))
+	return locked, nil
+}
+
+// GetLockedCoins implements `getLockedCoins(address)` method. It is an alias of
+// `getAllLockedBalances(address)`.
+func (c *Contract) GetLockedCoins(
+	ctx context.Context,
+	account common.Address,
+) ([]lib.CosmosCoin, error) {
+	return c.GetAllLockedBalances(ctx, account)
+}
+
+// GetVestingSchedule implements `getVestingSchedule(address)` method.
+func (c *Contract) GetVestingSchedule(
+	ctx context.Context,
+	account common.Address,
+) (bankgenerated.IBankModuleVestingSchedule, error) {
+	vestingAcc, isVesting, err := c.vestingAccount(ctx, account)
+	if err != nil {
+		return bankgenerated.IBankModuleVestingSchedule{}, err
+	} else if !isVesting {
+		return bankgenerated.IBankModuleVestingSchedule{
+			OriginalVesting: []bankgenerated.CosmosCoin{},
+			VestedCoins:     []bankgenerated.CosmosCoin{},
+			Periods:         []bankgenerated.IBankModuleVestingPeriod{},
+		}, nil
+	}
+
+	periods := []bankgenerated.IBankModuleVestingPeriod{}
+	if periodicAcc, ok := vestingAcc.(*vestingtypes.PeriodicVestingAccount); ok {
+		for _, period := range periodicAcc.VestingPeriods {
+			periods = append(periods, bankgenerated.IBankModuleVestingPeriod{
+				Length: uint64(period.Length),
+				Amount: coinsToEvmCoins(period.Amount),
+			})
+		}
+	}
+
+	blockTime := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).BlockTime()
+	return bankgenerated.IBankModuleVestingSchedule{
+		StartTime:       uint64(vestingAcc.GetStartTime()),
+		EndTime:         uint64(vestingAcc.GetEndTime()),
+		OriginalVesting: coinsToEvmCoins(vestingAcc.GetOriginalVesting()),
+		VestedCoins:     coinsToEvmCoins(vestingAcc.GetVestedCoins(blockTime)),
+		Periods:         periods,
+	}, nil
+}
+
+// BatchHexToBech32 implements `batchHexToBech32(address[])` method.
+func (c *Contract) BatchHexToBech32(
+	ctx context.Context,
+	addrs []common.Address,
+) ([]string, error) {
+	c.consumeGasPerItem(ctx, len(addrs))
+	bech32s := make([]string, len(addrs))
+	for i, addr := range addrs {
+		bech32, err := cosmlib.StringFromEthAddress(c.addressCodec, addr)
+		if err != nil {
+			return nil, errorslib.Wrapf(precompile.ErrInvalidHexAddress, "index %d: %v", i, err)
+		}
+		bech32s[i] = bech32
+	}
+	return bech32s, nil
+}
+
+// BatchBech32ToHex implements `batchBech32ToHex(string[])` method.
+func (c *Contract) BatchBech32ToHex(
+	ctx context.Context,
+	bech32s []string,
+) ([]common.Address, error) {
+	c.consumeGasPerItem(ctx, len(bech32s))
+	addrs := make([]common.Address, len(bech32s))
+	for i, bech32Addr := range bech32s {
+		addr, err := cosmlib.EthAddressFromString(c.addressCodec, bech32Addr)
+		if err != nil {
+			return nil, errorslib.Wrapf(precompile.ErrInvalidBech32Address, "index %d: %v", i, err)
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
 // Send implements `send(address,(uint256,string)[])` method.
 func (c *Contract) Send(
 	ctx context.Context,
 	toAddress common.Address,
 	coins any,
 ) (bool, error) {
-	amount, err := cosmlib.ExtractCoinsFromInput(coins)
+	toAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, toAddress)
+	if err != nil {
+		return false, err
+	}
+	return c.send(ctx, toAddr, toAddress.Bytes(), coins)
+}
+
+// Send0 implements `send(string,(uint256,string)[])` method.
+func (c *Contract) Send0(
+	ctx context.Context,
+	toAddress string,
+	coins any,
+) (bool, error) {
+	// the prefix is ignored, so that addresses of other bech32 prefixes are accepted as well
+	_, bz, err := bech32.DecodeAndConvert(toAddress)
+	if err != nil {
+		return false, errorslib.Wrapf(precompile.ErrInvalidBech32Address, "%s: %v", toAddress, err)
+	}
+	toAddr, err := c.addressCodec.BytesToString(bz)
+	if err != nil {
+		return false, err
+	}
+	return c.send(ctx, toAddr, bz, coins)
+}
+
+// send sends coins from the caller to the recipient with the given bech32 and raw address. The
+// `Transfer` logs are only emitted if the recipient has an EVM address, i.e. is 20 bytes long.
+func (c *Contract) send(
+	ctx context.Context,
+	toAddr string,
+	toBz []byte,
+	coins any,
+) (bool, error) {
+	amount, err := c.extractCoins(coins)
 	if err != nil {
 		return false, err
 	}
@@ -254,17 +904,390 @@ func (c *Contract) Send(
 	if err != nil {
 		return false, err
 	}
-	toAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, toAddress)
-	if err != nil {
+
+	// the spendable balances are checked upfront, as a failed send may have already deducted
+	// some of the coins
+	if err = c.checkSpendable(ctx, caller, amount); err != nil {
 		return false, err
 	}
-
-	_, err = c.msgServer.Send(ctx, &banktypes.MsgSend{
+	if _, err = c.msgServer.Send(ctx, &banktypes.MsgSend{
 		FromAddress: caller,
 		ToAddress:   toAddr,
 		Amount:      amount,
+	}); err != nil {
+		return false, c.sendError(ctx, amount, err)
+	}
+	if len(toBz) != common.AddressLength {
+		return true, nil
+	}
+
+	from, to := vm.UnwrapPolarContext(ctx).MsgSender(), common.BytesToAddress(toBz)
+	for _, coin := range amount {
+		if err = c.emitLog(
+			ctx, c.transferEvent, from, to, coin.Amount.BigInt(), coin.Denom,
+		); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Burn implements `burn((uint256,string)[])` method.
+func (c *Contract) Burn(
+	ctx context.Context,
+	coins any,
+) (bool, error) {
+	amount, err := c.extractCoins(coins)
+	if err != nil {
+		return false, err
+	}
+
+	// there is no MsgBurn in the bank module, so the coins are burned from the evm module account
+	caller := vm.UnwrapPolarContext(ctx).MsgSender()
+	if err = c.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, caller.Bytes(), evmtypes.ModuleName, amount,
+	); err != nil {
+		return false, err
+	}
+	if err = c.bankKeeper.BurnCoins(ctx, evmtypes.ModuleName, amount); err != nil {
+		return false, err
+	}
+	precompile.SyncBalances(ctx, caller)
+
+	if err = c.emitLog(ctx, c.burnEvent, caller, cosmlib.SdkCoinsToEvmCoins(amount)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Revoke implements `revoke(address)` method.
+func (c *Contract) Revoke(
+	ctx context.Context,
+	spender common.Address,
+) (bool, error) {
+	granter, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return false, err
+	}
+	grantee, err := cosmlib.StringFromEthAddress(c.addressCodec, spender)
+	if err != nil {
+		return false, err
+	}
+
+	_, sendAuth, err := c.sendGrant(ctx, granter, grantee)
+	if err != nil {
+		return false, err
+	}
+	if _, err = c.authzServer.Revoke(ctx, &authz.MsgRevoke{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	}); err != nil {
+		return false, err
+	}
+
+	if err = c.emitApprovals(ctx, spender, sendAuth.SpendLimit, sdk.Coins{}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DecreaseAllowance implements `decreaseAllowance(address,(uint256,string)[])` method.
+func (c *Contract) DecreaseAllowance(
+	ctx context.Context,
+	spender common.Address,
+	coins any,
+) (bool, error) {
+	amount, err := c.extractCoins(coins)
+	if err != nil {
+		return false, err
+	}
+	granter, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return false, err
+	}
+	grantee, err := cosmlib.StringFromEthAddress(c.addressCodec, spender)
+	if err != nil {
+		return false, err
+	}
+
+	grant, sendAuth, err := c.sendGrant(ctx, granter, grantee)
+	if err != nil {
+		return false, err
+	}
+	limit, isNeg := sendAuth.SpendLimit.SafeSub(amount...)
+	if isNeg {
+		return false, errorslib.Wrapf(
+			precompile.ErrInvalidCoin, "%s exceeds the spend limit %s", amount, sendAuth.SpendLimit,
+		)
+	}
+
+	// a grant with nothing left to spend is revoked rather than kept around
+	if limit.IsZero() {
+		_, err = c.authzServer.Revoke(ctx, &authz.MsgRevoke{
+			Granter:    granter,
+			Grantee:    grantee,
+			MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		})
+	} else {
+		var newGrant authz.Grant
+		newGrant, err = authz.NewGrant(
+			sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).BlockTime(),
+			&banktypes.SendAuthorization{SpendLimit: limit, AllowList: sendAuth.AllowList},
+			grant.Expiration,
+		)
+		if err != nil {
+			return false, err
+		}
+		_, err = c.authzServer.Grant(ctx, &authz.MsgGrant{
+			Granter: granter,
+			Grantee: grantee,
+			Grant:   newGrant,
+		})
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err = c.emitApprovals(ctx, spender, amount, limit); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CanCall implements `canCall(address,bytes4)` method. A method can be called by the given caller
+// if it is not restricted in the x/evm params or the caller is one of its allowed callers.
+func (c *Contract) CanCall(
+	ctx context.Context,
+	caller common.Address,
+	methodSelector [4]byte,
+) (bool, error) {
+	for _, method := range c.ABIMethods() {
+		if !bytes.Equal(method.ID, methodSelector[:]) {
+			continue
+		}
+		if c.pk == nil {
+			return true, nil
+		}
+		params := c.pk.GetParams(sdk.UnwrapSDKContext(ctx))
+		return params.IsMethodCallerAllowed(c.RegistryKey(), methodSelector, caller), nil
+	}
+	return false, nil
+}
+
+// vestingAccount returns the given account as a vesting account. It returns false if the account
+// does not exist or is not a vesting account.
+func (c *Contract) vestingAccount(
+	ctx context.Context, account common.Address,
+) (vestingexported.VestingAccount, bool, error) {
+	accAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, account)
+	if err != nil {
+		return nil, false, err
+	}
+
+	res, err := c.authQuerier.Account(ctx, &authtypes.QueryAccountRequest{Address: accAddr})
+	if status.Code(err) == codes.NotFound {
+		// handle the case where the account does not exist
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	vestingAcc, isVesting := res.GetAccount().GetCachedValue().(vestingexported.VestingAccount)
+	return vestingAcc, isVesting, nil
+}
+
+// consumeGasPerCoin charges the gas cost of the given number of coins returned by a query over all
+// denominations.
+func (c *Contract) consumeGasPerCoin(ctx context.Context, numCoins int) {
+	sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).GasMeter().ConsumeGas(
+		c.gasPerCoin*uint64(numCoins), "bank precompile coins",
+	)
+}
+
+// consumeGasPerItem charges the gas cost of the given number of elements read or converted by a
+// list or batch method.
+func (c *Contract) consumeGasPerItem(ctx context.Context, numItems int) {
+	sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).GasMeter().ConsumeGas(
+		c.gasPerItem*uint64(numItems), "bank precompile items",
+	)
+}
+
+// extractCoins extracts the coins from the given input, reverting with `InvalidCoins` if they are
+// invalid.
+func (c *Contract) extractCoins(coins any) (sdk.Coins, error) {
+	amount, err := cosmlib.ExtractCoinsFromInput(coins)
+	if err != nil {
+		return nil, ethprecompile.NewRevertError(c.ABIErrors()["InvalidCoins"], err)
+	}
+	return amount, nil
+}
+
+// checkSpendable reverts with `InsufficientSpendableBalance` for the first coin of `amount` that
+// exceeds the spendable balance of `addr`.
+func (c *Contract) checkSpendable(ctx context.Context, addr string, amount sdk.Coins) error {
+	for _, coin := range amount {
+		res, err := c.querier.SpendableBalanceByDenom(
+			ctx, &banktypes.QuerySpendableBalanceByDenomRequest{Address: addr, Denom: coin.Denom},
+		)
+		if err != nil {
+			return err
+		}
+		if spendable := res.GetBalance().Amount; spendable.LT(coin.Amount) {
+			return ethprecompile.NewRevertError(
+				c.ABIErrors()["InsufficientSpendableBalance"],
+				errorslib.Wrapf(
+					sdkerrors.ErrInsufficientFunds, "spendable %s%s", spendable, coin.Denom,
+				),
+				coin.Denom, spendable.BigInt(), coin.Amount.BigInt(),
+			)
+		}
+	}
+	return nil
+}
+
+// sendError returns the error to revert with for the given error of a failed send of `amount`,
+// which is `SendDisabled` if sending one of its denoms is disabled.
+func (c *Contract) sendError(ctx context.Context, amount sdk.Coins, err error) error {
+	if !errors.Is(err, banktypes.ErrSendDisabled) {
+		return err
+	}
+	for _, coin := range amount {
+		enabled, qErr := c.isSendEnabled(ctx, coin.Denom)
+		if qErr != nil {
+			return err
+		}
+		if !enabled {
+			return ethprecompile.NewRevertError(c.ABIErrors()["SendDisabled"], err, coin.Denom)
+		}
+	}
+	return err
+}
+
+// isSendEnabled returns whether sending `denom` is enabled, falling back to the default of the
+// bank params for denoms without their own setting.
+func (c *Contract) isSendEnabled(ctx context.Context, denom string) (bool, error) {
+	res, err := c.querier.SendEnabled(ctx, &banktypes.QuerySendEnabledRequest{
+		Denoms: []string{denom},
 	})
-	return err == nil, err
+	if err != nil {
+		return false, err
+	}
+	if len(res.SendEnabled) > 0 {
+		return res.SendEnabled[0].Enabled, nil
+	}
+
+	params, err := c.querier.Params(ctx, &banktypes.QueryParamsRequest{})
+	if err != nil {
+		return false, err
+	}
+	return params.Params.DefaultSendEnabled, nil
+}
+
+// queryContextAt returns the context to query the state at the given past or current height. Past
+// heights can only be queried outside of txs.
+func (c *Contract) queryContextAt(ctx context.Context, height int64) (context.Context, error) {
+	sdkCtx := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+	if height <= 0 || height > sdkCtx.BlockHeight() {
+		return nil, errorslib.Wrapf(
+			precompile.ErrInvalidHeight, "%d, current height %d", height, sdkCtx.BlockHeight(),
+		)
+	}
+	if height == sdkCtx.BlockHeight() {
+		return ctx, nil
+	}
+
+	// the state at a past height is pruned differently on every node, so it can only be read by
+	// queries, e.g. eth_calls, and not by txs, whose results have to be deterministic
+	if !vm.UnwrapPolarContext(ctx).IsQuery() {
+		return nil, precompile.ErrHistoricalStateInTx
+	}
+	if c.getQueryContext == nil {
+		return nil, precompile.ErrNoQueryContext
+	}
+	return c.getQueryContext(height, false)
+}
+
+// sendGrant returns the send grant given by `granter` to `grantee` along with its
+// `SendAuthorization`.
+func (c *Contract) sendGrant(
+	ctx context.Context,
+	granter, grantee string,
+) (*authz.Grant, *banktypes.SendAuthorization, error) {
+	res, err := c.authzQuerier.Grants(ctx, &authz.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(res.GetGrants()) == 0 {
+		return nil, nil, errorslib.Wrapf(
+			precompile.ErrInvalidGrantType, "no send grant to %s", grantee,
+		)
+	}
+
+	grant := res.GetGrants()[0]
+	sendAuth, ok := grant.Authorization.GetCachedValue().(*banktypes.SendAuthorization)
+	if !ok {
+		return nil, nil, errorslib.Wrapf(
+			precompile.ErrInvalidGrantType, "%T", grant.Authorization.GetCachedValue(),
+		)
+	}
+	return grant, sendAuth, nil
+}
+
+// emitApprovals emits an `Approval` log from the caller to `spender` for each denom of `changed`,
+// with the denom's amount in the new spend `limit` as value.
+func (c *Contract) emitApprovals(
+	ctx context.Context,
+	spender common.Address,
+	changed, limit sdk.Coins,
+) error {
+	owner := vm.UnwrapPolarContext(ctx).MsgSender()
+	for _, coin := range changed {
+		if err := c.emitLog(
+			ctx, c.approvalEvent, owner, spender, limit.AmountOf(coin.Denom).BigInt(), coin.Denom,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emitLog adds a log of the given ABI event to the StateDB, where args are the values of all the
+// event's inputs in order.
+func (c *Contract) emitLog(ctx context.Context, event abi.Event, args ...any) error {
+	query := []any{event.ID}
+	data := make([]any, 0, len(args))
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			query = append(query, args[i])
+		} else {
+			data = append(data, args[i])
+		}
+	}
+
+	topics, err := abi.MakeTopics(query)
+	if err != nil {
+		return err
+	}
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	vm.UnwrapPolarContext(ctx).Evm().GetStateDB().AddLog(&coretypes.Log{
+		Address: c.RegistryKey(),
+		Topics:  topics[0],
+		Data:    packed,
+	})
+	return nil
 }
 
 // ConvertAccAddressFromString converts a Cosmos string representing a account address to a
@@ -273,3 +1296,67 @@ func (c *Contract) ConvertAccAddressFromString(attributeValue string) (any, erro
 	// extract the sdk.AccAddress from string value as common.Address
 	return cosmlib.EthAddressFromString(c.addressCodec, attributeValue)
 }
+
+// sendGrantsToEvmGrants converts the `SendAuthorization` grants among the given authz grants into
+// the `Grant` struct shared by all grant-related methods. Other authorization types are skipped.
+func (c *Contract) sendGrantsToEvmGrants(
+	grants []*authz.GrantAuthorization,
+) ([]bankgenerated.IBankModuleGrant, error) {
+	evmGrants := make([]bankgenerated.IBankModuleGrant, 0, len(grants))
+	for _, grant := range grants {
+		sendAuth, ok := grant.Authorization.GetCachedValue().(*banktypes.SendAuthorization)
+		if !ok {
+			continue
+		}
+
+		spender, err := cosmlib.EthAddressFromString(c.addressCodec, grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		var expiration uint64
+		if grant.Expiration != nil {
+			expiration = uint64(grant.Expiration.Unix())
+		}
+
+		evmGrants = append(evmGrants, bankgenerated.IBankModuleGrant{
+			Spender:    spender,
+			Coins:      coinsToEvmCoins(sendAuth.SpendLimit),
+			Expiration: expiration,
+		})
+	}
+	return evmGrants, nil
+}
+
+// coinsToEvmCoins converts the given sdk coins into the bank module's `Coin` struct.
+func coinsToEvmCoins(coins sdk.Coins) []bankgenerated.CosmosCoin {
+	evmCoins := make([]bankgenerated.CosmosCoin, 0, len(coins))
+	for _, coin := range coins {
+		evmCoins = append(evmCoins, bankgenerated.CosmosCoin{
+			Amount: coin.Amount.BigInt(),
+			Denom:  coin.Denom,
+		})
+	}
+	return evmCoins
+}
+
+// metadataToEvmMetadata converts the given bank denom metadata into the `DenomMetadata` struct.
+func metadataToEvmMetadata(metadata banktypes.Metadata) bankgenerated.IBankModuleDenomMetadata {
+	denomUnits := make([]bankgenerated.IBankModuleDenomUnit, len(metadata.DenomUnits))
+	for i, d := range metadata.DenomUnits {
+		denomUnits[i] = bankgenerated.IBankModuleDenomUnit{
+			Denom:    d.Denom,
+			Aliases:  d.Aliases,
+			Exponent: d.Exponent,
+		}
+	}
+
+	return bankgenerated.IBankModuleDenomMetadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	}
+}
@@ -21,19 +21,36 @@
 package bank_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	bankmodule "github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
 	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/bank"
 	"pkg.berachain.dev/polaris/cosmos/precompile"
 	"pkg.berachain.dev/polaris/cosmos/precompile/bank"
@@ -42,8 +59,10 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
 	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/core/vm/mock"
 	"pkg.berachain.dev/polaris/lib/utils"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -60,17 +79,32 @@ var _ = Describe("Bank Precompile Test", func() {
 		contract *bank.Contract
 		addr     sdk.AccAddress
 		factory  *log.Factory
-		ak       authkeeper.AccountKeeperI
+		ak       authkeeper.AccountKeeper
 		bk       bankkeeper.BaseKeeper
+		azk      authzkeeper.Keeper
 		ctx      context.Context
 	)
 
 	BeforeEach(func() {
 		ctx, ak, bk, _ = testutils.SetupMinimalKeepers()
+		encCfg := testutils.MakeTestEncodingConfig(
+			authzmodule.AppModuleBasic{},
+			bankmodule.AppModuleBasic{},
+		)
+		// authz only accepts grants for msgs with a registered handler
+		router := baseapp.NewMsgServiceRouter()
+		router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		banktypes.RegisterMsgServer(router, bankkeeper.NewMsgServerImpl(bk))
+		azk = authzkeeper.NewKeeper(
+			runtime.NewKVStoreService(storetypes.NewKVStoreKey(authz.ModuleName)),
+			encCfg.Codec,
+			router,
+			ak,
+		)
 
 		contract = utils.MustGetAs[*bank.Contract](bank.NewPrecompileContract(
-			ak, bankkeeper.NewMsgServerImpl(bk), bk),
-		)
+			ak, bankkeeper.NewMsgServerImpl(bk), bk, bk, azk, azk, authkeeper.NewQueryServer(ak),
+		))
 		addr = sdk.AccAddress([]byte("bank"))
 
 		// Register the events.
@@ -132,6 +166,25 @@ var _ = Describe("Bank Precompile Test", func() {
 		Expect(log.Address).To(Equal(contract.RegistryKey()))
 	})
 
+	It("should charge a fixed gas cost for every method", func() {
+		for _, method := range contract.ABIMethods() {
+			Expect(contract.GasTable()[method.Sig].Flat).To(BeNumerically(">", 0), method.Sig)
+		}
+	})
+
+	It("should override the gas table", func() {
+		sendSig := contract.ABIMethods()["send"].Sig
+		burnGas := contract.GasTable()["burn((uint256,string)[])"]
+
+		gas := ethprecompile.MethodGas{Flat: 42, PerByte: 1}
+		Expect(contract.SetGasTable(ethprecompile.GasTable{sendSig: gas})).To(Succeed())
+		Expect(contract.GasTable()[sendSig]).To(Equal(gas))
+		Expect(contract.GasTable()["burn((uint256,string)[])"]).To(Equal(burnGas))
+
+		err := contract.SetGasTable(ethprecompile.GasTable{"unknown()": gas})
+		Expect(err).To(MatchError(ContainSubstring(ethprecompile.ErrMethodNotFound.Error())))
+	})
+
 	When("Calling Precompile Methods", func() {
 		var (
 			acc    sdk.AccAddress
@@ -222,6 +275,56 @@ var _ = Describe("Bank Precompile Test", func() {
 					Expect(coin.Amount).To(Equal(balanceAmount))
 				}
 			})
+
+			It("should charge gas for every returned coin", func() {
+				acc = simtestutil.CreateRandomAccounts(1)[0]
+				sdkCtx := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+				Expect(FundAccount(sdkCtx, bk, acc, sdk.NewCoins(
+					sdk.NewCoin(denom, sdkmath.NewInt(1)),
+					sdk.NewCoin(denom2, sdkmath.NewInt(1)),
+				))).To(Succeed())
+
+				gasUsed := func(gasPerCoin uint64) uint64 {
+					contract.SetGasPerCoin(gasPerCoin)
+					gm := storetypes.NewInfiniteGasMeter()
+					_, err := contract.GetAllBalances(vm.NewPolarContext(
+						sdkCtx.WithGasMeter(gm), nil, common.BytesToAddress(acc), big.NewInt(0),
+					), common.BytesToAddress(acc))
+					Expect(err).ToNot(HaveOccurred())
+					return gm.GasConsumed()
+				}
+				Expect(gasUsed(1_000) - gasUsed(0)).To(Equal(uint64(2_000)))
+			})
+
+			It("should paginate", func() {
+				acc = simtestutil.CreateRandomAccounts(1)[0]
+				Expect(FundAccount(
+					sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()),
+					bk,
+					acc,
+					sdk.NewCoins(
+						sdk.NewCoin("denom_1", sdkmath.NewInt(1)),
+						sdk.NewCoin("denom_2", sdkmath.NewInt(2)),
+						sdk.NewCoin("denom_3", sdkmath.NewInt(3)),
+					),
+				)).To(Succeed())
+
+				coins, pageRes, err := contract.GetAllBalances0(
+					ctx, common.BytesToAddress(acc), pageRequest("", 2),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(coins).To(HaveLen(2))
+				Expect(coins[1].Denom).To(Equal("denom_2"))
+				Expect(pageRes.NextKey).ToNot(BeEmpty())
+
+				coins, pageRes, err = contract.GetAllBalances0(
+					ctx, common.BytesToAddress(acc), pageRequest(pageRes.NextKey, 2),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(coins).To(HaveLen(1))
+				Expect(coins[0].Denom).To(Equal("denom_3"))
+				Expect(pageRes.NextKey).To(BeEmpty())
+			})
 		})
 
 		When("GetSpendableBalanceByDenom", func() {
@@ -266,6 +369,75 @@ var _ = Describe("Bank Precompile Test", func() {
 			})
 		})
 
+		When("GetBalances", func() {
+			It("should return the balance of each pair in order", func() {
+				accs := simtestutil.CreateRandomAccounts(2)
+				Expect(FundAccount(
+					sdk.UnwrapSDKContext(ctx), bk, accs[0],
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+				)).To(Succeed())
+				Expect(FundAccount(
+					sdk.UnwrapSDKContext(ctx), bk, accs[1],
+					sdk.NewCoins(sdk.NewCoin(denom2, sdkmath.NewInt(30))),
+				)).To(Succeed())
+
+				res, err := contract.GetBalances(ctx, []generated.IBankModuleBalanceQuery{
+					{Account: common.BytesToAddress(accs[0]), Denom: denom},
+					{Account: common.BytesToAddress(accs[1]), Denom: denom2},
+					{Account: common.BytesToAddress(accs[1]), Denom: denom},
+					{Account: common.BytesToAddress(accs[0]), Denom: denom},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(4))
+				Expect(res[0]).To(Equal(big.NewInt(100)))
+				Expect(res[1]).To(Equal(big.NewInt(30)))
+				Expect(res[2].Sign()).To(BeZero())
+				Expect(res[3]).To(Equal(big.NewInt(100)))
+			})
+
+			It("should fail on an invalid denom", func() {
+				_, err := contract.GetBalances(ctx, []generated.IBankModuleBalanceQuery{
+					{Account: common.BytesToAddress(simtestutil.CreateRandomAccounts(1)[0]), Denom: "_"},
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		When("GetSpendableBalances for multiple denoms", func() {
+			It("should return the spendable amounts of a vesting account in order", func() {
+				acc = simtestutil.CreateRandomAccounts(1)[0]
+				sdkCtx := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+
+				baseAcc := utils.MustGetAs[*authtypes.BaseAccount](ak.NewAccountWithAddress(sdkCtx, acc))
+				vestingAcc, err := vestingtypes.NewDelayedVestingAccount(
+					baseAcc,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(60)), sdk.NewCoin(denom2, sdkmath.NewInt(50))),
+					sdkCtx.BlockTime().Add(time.Hour).Unix(),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				ak.SetAccount(sdkCtx, vestingAcc)
+
+				err = FundAccount(
+					sdkCtx,
+					bk,
+					acc,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)), sdk.NewCoin(denom2, sdkmath.NewInt(50))),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				res, err := contract.GetSpendableBalances(
+					ctx,
+					common.BytesToAddress(acc),
+					[]string{denom, denom2, "aunheld"},
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(3))
+				Expect(res[0]).To(Equal(big.NewInt(40)))
+				Expect(res[1].Sign()).To(BeZero())
+				Expect(res[2].Sign()).To(BeZero())
+			})
+		})
+
 		When("GetSpendableBalances", func() {
 
 			It("should succeed", func() {
@@ -306,6 +478,35 @@ var _ = Describe("Bank Precompile Test", func() {
 					Expect(coin.Amount).To(Equal(balanceAmount))
 				}
 			})
+
+			It("should paginate", func() {
+				acc = simtestutil.CreateRandomAccounts(1)[0]
+				Expect(FundAccount(
+					sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()),
+					bk,
+					acc,
+					sdk.NewCoins(
+						sdk.NewCoin("denom_1", sdkmath.NewInt(1)),
+						sdk.NewCoin("denom_2", sdkmath.NewInt(2)),
+						sdk.NewCoin("denom_3", sdkmath.NewInt(3)),
+					),
+				)).To(Succeed())
+
+				coins, pageRes, err := contract.GetAllSpendableBalances0(
+					ctx, common.BytesToAddress(acc), pageRequest("", 2),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(coins).To(HaveLen(2))
+				Expect(pageRes.NextKey).ToNot(BeEmpty())
+
+				coins, pageRes, err = contract.GetAllSpendableBalances0(
+					ctx, common.BytesToAddress(acc), pageRequest(pageRes.NextKey, 2),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(coins).To(HaveLen(1))
+				Expect(coins[0].Denom).To(Equal("denom_3"))
+				Expect(pageRes.NextKey).To(BeEmpty())
+			})
 		})
 
 		When("GetSupplyOf", func() {
@@ -352,6 +553,148 @@ var _ = Describe("Bank Precompile Test", func() {
 			})
 		})
 
+		When("GetSupplyAt", func() {
+			var (
+				historical sdk.Context
+				latestCtx  context.Context
+				txCtx      context.Context
+			)
+
+			BeforeEach(func() {
+				// The supply is 100 at height 1 and 150 at the current height 2.
+				historical = sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+				acc := simtestutil.CreateRandomAccounts(1)[0]
+				Expect(FundAccount(
+					historical, bk, acc, sdk.NewCoins(sdk.NewCoin(denom2, sdkmath.NewInt(100))),
+				)).To(Succeed())
+
+				latest, _ := historical.CacheContext()
+				latest = latest.WithBlockHeight(2)
+				Expect(FundAccount(
+					latest, bk, acc, sdk.NewCoins(sdk.NewCoin(denom2, sdkmath.NewInt(50))),
+				)).To(Succeed())
+				latestCtx = vm.NewPolarContext(
+					latest.WithValue(vm.QueryContextKey, true), nil, common.Address{}, big.NewInt(0),
+				)
+				txCtx = vm.NewPolarContext(latest, nil, common.Address{}, big.NewInt(0))
+			})
+
+			It("should return the supply at past and current heights", func() {
+				contract.SetQueryContextFn(func(height int64, _ bool) (sdk.Context, error) {
+					Expect(height).To(Equal(int64(1)))
+					return historical, nil
+				})
+
+				past, err := contract.GetSupplyAt(latestCtx, denom2, 1)
+				Expect(err).ToNot(HaveOccurred())
+				current, err := contract.GetSupplyAt(latestCtx, denom2, 2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(past).To(Equal(big.NewInt(100)))
+				Expect(current).To(Equal(big.NewInt(150)))
+				Expect(new(big.Int).Sub(current, past)).To(Equal(big.NewInt(50)))
+			})
+
+			It("should reject heights outside of the chain", func() {
+				_, err := contract.GetSupplyAt(latestCtx, denom2, 0)
+				Expect(err).To(MatchError(precompile.ErrInvalidHeight))
+				_, err = contract.GetSupplyAt(latestCtx, denom2, 3)
+				Expect(err).To(MatchError(precompile.ErrInvalidHeight))
+			})
+
+			It("should fail on a past height without a query context", func() {
+				_, err := contract.GetSupplyAt(latestCtx, denom2, 1)
+				Expect(err).To(MatchError(precompile.ErrNoQueryContext))
+			})
+
+			It("should only return the supply at past heights to queries", func() {
+				contract.SetQueryContextFn(func(int64, bool) (sdk.Context, error) {
+					return historical, nil
+				})
+
+				_, err := contract.GetSupplyAt(txCtx, denom2, 1)
+				Expect(err).To(MatchError(precompile.ErrHistoricalStateInTx))
+				current, err := contract.GetSupplyAt(txCtx, denom2, 2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(current).To(Equal(big.NewInt(150)))
+			})
+		})
+
+		When("GetBalanceAtHeight", func() {
+			var (
+				acc        sdk.AccAddress
+				historical sdk.Context
+				latestCtx  context.Context
+				txCtx      context.Context
+			)
+
+			BeforeEach(func() {
+				// The balance is 100 at height 1 and 60 at the current height 2.
+				historical = sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+				acc = simtestutil.CreateRandomAccounts(1)[0]
+				Expect(FundAccount(
+					historical, bk, acc, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+				)).To(Succeed())
+
+				latest, _ := historical.CacheContext()
+				latest = latest.WithBlockHeight(2)
+				Expect(bk.SendCoinsFromAccountToModule(
+					latest, acc, evmtypes.ModuleName,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40))),
+				)).To(Succeed())
+				latestCtx = vm.NewPolarContext(
+					latest.WithValue(vm.QueryContextKey, true), nil, common.Address{}, big.NewInt(0),
+				)
+				txCtx = vm.NewPolarContext(latest, nil, common.Address{}, big.NewInt(0))
+			})
+
+			It("should return the balance at past and current heights", func() {
+				contract.SetQueryContextFn(func(height int64, _ bool) (sdk.Context, error) {
+					Expect(height).To(Equal(int64(1)))
+					return historical, nil
+				})
+
+				past, err := contract.GetBalanceAtHeight(
+					latestCtx, common.BytesToAddress(acc), denom, 1,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(past).To(Equal(big.NewInt(100)))
+
+				current, err := contract.GetBalanceAtHeight(
+					latestCtx, common.BytesToAddress(acc), denom, 2,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(current).To(Equal(big.NewInt(60)))
+			})
+
+			It("should reject heights outside of the chain", func() {
+				for _, height := range []uint64{0, 3, math.MaxUint64} {
+					_, err := contract.GetBalanceAtHeight(
+						latestCtx, common.BytesToAddress(acc), denom, height,
+					)
+					Expect(err).To(MatchError(precompile.ErrInvalidHeight))
+				}
+			})
+
+			It("should fail on a past height without a query context", func() {
+				_, err := contract.GetBalanceAtHeight(latestCtx, common.BytesToAddress(acc), denom, 1)
+				Expect(err).To(MatchError(precompile.ErrNoQueryContext))
+			})
+
+			It("should only return the balance at past heights to queries", func() {
+				contract.SetQueryContextFn(func(int64, bool) (sdk.Context, error) {
+					return historical, nil
+				})
+
+				_, err := contract.GetBalanceAtHeight(txCtx, common.BytesToAddress(acc), denom, 1)
+				Expect(err).To(MatchError(precompile.ErrHistoricalStateInTx))
+				current, err := contract.GetBalanceAtHeight(
+					txCtx, common.BytesToAddress(acc), denom, 2,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(current).To(Equal(big.NewInt(60)))
+			})
+		})
+
 		When("GetTotalSupply", func() {
 			It("should succeed", func() {
 				balanceAmount, ok := new(big.Int).SetString("22000000000000000000", 10)
@@ -388,6 +731,34 @@ var _ = Describe("Bank Precompile Test", func() {
 				}
 
 			})
+
+			It("should paginate", func() {
+				accs := simtestutil.CreateRandomAccounts(3)
+				for i := 0; i < 3; i++ {
+					Expect(FundAccount(
+						sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()),
+						bk,
+						accs[i],
+						sdk.NewCoins(sdk.NewCoin(fmt.Sprintf("%s%d", denom, i), sdkmath.NewInt(5))),
+					)).To(Succeed())
+				}
+
+				var denoms []string
+				key := ""
+				for {
+					coins, pageRes, err := contract.GetAllSupply0(ctx, pageRequest(key, 1))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(len(coins)).To(BeNumerically("<=", 1))
+					for _, coin := range coins {
+						denoms = append(denoms, coin.Denom)
+					}
+					if pageRes.NextKey == "" {
+						break
+					}
+					key = pageRes.NextKey
+				}
+				Expect(denoms).To(ContainElements(denom+"0", denom+"1", denom+"2"))
+			})
 		})
 
 		When("GetDenomMetadata", func() {
@@ -428,6 +799,55 @@ var _ = Describe("Bank Precompile Test", func() {
 			})
 		})
 
+		When("GetDenomsMetadata", func() {
+			It("should page through all registered denoms", func() {
+				metadata := getTestMetadata()
+				for _, m := range metadata {
+					bk.SetDenomMetaData(ctx, m)
+				}
+
+				res, pageRes, err := contract.GetDenomsMetadata(ctx, pageRequest("", 1))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(1))
+				Expect(pageRes.NextKey).ToNot(BeEmpty())
+				bases := []string{res[0].Base}
+
+				res, pageRes, err = contract.GetDenomsMetadata(ctx, pageRequest(pageRes.NextKey, 1))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(1))
+				Expect(pageRes.NextKey).To(BeEmpty())
+				bases = append(bases, res[0].Base)
+
+				Expect(bases).To(ConsistOf(metadata[0].Base, metadata[1].Base))
+				Expect(res[0].DenomUnits).To(HaveLen(3))
+			})
+
+			It("should charge gas for every returned metadata", func() {
+				for _, m := range getTestMetadata() {
+					bk.SetDenomMetaData(ctx, m)
+				}
+
+				sdkCtx := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+				gasUsed := func(gasPerItem uint64) uint64 {
+					contract.SetGasPerItem(gasPerItem)
+					gm := storetypes.NewInfiniteGasMeter()
+					_, _, err := contract.GetDenomsMetadata(vm.NewPolarContext(
+						sdkCtx.WithGasMeter(gm), nil, common.Address{}, big.NewInt(0),
+					), pageRequest("", 10))
+					Expect(err).ToNot(HaveOccurred())
+					return gm.GasConsumed()
+				}
+				Expect(gasUsed(1_000) - gasUsed(0)).To(Equal(uint64(2_000)))
+			})
+
+			It("should return nothing when no denom is registered", func() {
+				res, pageRes, err := contract.GetDenomsMetadata(ctx, pageRequest("", 10))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeEmpty())
+				Expect(pageRes.NextKey).To(BeEmpty())
+			})
+		})
+
 		When("GetSendEnabled", func() {
 			It("should succeed", func() {
 				enabledDenom := "enabledDenom"
@@ -445,6 +865,639 @@ var _ = Describe("Bank Precompile Test", func() {
 			})
 		})
 
+		When("GetAllowances and GetGrantsToSpender", func() {
+			var (
+				owner, spender sdk.AccAddress
+				limit          sdk.Coins
+				expiration     time.Time
+			)
+
+			BeforeEach(func() {
+				accs := simtestutil.CreateRandomAccounts(2)
+				owner, spender = accs[0], accs[1]
+				limit = sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+				expiration = time.Unix(2_000_000_000, 0).UTC()
+
+				Expect(azk.SaveGrant(
+					ctx, spender, owner, banktypes.NewSendAuthorization(limit, nil), &expiration,
+				)).To(Succeed())
+			})
+
+			It("should return the owner's grants as structs", func() {
+				grants, err := contract.GetAllowances(ctx, common.BytesToAddress(owner))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(grants).To(HaveLen(1))
+				Expect(grants[0].Spender).To(Equal(common.BytesToAddress(spender)))
+				Expect(grants[0].Coins).To(HaveLen(1))
+				Expect(grants[0].Coins[0].Denom).To(Equal(denom))
+				Expect(grants[0].Coins[0].Amount).To(Equal(big.NewInt(100)))
+				Expect(grants[0].Expiration).To(Equal(uint64(expiration.Unix())))
+			})
+
+			It("should return the spender's grants in the same shape", func() {
+				grants, err := contract.GetGrantsToSpender(ctx, common.BytesToAddress(spender))
+				Expect(err).ToNot(HaveOccurred())
+				ownerGrants, err := contract.GetAllowances(ctx, common.BytesToAddress(owner))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(grants).To(Equal(ownerGrants))
+			})
+		})
+
+		When("GetEffectiveAllowance", func() {
+			var owner, spender sdk.AccAddress
+
+			BeforeEach(func() {
+				accs := simtestutil.CreateRandomAccounts(2)
+				owner, spender = accs[0], accs[1]
+				expiration := time.Unix(2_000_000_000, 0).UTC()
+				Expect(azk.SaveGrant(
+					ctx, spender, owner,
+					banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))), nil),
+					&expiration,
+				)).To(Succeed())
+			})
+
+			fund := func(amount int64) {
+				Expect(FundAccount(
+					sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()),
+					bk,
+					owner,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount))),
+				)).To(Succeed())
+			}
+
+			It("should be bound by the balance", func() {
+				fund(40)
+				res, err := contract.GetEffectiveAllowance(
+					ctx, common.BytesToAddress(owner), common.BytesToAddress(spender), denom,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(big.NewInt(40)))
+			})
+
+			It("should be bound by the grant", func() {
+				fund(500)
+				res, err := contract.GetEffectiveAllowance(
+					ctx, common.BytesToAddress(owner), common.BytesToAddress(spender), denom,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(big.NewInt(100)))
+			})
+
+			It("should be zero without a grant for the denom or spender", func() {
+				fund(500)
+				res, err := contract.GetEffectiveAllowance(
+					ctx, common.BytesToAddress(owner), common.BytesToAddress(spender), denom2,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Sign()).To(BeZero())
+
+				res, err = contract.GetEffectiveAllowance(
+					ctx, common.BytesToAddress(spender), common.BytesToAddress(owner), denom,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Sign()).To(BeZero())
+			})
+		})
+
+		When("GetSendAllowances", func() {
+			var owner, spender, recipient sdk.AccAddress
+
+			BeforeEach(func() {
+				accs := simtestutil.CreateRandomAccounts(3)
+				owner, spender, recipient = accs[0], accs[1], accs[2]
+			})
+
+			It("should return the spend limit, expiration and allow list", func() {
+				expiration := time.Unix(2_000_000_000, 0).UTC()
+				Expect(azk.SaveGrant(
+					ctx, spender, owner,
+					banktypes.NewSendAuthorization(
+						sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+						[]sdk.AccAddress{recipient},
+					),
+					&expiration,
+				)).To(Succeed())
+
+				res, err := contract.GetSendAllowances(
+					ctx, common.BytesToAddress(owner), common.BytesToAddress(spender),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(1))
+				Expect(res[0].Coins).To(HaveLen(1))
+				Expect(res[0].Coins[0].Denom).To(Equal(denom))
+				Expect(res[0].Coins[0].Amount).To(Equal(big.NewInt(100)))
+				Expect(res[0].Expiration).To(Equal(uint64(expiration.Unix())))
+				Expect(res[0].AllowList).To(Equal([]common.Address{common.BytesToAddress(recipient)}))
+			})
+
+			It("should return zero values for a grant without expiration or allow list", func() {
+				Expect(azk.SaveGrant(
+					ctx, spender, owner,
+					banktypes.NewSendAuthorization(
+						sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))), nil,
+					),
+					nil,
+				)).To(Succeed())
+
+				res, err := contract.GetSendAllowances(
+					ctx, common.BytesToAddress(owner), common.BytesToAddress(spender),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(1))
+				Expect(res[0].Expiration).To(BeZero())
+				Expect(res[0].AllowList).To(BeEmpty())
+			})
+
+			It("should return nothing without a grant", func() {
+				res, err := contract.GetSendAllowances(
+					ctx, common.BytesToAddress(owner), common.BytesToAddress(spender),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeEmpty())
+			})
+		})
+
+		When("Burn", func() {
+			var (
+				acc     sdk.AccAddress
+				mockEVM *mock.PrecompileEVMMock
+				pCtx    context.Context
+			)
+
+			BeforeEach(func() {
+				acc = simtestutil.CreateRandomAccounts(1)[0]
+				Expect(FundAccount(
+					sdk.UnwrapSDKContext(ctx), bk, acc,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+				)).To(Succeed())
+				mockEVM = mock.NewEVM()
+				pCtx = vm.NewPolarContext(ctx, mockEVM, common.BytesToAddress(acc), big.NewInt(0))
+			})
+
+			It("should burn the caller's coins", func() {
+				supplyBefore := bk.GetSupply(ctx, denom).Amount
+
+				ok, err := contract.Burn(pCtx, testutil.SdkCoinsToEvmCoins(
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40))),
+				))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+
+				Expect(bk.GetBalance(ctx, acc, denom).Amount.Int64()).To(Equal(int64(60)))
+				Expect(bk.GetSupply(ctx, denom).Amount.Int64()).To(Equal(supplyBefore.Int64() - 40))
+				moduleAcc := authtypes.NewModuleAddress(evmtypes.ModuleName)
+				Expect(bk.GetBalance(ctx, moduleAcc, denom).Amount.IsZero()).To(BeTrue())
+			})
+
+			It("should emit a burn event that maps to a log", func() {
+				sdkCtx := sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+				pCtx = vm.NewPolarContext(sdkCtx, mockEVM, common.BytesToAddress(acc), big.NewInt(0))
+				_, err := contract.Burn(pCtx, testutil.SdkCoinsToEvmCoins(
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40))),
+				))
+				Expect(err).ToNot(HaveOccurred())
+
+				events := sdkCtx.EventManager().Events()
+				idx := slices.IndexFunc(events, func(e sdk.Event) bool {
+					return e.Type == banktypes.EventTypeCoinBurn
+				})
+				Expect(idx).ToNot(Equal(-1))
+				log, err := factory.Build(&events[idx])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(log.Address).To(Equal(contract.RegistryKey()))
+			})
+
+			It("should emit a burn log with the caller as burner", func() {
+				_, err := contract.Burn(pCtx, testutil.SdkCoinsToEvmCoins(
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40))),
+				))
+				Expect(err).ToNot(HaveOccurred())
+
+				sdb := utils.MustGetAs[*mock.PolarisStateDBMock](mockEVM.GetStateDB())
+				Expect(sdb.AddLogCalls()).To(HaveLen(1))
+				filterer, err := generated.NewBankModuleFilterer(contract.RegistryKey(), nil)
+				Expect(err).ToNot(HaveOccurred())
+				burn, err := filterer.ParseBurn(*sdb.AddLogCalls()[0].Log)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(burn.Burner).To(Equal(common.BytesToAddress(acc)))
+				Expect(burn.Amount).To(HaveLen(1))
+				Expect(burn.Amount[0].Denom).To(Equal(denom))
+				Expect(burn.Amount[0].Amount.Int64()).To(Equal(int64(40)))
+			})
+
+			It("should sync the EVM balance of the caller", func() {
+				bs := &mockBalanceSyncer{}
+				syncCtx := sdk.UnwrapSDKContext(ctx).WithValue(precompile.BalanceSyncerContextKey, bs)
+				pCtx = vm.NewPolarContext(syncCtx, mockEVM, common.BytesToAddress(acc), big.NewInt(0))
+				_, err := contract.Burn(pCtx, testutil.SdkCoinsToEvmCoins(
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40))),
+				))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bs.synced).To(Equal([]common.Address{common.BytesToAddress(acc)}))
+			})
+
+			It("should fail to burn more than the balance", func() {
+				ok, err := contract.Burn(pCtx, testutil.SdkCoinsToEvmCoins(
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(101))),
+				))
+				Expect(err).To(HaveOccurred())
+				Expect(ok).To(BeFalse())
+				Expect(bk.GetBalance(ctx, acc, denom).Amount.Int64()).To(Equal(int64(100)))
+			})
+
+			It("should fail to burn zero coins", func() {
+				_, err := contract.Burn(pCtx, testutil.SdkCoinsToEvmCoins(
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(0))),
+				))
+				Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+			})
+		})
+
+		When("Revoke and DecreaseAllowance", func() {
+			var (
+				owner, spender sdk.AccAddress
+				mockEVM        *mock.PrecompileEVMMock
+				pCtx           context.Context
+			)
+
+			BeforeEach(func() {
+				accs := simtestutil.CreateRandomAccounts(2)
+				owner, spender = accs[0], accs[1]
+				expiration := time.Unix(2_000_000_000, 0).UTC()
+				Expect(azk.SaveGrant(
+					ctx, spender, owner,
+					banktypes.NewSendAuthorization(sdk.NewCoins(
+						sdk.NewCoin(denom, sdkmath.NewInt(100)),
+						sdk.NewCoin(denom2, sdkmath.NewInt(50)),
+					), nil),
+					&expiration,
+				)).To(Succeed())
+				mockEVM = mock.NewEVM()
+				pCtx = vm.NewPolarContext(ctx, mockEVM, common.BytesToAddress(owner), big.NewInt(0))
+			})
+
+			approvals := func() []*generated.BankModuleApproval {
+				filterer, err := generated.NewBankModuleFilterer(contract.RegistryKey(), nil)
+				Expect(err).ToNot(HaveOccurred())
+				sdb := utils.MustGetAs[*mock.PolarisStateDBMock](mockEVM.GetStateDB())
+				res := make([]*generated.BankModuleApproval, 0)
+				for _, call := range sdb.AddLogCalls() {
+					var approval *generated.BankModuleApproval
+					approval, err = filterer.ParseApproval(*call.Log)
+					Expect(err).ToNot(HaveOccurred())
+					res = append(res, approval)
+				}
+				return res
+			}
+
+			decrease := func(coins sdk.Coins) error {
+				_, err := contract.DecreaseAllowance(
+					pCtx, common.BytesToAddress(spender), testutil.SdkCoinsToEvmCoins(coins),
+				)
+				return err
+			}
+
+			It("should revoke the grant", func() {
+				ok, err := contract.Revoke(pCtx, common.BytesToAddress(spender))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+
+				grants, err := contract.GetAllowances(ctx, common.BytesToAddress(owner))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(grants).To(BeEmpty())
+
+				logs := approvals()
+				Expect(logs).To(HaveLen(2))
+				for _, approval := range logs {
+					Expect(approval.Owner).To(Equal(common.BytesToAddress(owner)))
+					Expect(approval.Spender).To(Equal(common.BytesToAddress(spender)))
+					Expect(approval.Value.Sign()).To(BeZero())
+				}
+				Expect([]string{logs[0].Denom, logs[1].Denom}).To(ConsistOf(denom, denom2))
+			})
+
+			It("should fail to revoke a missing grant", func() {
+				ok, err := contract.Revoke(pCtx, common.BytesToAddress(owner))
+				Expect(err).To(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+
+			It("should lower the spend limit and keep the expiration", func() {
+				Expect(decrease(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(30))))).To(Succeed())
+
+				grants, err := contract.GetAllowances(ctx, common.BytesToAddress(owner))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(grants).To(HaveLen(1))
+				Expect(grants[0].Coins).To(ConsistOf(
+					generated.CosmosCoin{Amount: big.NewInt(70), Denom: denom},
+					generated.CosmosCoin{Amount: big.NewInt(50), Denom: denom2},
+				))
+				Expect(grants[0].Expiration).To(Equal(uint64(2_000_000_000)))
+
+				logs := approvals()
+				Expect(logs).To(HaveLen(1))
+				Expect(logs[0].Owner).To(Equal(common.BytesToAddress(owner)))
+				Expect(logs[0].Spender).To(Equal(common.BytesToAddress(spender)))
+				Expect(logs[0].Value).To(Equal(big.NewInt(70)))
+				Expect(logs[0].Denom).To(Equal(denom))
+			})
+
+			It("should drop a denom whose limit reaches zero", func() {
+				Expect(decrease(sdk.NewCoins(sdk.NewCoin(denom2, sdkmath.NewInt(50))))).To(Succeed())
+
+				grants, err := contract.GetAllowances(ctx, common.BytesToAddress(owner))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(grants).To(HaveLen(1))
+				Expect(grants[0].Coins).To(ConsistOf(
+					generated.CosmosCoin{Amount: big.NewInt(100), Denom: denom},
+				))
+			})
+
+			It("should revoke the grant once the whole limit is removed", func() {
+				Expect(decrease(sdk.NewCoins(
+					sdk.NewCoin(denom, sdkmath.NewInt(100)),
+					sdk.NewCoin(denom2, sdkmath.NewInt(50)),
+				))).To(Succeed())
+
+				grants, err := contract.GetAllowances(ctx, common.BytesToAddress(owner))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(grants).To(BeEmpty())
+			})
+
+			It("should fail to decrease below zero", func() {
+				err := decrease(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(101))))
+				Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+				Expect(approvals()).To(BeEmpty())
+
+				res, err := contract.GetAllowances(ctx, common.BytesToAddress(owner))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res[0].Coins).To(ContainElement(
+					generated.CosmosCoin{Amount: big.NewInt(100), Denom: denom},
+				))
+			})
+		})
+
+		When("IsVestingAccount", func() {
+			var sdkCtx sdk.Context
+
+			BeforeEach(func() {
+				sdkCtx = sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+			})
+
+			It("should be true for a vesting account", func() {
+				vestingAddr := simtestutil.CreateRandomAccounts(1)[0]
+				baseAcc := utils.MustGetAs[*authtypes.BaseAccount](ak.NewAccountWithAddress(sdkCtx, vestingAddr))
+				vestingAcc, err := vestingtypes.NewDelayedVestingAccount(
+					baseAcc,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(60))),
+					sdkCtx.BlockTime().Add(time.Hour).Unix(),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				ak.SetAccount(sdkCtx, vestingAcc)
+
+				res, err := contract.IsVestingAccount(ctx, common.BytesToAddress(vestingAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeTrue())
+			})
+
+			It("should be false for a normal account", func() {
+				normalAddr := simtestutil.CreateRandomAccounts(1)[0]
+				ak.SetAccount(sdkCtx, ak.NewAccountWithAddress(sdkCtx, normalAddr))
+
+				res, err := contract.IsVestingAccount(ctx, common.BytesToAddress(normalAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeFalse())
+			})
+
+			It("should be false for a module account", func() {
+				moduleAcc := ak.GetModuleAccount(sdkCtx, evmtypes.ModuleName)
+				Expect(moduleAcc).ToNot(BeNil())
+
+				res, err := contract.IsVestingAccount(ctx, common.BytesToAddress(moduleAcc.GetAddress()))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeFalse())
+			})
+
+			It("should be false for an unknown account", func() {
+				res, err := contract.IsVestingAccount(ctx, common.BytesToAddress(simtestutil.CreateRandomAccounts(1)[0]))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeFalse())
+			})
+		})
+
+		When("GetAllLockedBalances", func() {
+			var sdkCtx sdk.Context
+
+			BeforeEach(func() {
+				sdkCtx = sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+			})
+
+			It("should return the locked portion of each held denom", func() {
+				vestingAddr := simtestutil.CreateRandomAccounts(1)[0]
+				baseAcc := utils.MustGetAs[*authtypes.BaseAccount](
+					ak.NewAccountWithAddress(sdkCtx, vestingAddr),
+				)
+				vestingAcc, err := vestingtypes.NewDelayedVestingAccount(
+					baseAcc,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(60)), sdk.NewCoin(denom2, sdkmath.NewInt(50))),
+					sdkCtx.BlockTime().Add(time.Hour).Unix(),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				ak.SetAccount(sdkCtx, vestingAcc)
+
+				// The held amount of denom2 is below its vesting amount, so all of it is locked.
+				Expect(FundAccount(
+					sdkCtx,
+					bk,
+					vestingAddr,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)), sdk.NewCoin(denom2, sdkmath.NewInt(30))),
+				)).To(Succeed())
+
+				res, err := contract.GetAllLockedBalances(ctx, common.BytesToAddress(vestingAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal([]lib.CosmosCoin{
+					{Denom: denom, Amount: big.NewInt(60)},
+					{Denom: denom2, Amount: big.NewInt(30)},
+				}))
+			})
+
+			It("should return nothing for a non-vesting account", func() {
+				normalAddr := simtestutil.CreateRandomAccounts(1)[0]
+				ak.SetAccount(sdkCtx, ak.NewAccountWithAddress(sdkCtx, normalAddr))
+				Expect(FundAccount(
+					sdkCtx, bk, normalAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+				)).To(Succeed())
+
+				res, err := contract.GetAllLockedBalances(ctx, common.BytesToAddress(normalAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeEmpty())
+			})
+		})
+
+		When("GetLockedCoins and GetVestingSchedule", func() {
+			var (
+				sdkCtx      sdk.Context
+				vestingAddr sdk.AccAddress
+				startTime   int64
+			)
+
+			BeforeEach(func() {
+				startTime = 1_700_000_000
+				sdkCtx = sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).
+					WithBlockTime(time.Unix(startTime+3600, 0))
+				ctx = vm.NewPolarContext(sdkCtx, nil, common.Address{}, big.NewInt(0))
+
+				vestingAddr = simtestutil.CreateRandomAccounts(1)[0]
+				baseAcc := utils.MustGetAs[*authtypes.BaseAccount](
+					ak.NewAccountWithAddress(sdkCtx, vestingAddr),
+				)
+				// The first period has vested an hour after the start, the second has not.
+				vestingAcc, err := vestingtypes.NewPeriodicVestingAccount(
+					baseAcc,
+					sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+					startTime,
+					vestingtypes.Periods{
+						{Length: 1800, Amount: sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40)))},
+						{Length: 3600, Amount: sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(60)))},
+					},
+				)
+				Expect(err).ToNot(HaveOccurred())
+				ak.SetAccount(sdkCtx, vestingAcc)
+			})
+
+			It("should return the locked balances", func() {
+				// only 40 of the 60 locked coins are still held by the account
+				Expect(FundAccount(
+					sdkCtx, bk, vestingAddr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40))),
+				)).To(Succeed())
+
+				res, err := contract.GetLockedCoins(ctx, common.BytesToAddress(vestingAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal([]lib.CosmosCoin{{Denom: denom, Amount: big.NewInt(40)}}))
+				Expect(contract.GetAllLockedBalances(ctx, common.BytesToAddress(vestingAddr))).
+					To(Equal(res))
+			})
+
+			It("should return the vesting schedule", func() {
+				res, err := contract.GetVestingSchedule(ctx, common.BytesToAddress(vestingAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.StartTime).To(Equal(uint64(startTime)))
+				Expect(res.EndTime).To(Equal(uint64(startTime + 5400)))
+				Expect(res.OriginalVesting).To(Equal([]generated.CosmosCoin{
+					{Denom: denom, Amount: big.NewInt(100)},
+				}))
+				Expect(res.VestedCoins).To(Equal([]generated.CosmosCoin{
+					{Denom: denom, Amount: big.NewInt(40)},
+				}))
+				Expect(res.Periods).To(HaveLen(2))
+				Expect(res.Periods[0].Length).To(Equal(uint64(1800)))
+				Expect(res.Periods[1].Amount).To(Equal([]generated.CosmosCoin{
+					{Denom: denom, Amount: big.NewInt(60)},
+				}))
+			})
+
+			It("should return nothing for a non-vesting account", func() {
+				normalAddr := simtestutil.CreateRandomAccounts(1)[0]
+				ak.SetAccount(sdkCtx, ak.NewAccountWithAddress(sdkCtx, normalAddr))
+
+				locked, err := contract.GetLockedCoins(ctx, common.BytesToAddress(normalAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(locked).To(BeEmpty())
+
+				schedule, err := contract.GetVestingSchedule(ctx, common.BytesToAddress(normalAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(schedule.EndTime).To(BeZero())
+				Expect(schedule.OriginalVesting).To(BeEmpty())
+				Expect(schedule.Periods).To(BeEmpty())
+			})
+		})
+
+		When("CanCall", func() {
+			var (
+				pk      *mockParamsKeeper
+				allowed common.Address
+				caller  common.Address
+				burn    [4]byte
+			)
+
+			BeforeEach(func() {
+				pk = &mockParamsKeeper{params: evmtypes.DefaultParams()}
+				contract.SetParamsKeeper(pk)
+				allowed = common.BytesToAddress(simtestutil.CreateRandomAccounts(1)[0])
+				caller = common.BytesToAddress(simtestutil.CreateRandomAccounts(1)[0])
+				copy(burn[:], contract.ABIMethods()["burn"].ID)
+			})
+
+			It("should allow anyone to call an unrestricted method", func() {
+				Expect(contract.CanCall(ctx, caller, burn)).To(BeTrue())
+			})
+
+			It("should only allow the allowed callers to call a restricted method", func() {
+				pk.params.RestrictedMethods = []*evmtypes.RestrictedMethod{{
+					Precompile:     contract.RegistryKey().Hex(),
+					Method:         hexutil.Encode(burn[:]),
+					AllowedCallers: []string{allowed.Hex()},
+				}}
+				Expect(contract.CanCall(ctx, allowed, burn)).To(BeTrue())
+				Expect(contract.CanCall(ctx, caller, burn)).To(BeFalse())
+			})
+
+			It("should return false for an unknown selector", func() {
+				Expect(contract.CanCall(ctx, caller, [4]byte{0xde, 0xad, 0xbe, 0xef})).To(BeFalse())
+			})
+		})
+
+		When("BatchHexToBech32 and BatchBech32ToHex", func() {
+			It("should round-trip a batch of addresses", func() {
+				accs := simtestutil.CreateRandomAccounts(3)
+				hexes := make([]common.Address, len(accs))
+				for i, a := range accs {
+					hexes[i] = common.BytesToAddress(a)
+				}
+
+				bech32s, err := contract.BatchHexToBech32(ctx, hexes)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bech32s).To(HaveLen(len(accs)))
+				for i, a := range accs {
+					Expect(bech32s[i]).To(Equal(a.String()))
+				}
+
+				res, err := contract.BatchBech32ToHex(ctx, bech32s)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(hexes))
+			})
+
+			It("should reject a malformed bech32 with its index", func() {
+				accs := simtestutil.CreateRandomAccounts(2)
+				_, err := contract.BatchBech32ToHex(
+					ctx, []string{accs[0].String(), "invalid", accs[1].String()},
+				)
+				Expect(err).To(MatchError(precompile.ErrInvalidBech32Address))
+				Expect(err.Error()).To(ContainSubstring("index 1"))
+			})
+
+			It("should charge gas for every address", func() {
+				accs := simtestutil.CreateRandomAccounts(3)
+				hexes := make([]common.Address, len(accs))
+				for i, a := range accs {
+					hexes[i] = common.BytesToAddress(a)
+				}
+
+				sdkCtx := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+				gasUsed := func(gasPerItem uint64) uint64 {
+					contract.SetGasPerItem(gasPerItem)
+					gm := storetypes.NewInfiniteGasMeter()
+					_, err := contract.BatchHexToBech32(vm.NewPolarContext(
+						sdkCtx.WithGasMeter(gm), nil, common.Address{}, big.NewInt(0),
+					), hexes)
+					Expect(err).ToNot(HaveOccurred())
+					return gm.GasConsumed()
+				}
+				Expect(gasUsed(1_000) - gasUsed(0)).To(Equal(uint64(3_000)))
+			})
+		})
+
 		When("Send", func() {
 			It("should succeed", func() {
 
@@ -454,7 +1507,8 @@ var _ = Describe("Bank Precompile Test", func() {
 				accs := simtestutil.CreateRandomAccounts(2)
 				fromAcc, toAcc := accs[0], accs[1]
 
-				pCtx := vm.NewPolarContext(ctx, nil, common.BytesToAddress(fromAcc), new(big.Int))
+				mockEVM := mock.NewEVM()
+				pCtx := vm.NewPolarContext(ctx, mockEVM, common.BytesToAddress(fromAcc), new(big.Int))
 
 				sortedSdkCoins := sdk.NewCoins(
 					sdk.NewCoin(
@@ -489,6 +1543,21 @@ var _ = Describe("Bank Precompile Test", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(balances.Balances).To(Equal(sortedSdkCoins))
+
+				// an ERC20-style transfer log is emitted per denom
+				filterer, err := generated.NewBankModuleFilterer(contract.RegistryKey(), nil)
+				Expect(err).ToNot(HaveOccurred())
+				sdb := utils.MustGetAs[*mock.PolarisStateDBMock](mockEVM.GetStateDB())
+				Expect(sdb.AddLogCalls()).To(HaveLen(2))
+				for i, call := range sdb.AddLogCalls() {
+					var transfer *generated.BankModuleTransfer0
+					transfer, err = filterer.ParseTransfer0(*call.Log)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(transfer.From).To(Equal(common.BytesToAddress(fromAcc)))
+					Expect(transfer.To).To(Equal(common.BytesToAddress(toAcc)))
+					Expect(transfer.Value).To(Equal(balanceAmount))
+					Expect(transfer.Denom).To(Equal(sortedSdkCoins[i].Denom))
+				}
 			})
 
 			It("should error when sending 0 coins", func() {
@@ -516,6 +1585,119 @@ var _ = Describe("Bank Precompile Test", func() {
 					testutil.SdkCoinsToEvmCoins(coinsToSend),
 				)
 				Expect(err).To(MatchError(precompile.ErrInvalidCoin))
+				name, args := customError(err)
+				Expect(name).To(Equal("InvalidCoins"))
+				Expect(args).To(BeEmpty())
+			})
+
+			When("the send fails", func() {
+				var (
+					fromAcc, toAcc sdk.AccAddress
+					pCtx           context.Context
+				)
+
+				BeforeEach(func() {
+					accs := simtestutil.CreateRandomAccounts(2)
+					fromAcc, toAcc = accs[0], accs[1]
+					Expect(FundAccount(
+						sdk.UnwrapSDKContext(ctx), bk, fromAcc,
+						sdk.NewCoins(
+							sdk.NewCoin(denom, sdkmath.NewInt(100)),
+							sdk.NewCoin(denom2, sdkmath.NewInt(40)),
+						),
+					)).To(Succeed())
+					pCtx = vm.NewPolarContext(ctx, nil, common.BytesToAddress(fromAcc), new(big.Int))
+				})
+
+				It("should revert with SendDisabled", func() {
+					bk.SetSendEnabled(ctx, denom, true)
+					bk.SetSendEnabled(ctx, denom2, false)
+
+					_, err := contract.Send(
+						pCtx, common.BytesToAddress(toAcc), testutil.SdkCoinsToEvmCoins(sdk.NewCoins(
+							sdk.NewCoin(denom, sdkmath.NewInt(10)),
+							sdk.NewCoin(denom2, sdkmath.NewInt(10)),
+						)),
+					)
+					Expect(err).To(MatchError(banktypes.ErrSendDisabled))
+					name, args := customError(err)
+					Expect(name).To(Equal("SendDisabled"))
+					Expect(args).To(Equal([]any{denom2}))
+				})
+
+				It("should revert with InsufficientSpendableBalance", func() {
+					bk.SetSendEnabled(ctx, denom, true)
+					bk.SetSendEnabled(ctx, denom2, true)
+
+					_, err := contract.Send(
+						pCtx, common.BytesToAddress(toAcc), testutil.SdkCoinsToEvmCoins(sdk.NewCoins(
+							sdk.NewCoin(denom, sdkmath.NewInt(60)),
+							sdk.NewCoin(denom2, sdkmath.NewInt(50)),
+						)),
+					)
+					Expect(err).To(HaveOccurred())
+					name, args := customError(err)
+					Expect(name).To(Equal("InsufficientSpendableBalance"))
+					Expect(args).To(Equal([]any{denom2, big.NewInt(40), big.NewInt(50)}))
+
+					// nothing was sent
+					Expect(bk.GetBalance(ctx, fromAcc, denom).Amount.Int64()).To(Equal(int64(100)))
+				})
+			})
+
+			When("the recipient is a bech32 address", func() {
+				var (
+					fromAcc sdk.AccAddress
+					mockEVM *mock.PrecompileEVMMock
+					pCtx    context.Context
+					coins   sdk.Coins
+				)
+
+				BeforeEach(func() {
+					fromAcc = simtestutil.CreateRandomAccounts(1)[0]
+					coins = sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))
+					Expect(FundAccount(sdk.UnwrapSDKContext(ctx), bk, fromAcc, coins)).To(Succeed())
+					bk.SetSendEnabled(ctx, denom, true)
+					mockEVM = mock.NewEVM()
+					pCtx = vm.NewPolarContext(ctx, mockEVM, common.BytesToAddress(fromAcc), new(big.Int))
+				})
+
+				It("should send to a 32 byte address of another prefix without logs", func() {
+					toAcc := sdk.AccAddress(address.Module("test", []byte("sub")))
+					Expect(toAcc).To(HaveLen(32))
+					toBech32, err := bech32.ConvertAndEncode("osmo", toAcc)
+					Expect(err).ToNot(HaveOccurred())
+
+					ok, err := contract.Send0(pCtx, toBech32, testutil.SdkCoinsToEvmCoins(coins))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ok).To(BeTrue())
+					Expect(bk.GetAllBalances(ctx, toAcc)).To(Equal(coins))
+
+					sdb := utils.MustGetAs[*mock.PolarisStateDBMock](mockEVM.GetStateDB())
+					Expect(sdb.AddLogCalls()).To(BeEmpty())
+				})
+
+				It("should send to a 20 byte address with a transfer log", func() {
+					toAcc := simtestutil.CreateRandomAccounts(1)[0]
+
+					ok, err := contract.Send0(pCtx, toAcc.String(), testutil.SdkCoinsToEvmCoins(coins))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ok).To(BeTrue())
+					Expect(bk.GetAllBalances(ctx, toAcc)).To(Equal(coins))
+
+					filterer, err := generated.NewBankModuleFilterer(contract.RegistryKey(), nil)
+					Expect(err).ToNot(HaveOccurred())
+					sdb := utils.MustGetAs[*mock.PolarisStateDBMock](mockEVM.GetStateDB())
+					Expect(sdb.AddLogCalls()).To(HaveLen(1))
+					transfer, err := filterer.ParseTransfer0(*sdb.AddLogCalls()[0].Log)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(transfer.To).To(Equal(common.BytesToAddress(toAcc)))
+				})
+
+				It("should fail on an invalid bech32 address", func() {
+					_, err := contract.Send0(pCtx, "invalid", testutil.SdkCoinsToEvmCoins(coins))
+					Expect(err).To(MatchError(precompile.ErrInvalidBech32Address))
+				})
 			})
 		})
 	})
@@ -556,3 +1738,52 @@ func getTestMetadata() []banktypes.Metadata {
 		},
 	}
 }
+
+// customError returns the name and args of the custom error of the bank precompile ABI that the
+// given error reverts with.
+func customError(err error) (string, []any) {
+	var revertErr ethprecompile.RevertError
+	Expect(errors.As(err, &revertErr)).To(BeTrue())
+	data := revertErr.RevertData()
+
+	bankABI, abiErr := generated.BankModuleMetaData.GetAbi()
+	Expect(abiErr).ToNot(HaveOccurred())
+	for name, customErr := range bankABI.Errors {
+		if bytes.Equal(customErr.ID.Bytes()[:4], data[:4]) {
+			args, unpackErr := customErr.Inputs.Unpack(data[4:])
+			Expect(unpackErr).ToNot(HaveOccurred())
+			return name, args
+		}
+	}
+	Fail("unknown custom error")
+	return "", nil
+}
+
+// pageRequest builds a page request in the form the precompile receives it from the ABI decoder.
+func pageRequest(key string, limit uint64) any {
+	return struct {
+		Key        string `json:"key"`
+		Offset     uint64 `json:"offset"`
+		Limit      uint64 `json:"limit"`
+		CountTotal bool   `json:"count_total"`
+		Reverse    bool   `json:"reverse"`
+	}{Key: key, Limit: limit}
+}
+
+// mockParamsKeeper returns the given x/evm params.
+type mockParamsKeeper struct {
+	params evmtypes.Params
+}
+
+func (mpk *mockParamsKeeper) GetParams(sdk.Context) evmtypes.Params {
+	return mpk.params
+}
+
+// mockBalanceSyncer records the accounts whose EVM balances are synced.
+type mockBalanceSyncer struct {
+	synced []common.Address
+}
+
+func (mbs *mockBalanceSyncer) SyncBalance(addr common.Address) {
+	mbs.synced = append(mbs.synced, addr)
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bech32
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/bech32"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// ModuleName is the name used to derive the address of the bech32 precompile.
+const ModuleName = "bech32"
+
+// Contract is the precompile contract for converting between EVM addresses and bech32 strings.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	addressCodec address.Codec
+}
+
+// NewPrecompileContract creates a new precompile contract for bech32 address conversions.
+func NewPrecompileContract(ak cosmlib.CodecProvider) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.Bech32MetaData.ABI,
+			// Precompile Address: 0xBdA2EbCbf0Bd6BC4EE1c330a64A9Ff95E839Cc2d
+			common.BytesToAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+		addressCodec: ak.AddressCodec(),
+	}
+}
+
+// ToBech32 implements the `toBech32(address,string)` method. An empty prefix uses the chain's
+// account address codec.
+func (c *Contract) ToBech32(
+	_ context.Context,
+	account common.Address,
+	prefix string,
+) (string, error) {
+	codec := c.addressCodec
+	if prefix != "" {
+		codec = addresscodec.NewBech32Codec(prefix)
+	}
+	return cosmlib.StringFromEthAddress(codec, account)
+}
+
+// FromBech32 implements the `fromBech32(string)` method.
+func (c *Contract) FromBech32(
+	_ context.Context,
+	bech32Address string,
+) (common.Address, error) {
+	// decode the prefix first, so that addresses of any chain can be converted.
+	prefix, _, err := bech32.DecodeAndConvert(bech32Address)
+	if err != nil {
+		return common.Address{}, precompile.ErrInvalidBech32Address
+	}
+
+	bz, err := addresscodec.NewBech32Codec(prefix).StringToBytes(bech32Address)
+	if err != nil || len(bz) != common.AddressLength {
+		return common.Address{}, precompile.ErrInvalidBech32Address
+	}
+	return common.BytesToAddress(bz), nil
+}
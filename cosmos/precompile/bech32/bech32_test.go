@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bech32_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkbech32 "github.com/cosmos/cosmos-sdk/types/bech32"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/bech32"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBech32Precompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/bech32")
+}
+
+var _ = Describe("Bech32 Precompile Test", func() {
+	var (
+		contract *bech32.Contract
+		ak       authkeeper.AccountKeeper
+		ctx      context.Context
+		account  = testutils.Alice
+	)
+
+	BeforeEach(func() {
+		var sdkCtx sdk.Context
+		sdkCtx, ak, _, _ = testutils.SetupMinimalKeepers()
+		contract = bech32.NewPrecompileContract(ak)
+		ctx = vm.NewPolarContext(sdkCtx, nil, common.Address{}, big.NewInt(0))
+	})
+
+	It("should have the bech32 address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(bech32.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("ToBech32", func() {
+		It("should use the chain prefix if the prefix is empty", func() {
+			expected, err := ak.AddressCodec().BytesToString(account.Bytes())
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := contract.ToBech32(ctx, account, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(expected))
+		})
+
+		It("should use the given prefix", func() {
+			expected, err := sdkbech32.ConvertAndEncode("cosmos", account.Bytes())
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := contract.ToBech32(ctx, account, "cosmos")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(expected))
+		})
+	})
+
+	When("FromBech32", func() {
+		It("should convert addresses of any prefix", func() {
+			for _, prefix := range []string{"cosmos", "osmo"} {
+				addr, err := sdkbech32.ConvertAndEncode(prefix, account.Bytes())
+				Expect(err).ToNot(HaveOccurred())
+
+				res, err := contract.FromBech32(ctx, addr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(account))
+			}
+		})
+
+		It("should round trip with ToBech32", func() {
+			addr, err := contract.ToBech32(ctx, account, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := contract.FromBech32(ctx, addr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(account))
+		})
+
+		It("should fail on an invalid bech32 string", func() {
+			_, err := contract.FromBech32(ctx, "invalid")
+			Expect(err).To(MatchError(precompile.ErrInvalidBech32Address))
+		})
+
+		It("should fail if the address is not 20 bytes", func() {
+			addr, err := sdkbech32.ConvertAndEncode("cosmos", make([]byte, 32))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = contract.FromBech32(ctx, addr)
+			Expect(err).To(MatchError(precompile.ErrInvalidBech32Address))
+		})
+	})
+})
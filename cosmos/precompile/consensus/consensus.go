@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package consensus
+
+import (
+	"context"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/consensus"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// Contract is the precompile contract for the consensus module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	querier consensustypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the consensus module precompile contract.
+func NewPrecompileContract(q consensustypes.QueryServer) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.ConsensusModuleMetaData.ABI,
+			// Precompile Address: 0xC983C585aC3c40D920834f96200066352ff58E32
+			common.BytesToAddress(authtypes.NewModuleAddress(consensustypes.ModuleName)),
+		),
+		querier: q,
+	}
+}
+
+// GetBlockParams implements `getBlockParams()` method.
+func (c *Contract) GetBlockParams(
+	ctx context.Context,
+) (generated.IConsensusModuleBlockParams, error) {
+	res, err := c.querier.Params(ctx, &consensustypes.QueryParamsRequest{})
+	if err != nil {
+		return generated.IConsensusModuleBlockParams{}, err
+	}
+
+	block := res.GetParams().GetBlock()
+	return generated.IConsensusModuleBlockParams{
+		MaxBytes: block.GetMaxBytes(),
+		MaxGas:   block.GetMaxGas(),
+	}, nil
+}
+
+// GetEvidenceParams implements `getEvidenceParams()` method.
+func (c *Contract) GetEvidenceParams(
+	ctx context.Context,
+) (generated.IConsensusModuleEvidenceParams, error) {
+	res, err := c.querier.Params(ctx, &consensustypes.QueryParamsRequest{})
+	if err != nil {
+		return generated.IConsensusModuleEvidenceParams{}, err
+	}
+
+	evidence := res.GetParams().GetEvidence()
+	return generated.IConsensusModuleEvidenceParams{
+		MaxAgeNumBlocks: evidence.GetMaxAgeNumBlocks(),
+		MaxAgeDuration:  int64(evidence.GetMaxAgeDuration().Seconds()),
+		MaxBytes:        evidence.GetMaxBytes(),
+	}, nil
+}
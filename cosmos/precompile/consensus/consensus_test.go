@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package consensus_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	consensusmodule "github.com/cosmos/cosmos-sdk/x/consensus"
+	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/consensus"
+	"pkg.berachain.dev/polaris/cosmos/precompile/consensus"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConsensusPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/consensus")
+}
+
+var _ = Describe("Consensus Precompile Test", func() {
+	var (
+		contract *consensus.Contract
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		sdkCtx := testutils.NewContext()
+		encCfg := testutils.MakeTestEncodingConfig(consensusmodule.AppModuleBasic{})
+		ck := consensuskeeper.NewKeeper(
+			encCfg.Codec,
+			runtime.NewKVStoreService(storetypes.NewKVStoreKey(consensustypes.StoreKey)),
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			runtime.EventService{},
+		)
+
+		params := cmttypes.DefaultConsensusParams()
+		params.Block.MaxBytes = 1_000_000
+		params.Block.MaxGas = 30_000_000
+		params.Evidence.MaxAgeNumBlocks = 1000
+		params.Evidence.MaxAgeDuration = 2 * time.Hour
+		params.Evidence.MaxBytes = 4096
+		Expect(ck.ParamsStore.Set(sdkCtx, params.ToProto())).To(Succeed())
+
+		contract = consensus.NewPrecompileContract(ck)
+		ctx = vm.NewPolarContext(sdkCtx, nil, common.Address{}, big.NewInt(0))
+	})
+
+	It("should have the consensus module account address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(consensustypes.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return the block params", func() {
+		res, err := contract.GetBlockParams(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(generated.IConsensusModuleBlockParams{
+			MaxBytes: 1_000_000,
+			MaxGas:   30_000_000,
+		}))
+	})
+
+	It("should return the evidence params", func() {
+		res, err := contract.GetEvidenceParams(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(generated.IConsensusModuleEvidenceParams{
+			MaxAgeNumBlocks: 1000,
+			MaxAgeDuration:  7200,
+			MaxBytes:        4096,
+		}))
+	})
+})
@@ -139,6 +139,119 @@ func (c *Contract) WithdrawDelegatorReward(
 	return amount, nil
 }
 
+// WithdrawDelegatorRewards is the precompile contract method for the `withdrawDelegatorRewards()`
+// method.
+func (c *Contract) WithdrawDelegatorRewards(
+	ctx context.Context,
+) ([]lib.CosmosCoin, error) {
+	delAddr, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := c.querier.DelegatorValidators(
+		ctx, &distributiontypes.QueryDelegatorValidatorsRequest{DelegatorAddress: delAddr},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for _, valAddr := range vals.Validators {
+		var res *distributiontypes.MsgWithdrawDelegatorRewardResponse
+		res, err = c.msgServer.WithdrawDelegatorReward(
+			ctx, &distributiontypes.MsgWithdrawDelegatorReward{
+				DelegatorAddress: delAddr,
+				ValidatorAddress: valAddr,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(res.Amount...)
+	}
+
+	return cosmlib.SdkCoinsToEvmCoins(total), nil
+}
+
+// FundCommunityPool is the precompile contract method for the `fundCommunityPool(Coin[])` method.
+func (c *Contract) FundCommunityPool(
+	ctx context.Context,
+	amount any,
+) (bool, error) {
+	coins, err := cosmlib.ExtractCoinsFromInput(amount)
+	if err != nil {
+		return false, err
+	}
+	depositor, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = c.msgServer.FundCommunityPool(ctx, &distributiontypes.MsgFundCommunityPool{
+		Amount:    coins,
+		Depositor: depositor,
+	})
+	return err == nil, err
+}
+
+// GetDelegatorRewards implements `getDelegatorRewards(address,address)`.
+func (c *Contract) GetDelegatorRewards(
+	ctx context.Context,
+	delegator common.Address,
+	validator common.Address,
+) ([]lib.CosmosCoin, error) {
+	delAddr, err := cosmlib.StringFromEthAddress(c.addressCodec, delegator)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := cosmlib.StringFromEthAddress(c.vs.ValidatorAddressCodec(), validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: CacheContext is necessary here because this is a view method (EVM static call), but
+	// the Cosmos SDK distribution module's querier performs writes to the context kv stores. The
+	// cache context is never committed and discarded after this function call.
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	res, err := c.querier.DelegationRewards( // performs writes to the context kv stores
+		cacheCtx,
+		&distributiontypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return decCoinsToEvmCoins(res.Rewards), nil
+}
+
+// GetValidatorCommission implements `getValidatorCommission(address)`.
+func (c *Contract) GetValidatorCommission(
+	ctx context.Context,
+	validator common.Address,
+) ([]lib.CosmosCoin, error) {
+	valAddr, err := cosmlib.StringFromEthAddress(c.vs.ValidatorAddressCodec(), validator)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.querier.ValidatorCommission(
+		ctx, &distributiontypes.QueryValidatorCommissionRequest{ValidatorAddress: valAddr},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return decCoinsToEvmCoins(res.Commission.Commission), nil
+}
+
 // GetDelegatorReward implements `getAllDelegatorRewards(address)`.
 func (c *Contract) GetAllDelegatorRewards(
 	ctx context.Context,
@@ -211,14 +324,7 @@ func (c *Contract) GetTotalDelegatorReward(
 		return nil, err
 	}
 
-	amount := make([]lib.CosmosCoin, 0, len(res.Total))
-	for _, coin := range res.Total {
-		amount = append(amount, lib.CosmosCoin{
-			Denom:  coin.Denom,
-			Amount: coin.Amount.TruncateInt().BigInt(),
-		})
-	}
-	return amount, nil
+	return decCoinsToEvmCoins(res.Total), nil
 }
 
 // ConvertValAddressFromBech32 converts a Cosmos string representing a validator address to a
@@ -234,3 +340,16 @@ func (c *Contract) ConvertAccAddressFromString(attributeValue string) (any, erro
 	// extract the sdk.AccAddress from string value as common.Address
 	return cosmlib.EthAddressFromString(c.addressCodec, attributeValue)
 }
+
+// decCoinsToEvmCoins converts the given decimal coins into `Coin` structs, truncating their
+// amounts.
+func decCoinsToEvmCoins(decCoins sdk.DecCoins) []lib.CosmosCoin {
+	coins := make([]lib.CosmosCoin, 0, len(decCoins))
+	for _, coin := range decCoins {
+		coins = append(coins, lib.CosmosCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.TruncateInt().BigInt(),
+		})
+	}
+	return coins
+}
@@ -44,6 +44,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	precompiletestutil "pkg.berachain.dev/polaris/cosmos/precompile/testutil"
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
@@ -174,6 +175,26 @@ var _ = Describe("Distribution Precompile Test", func() {
 		})
 	})
 
+	When("FundCommunityPool", func() {
+		It("should send the caller's coins to the community pool", func() {
+			coins := sdk.NewCoins(amt)
+			Expect(bk.MintCoins(ctx, distributiontypes.ModuleName, coins)).To(Succeed())
+			Expect(bk.SendCoinsFromModuleToAccount(
+				ctx, distributiontypes.ModuleName, testutil.Alice.Bytes(), coins,
+			)).To(Succeed())
+
+			pCtx := vm.NewPolarContext(ctx, nil, testutil.Alice, big.NewInt(0))
+			res, err := contract.FundCommunityPool(pCtx, precompiletestutil.SdkCoinsToEvmCoins(coins))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeTrue())
+
+			Expect(bk.GetBalance(ctx, testutil.Alice.Bytes(), amt.Denom).IsZero()).To(BeTrue())
+			feePool, err := dk.FeePool.Get(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(feePool.CommunityPool.AmountOf(amt.Denom)).To(Equal(sdkmath.LegacyNewDec(100)))
+		})
+	})
+
 	When("Withdraw Delegator Rewards", func() {
 		var addr sdk.AccAddress
 		var tokens sdk.DecCoins
@@ -261,6 +282,53 @@ var _ = Describe("Distribution Precompile Test", func() {
 			})
 		})
 
+		When("Withdrawing all rewards of the caller", func() {
+			It("should withdraw the rewards of every delegation", func() {
+				pCtx := vm.NewPolarContext(ctx, nil, common.BytesToAddress(addr), big.NewInt(0))
+				rewards, _ := tokens.TruncateDecimal()
+
+				res1, err := contract.GetDelegatorRewards(
+					pCtx, common.BytesToAddress(addr), common.BytesToAddress(valAddr),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res1[0].Denom).To(Equal(sdk.DefaultBondDenom))
+				Expect(res1[0].Amount).To(Equal(rewards[0].Amount.BigInt()))
+
+				res2, err := contract.WithdrawDelegatorRewards(pCtx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res2).To(HaveLen(1))
+				Expect(res2[0].Denom).To(Equal(sdk.DefaultBondDenom))
+				Expect(res2[0].Amount).To(Equal(rewards[0].Amount.BigInt()))
+			})
+
+			It("should withdraw nothing without delegations", func() {
+				pCtx := vm.NewPolarContext(ctx, nil, testutil.Alice, big.NewInt(0))
+				res, err := contract.WithdrawDelegatorRewards(pCtx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeEmpty())
+			})
+		})
+
+		When("Reading the validator commission", func() {
+			It("should return the accumulated commission", func() {
+				commission := sdk.DecCoins{sdk.NewDecCoinFromDec(
+					sdk.DefaultBondDenom, sdkmath.LegacyNewDecWithPrec(12345, 1),
+				)}
+				Expect(dk.SetValidatorAccumulatedCommission(
+					ctx, valAddr, distributiontypes.ValidatorAccumulatedCommission{
+						Commission: commission,
+					},
+				)).To(Succeed())
+
+				pCtx := vm.NewPolarContext(ctx, nil, testutil.Alice, big.NewInt(0))
+				res, err := contract.GetValidatorCommission(pCtx, common.BytesToAddress(valAddr))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(HaveLen(1))
+				Expect(res[0].Denom).To(Equal(sdk.DefaultBondDenom))
+				Expect(res[0].Amount).To(Equal(big.NewInt(1234)))
+			})
+		})
+
 		When("Reading Params", func() {
 			It("Should get if withdraw forwarding is enabled", func() {
 				pCtx := vm.NewPolarContext(
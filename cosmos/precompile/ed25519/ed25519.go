@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ed25519
+
+import (
+	"context"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/ed25519"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+const (
+	// ModuleName is the name used to derive the address of the ed25519 precompile.
+	ModuleName = "ed25519"
+
+	// verifyGas is the fixed gas cost of verifying a signature, as proposed by EIP-665.
+	verifyGas uint64 = 2000
+)
+
+// Contract is the precompile contract for verifying ed25519 signatures.
+type Contract struct {
+	ethprecompile.BaseContract
+}
+
+// NewPrecompileContract creates a new precompile contract for verifying ed25519 signatures.
+func NewPrecompileContract() *Contract {
+	c := &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.Ed25519MetaData.ABI,
+			// Precompile Address: 0x3d5F4f95cDB1cdfc71014EFA1A669fd42599A0ce
+			common.BytesToAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+	}
+	for _, method := range c.ABIMethods() {
+		if err := c.RegisterMethodGas(method.Sig, ethprecompile.MethodGas{Flat: verifyGas}); err != nil {
+			panic(err)
+		}
+	}
+	return c
+}
+
+// Verify implements the `verify(bytes,bytes,bytes)` method. Signatures are verified with the
+// same rules as CometBFT uses for validator signatures.
+func (c *Contract) Verify(
+	_ context.Context,
+	pubKey []byte,
+	message []byte,
+	signature []byte,
+) (bool, error) {
+	if len(pubKey) != ed25519.PubKeySize {
+		return false, nil
+	}
+	return ed25519.PubKey(pubKey).VerifySignature(message, signature), nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ed25519_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile/ed25519"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEd25519Precompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/ed25519")
+}
+
+var _ = Describe("Ed25519 Precompile Test", func() {
+	var (
+		contract  *ed25519.Contract
+		ctx       context.Context
+		privKey   cmted25519.PrivKey
+		message   = []byte("block signature")
+		signature []byte
+	)
+
+	BeforeEach(func() {
+		contract = ed25519.NewPrecompileContract()
+		ctx = vm.NewPolarContext(testutils.NewContext(), nil, common.Address{}, big.NewInt(0))
+
+		var err error
+		privKey = cmted25519.GenPrivKey()
+		signature, err = privKey.Sign(message)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should have the ed25519 address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(ed25519.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should charge a fixed gas cost for verifying", func() {
+		Expect(contract.GasTable()).To(HaveKeyWithValue(
+			"verify(bytes,bytes,bytes)", ethprecompile.MethodGas{Flat: 2000},
+		))
+	})
+
+	When("verifying a signature", func() {
+		It("should accept a valid signature", func() {
+			valid, err := contract.Verify(ctx, privKey.PubKey().Bytes(), message, signature)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valid).To(BeTrue())
+		})
+
+		It("should reject a signature of another message", func() {
+			valid, err := contract.Verify(ctx, privKey.PubKey().Bytes(), []byte("other"), signature)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valid).To(BeFalse())
+		})
+
+		It("should reject a signature of another key", func() {
+			otherKey := cmted25519.GenPrivKey()
+			valid, err := contract.Verify(ctx, otherKey.PubKey().Bytes(), message, signature)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valid).To(BeFalse())
+		})
+
+		It("should reject malformed keys and signatures", func() {
+			valid, err := contract.Verify(ctx, []byte("short"), message, signature)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valid).To(BeFalse())
+
+			valid, err = contract.Verify(ctx, privKey.PubKey().Bytes(), message, signature[:32])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valid).To(BeFalse())
+		})
+	})
+})
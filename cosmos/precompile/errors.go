@@ -36,4 +36,23 @@ var (
 	ErrInvalidOptions       = errors.New("invalid options")
 	ErrInvalidBytes         = errors.New("invalid bytes")
 	ErrInvalidGrantType     = errors.New("invalid grant type")
+	ErrProposalNotInVoting  = errors.New("proposal has not entered the voting period")
+	ErrInvalidHeight        = errors.New("invalid height")
+	ErrNoQueryContext       = errors.New("no query context function set")
+	ErrMsgNotAllowed        = errors.New("msg type is not allowed")
+	ErrUnknownModuleAccount = errors.New("unknown module account")
+	ErrInvalidMembers       = errors.New("invalid members")
+	ErrQueryNotAllowed      = errors.New("query path is not allowed")
+	ErrUnknownQueryPath     = errors.New("unknown query path")
+	ErrSignerNotCaller      = errors.New("msg signer is not the caller")
+	ErrUnknownMsgHandler    = errors.New("no handler registered for msg")
+	ErrMulticallFailed      = errors.New("call of multicall failed")
+	ErrPrecisionLoss        = errors.New("conversion loses precision")
+	ErrNoDenomMetadata      = errors.New("no denom metadata")
+	ErrNegativeAmount       = errors.New("amount is negative")
+	ErrAmountOverflow       = errors.New("amount exceeds 256 bits")
+	ErrInvalidDenom         = errors.New("invalid denom")
+	ErrInvalidPageKey       = errors.New("invalid page key")
+	ErrZeroAmount           = errors.New("amount is zero")
+	ErrHistoricalStateInTx  = errors.New("past heights can only be queried outside of txs")
 )
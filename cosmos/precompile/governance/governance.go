@@ -23,22 +23,28 @@ package governance
 import (
 	"context"
 	"encoding/json"
+	"math/big"
 	"strconv"
 
 	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	cbindings "pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
 	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/governance"
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
-	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile/log"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
 	"pkg.berachain.dev/polaris/eth/common"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
 )
 
 const (
@@ -53,12 +59,20 @@ type Contract struct {
 	ethprecompile.BaseContract
 
 	addressCodec address.Codec
+	cdc          codec.BinaryCodec
 	msgServer    v1.MsgServer
 	querier      v1.QueryServer
+	msgDecoder   *cosmlib.ProposalMsgDecoder
 }
 
 // NewPrecompileContract creates a new precompile contract for the governance module.
-func NewPrecompileContract(ak cosmlib.CodecProvider, m v1.MsgServer, q v1.QueryServer) *Contract {
+func NewPrecompileContract(
+	ak cosmlib.CodecProvider, cdc codec.BinaryCodec, m v1.MsgServer, q v1.QueryServer,
+) *Contract {
+	// Bank sends are ABI encoded in the returned proposals by default.
+	msgDecoder := cosmlib.NewProposalMsgDecoder(cdc)
+	msgDecoder.RegisterEncoder(&banktypes.MsgSend{}, cosmlib.NewMsgSendEncoder(ak.AddressCodec()))
+
 	return &Contract{
 		BaseContract: ethprecompile.NewBaseContract(
 			generated.GovernanceModuleMetaData.ABI,
@@ -66,16 +80,23 @@ func NewPrecompileContract(ak cosmlib.CodecProvider, m v1.MsgServer, q v1.QueryS
 			common.BytesToAddress(authtypes.NewModuleAddress(govtypes.ModuleName)),
 		),
 		addressCodec: ak.AddressCodec(),
+		cdc:          cdc,
 		msgServer:    m,
 		querier:      q,
+		msgDecoder:   msgDecoder,
 	}
 }
 
+// RegisterProposalMsgEncoder registers the encoder of the proposal messages of the same type as
+// the given message, so that they are ABI encoded in the proposals returned by the precompile.
+func (c *Contract) RegisterProposalMsgEncoder(msg sdk.Msg, encoder cosmlib.ProposalMsgEncoder) {
+	c.msgDecoder.RegisterEncoder(msg, encoder)
+}
+
 // CustomValueDecoders implements the `ethprecompile.StatefulImpl` interface.
 func (c *Contract) CustomValueDecoders() ethprecompile.ValueDecoders {
 	return ethprecompile.ValueDecoders{
-		AttributeProposalSender: log.ConvertCommonHexAddress,
-		AttributeProposalVote:   ConvertStringToVote,
+		AttributeProposalVote: ConvertStringToVote,
 	}
 }
 
@@ -91,7 +112,60 @@ func (c *Contract) SubmitProposal(
 	}
 
 	// Create the proposal.
-	res, err := c.msgServer.SubmitProposal(ctx, &p)
+	return c.submitProposal(ctx, &p)
+}
+
+// SubmitProposal0 is the method for the overloaded `submitProposal` method of the governance
+// precompile contract, which submits a proposal with the caller as the proposer.
+func (c *Contract) SubmitProposal0(
+	ctx context.Context,
+	messages [][]byte,
+	initialDeposit any,
+	metadata string,
+	title string,
+	summary string,
+) (uint64, error) {
+	proposer, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	deposit, err := cosmlib.ExtractCoinsFromInput(initialDeposit)
+	if err != nil {
+		return 0, err
+	}
+
+	// Decode the messages and cache their values, which the msg server needs to route them.
+	anyMsgs := make([]*codectypes.Any, len(messages))
+	for i, bz := range messages {
+		anyMsg := new(codectypes.Any)
+		if err = c.cdc.Unmarshal(bz, anyMsg); err != nil {
+			return 0, errorslib.Wrapf(precompile.ErrInvalidAny, "message %d: %v", i, err)
+		}
+		var msg sdk.Msg
+		if err = c.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return 0, errorslib.Wrapf(precompile.ErrInvalidAny, "message %d: %v", i, err)
+		}
+		anyMsgs[i] = anyMsg
+	}
+
+	return c.submitProposal(ctx, &v1.MsgSubmitProposal{
+		Messages:       anyMsgs,
+		InitialDeposit: deposit,
+		Proposer:       proposer,
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+	})
+}
+
+// submitProposal submits the given proposal and emits the proposal submitted event.
+func (c *Contract) submitProposal(ctx context.Context, p *v1.MsgSubmitProposal) (uint64, error) {
+	res, err := c.msgServer.SubmitProposal(ctx, p)
+	if err != nil {
+		return 0, err
+	}
 
 	// emit an event at the end of this successful proposal submission
 	polarCtx := vm.UnwrapPolarContext(ctx)
@@ -104,7 +178,7 @@ func (c *Contract) SubmitProposal(
 	)
 
 	// Return the proposal ID.
-	return res.ProposalId, err
+	return res.ProposalId, nil
 }
 
 // CancelProposal is the method for the `cancelProposal` method of the governance precompile contract.
@@ -233,6 +307,31 @@ func (c *Contract) VoteWeighted(
 	return true, nil
 }
 
+// Deposit is the method for the `deposit` method of the governance precompile contract.
+func (c *Contract) Deposit(
+	ctx context.Context,
+	proposalID uint64,
+	amount any,
+) (bool, error) {
+	depositor, err := cosmlib.StringFromEthAddress(
+		c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender(),
+	)
+	if err != nil {
+		return false, err
+	}
+	coins, err := cosmlib.ExtractCoinsFromInput(amount)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = c.msgServer.Deposit(ctx, &v1.MsgDeposit{
+		ProposalId: proposalID,
+		Depositor:  depositor,
+		Amount:     coins,
+	})
+	return err == nil, err
+}
+
 // GetProposal is the method for the `getProposal` method of the governance precompile contract.
 func (c *Contract) GetProposal(
 	ctx context.Context,
@@ -244,7 +343,7 @@ func (c *Contract) GetProposal(
 	if err != nil {
 		return generated.IGovernanceModuleProposal{}, err
 	}
-	return cosmlib.SdkProposalToGovProposal(*res.Proposal), nil
+	return cosmlib.SdkProposalToGovProposal(*res.Proposal, c.msgDecoder)
 }
 
 // GetProposals is the method for the `getProposal` method of the governance precompile contract.
@@ -253,9 +352,14 @@ func (c *Contract) GetProposals(
 	proposalStatus int32,
 	pagination any,
 ) ([]generated.IGovernanceModuleProposal, cbindings.CosmosPageResponse, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	res, err := c.querier.Proposals(ctx, &v1.QueryProposalsRequest{
 		ProposalStatus: v1.ProposalStatus(proposalStatus),
-		Pagination:     cosmlib.ExtractPageRequestFromInput(pagination),
+		Pagination:     pageReq,
 	})
 	if err != nil {
 		return nil, cbindings.CosmosPageResponse{}, err
@@ -263,7 +367,11 @@ func (c *Contract) GetProposals(
 
 	proposals := make([]generated.IGovernanceModuleProposal, 0)
 	for _, proposal := range res.Proposals {
-		proposals = append(proposals, cosmlib.SdkProposalToGovProposal(*proposal))
+		var p generated.IGovernanceModuleProposal
+		if p, err = cosmlib.SdkProposalToGovProposal(*proposal, c.msgDecoder); err != nil {
+			return nil, cbindings.CosmosPageResponse{}, err
+		}
+		proposals = append(proposals, p)
 	}
 
 	return proposals, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
@@ -322,6 +430,49 @@ func (c *Contract) GetProposalDepositsByDepositor(
 	return deposits, nil
 }
 
+// GetDeposits is the method for the `getDeposits` method of the governance precompile contract.
+func (c *Contract) GetDeposits(
+	ctx context.Context,
+	proposalID uint64,
+	pagination any,
+) ([]generated.IGovernanceModuleDeposit, cbindings.CosmosPageResponse, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
+	res, err := c.querier.Deposits(ctx, &v1.QueryDepositsRequest{
+		ProposalId: proposalID,
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
+	deposits := make([]generated.IGovernanceModuleDeposit, 0, len(res.Deposits))
+	for _, deposit := range res.Deposits {
+		var depositor common.Address
+		depositor, err = cosmlib.EthAddressFromString(c.addressCodec, deposit.Depositor)
+		if err != nil {
+			return nil, cbindings.CosmosPageResponse{}, err
+		}
+		amount := make([]generated.CosmosCoin, 0, len(deposit.Amount))
+		for _, coin := range deposit.Amount {
+			amount = append(amount, generated.CosmosCoin{
+				Denom:  coin.Denom,
+				Amount: coin.Amount.BigInt(),
+			})
+		}
+		deposits = append(deposits, generated.IGovernanceModuleDeposit{
+			ProposalId: deposit.ProposalId,
+			Depositor:  depositor,
+			Amount:     amount,
+		})
+	}
+
+	return deposits, cosmlib.SdkPageResponseToEvmPageResponse(res.Pagination), nil
+}
+
 // GetProposalVotes is the method for the `getProposalVotes` method of the governance precompile contract.
 func (c *Contract) GetProposalTallyResult(
 	ctx context.Context,
@@ -348,9 +499,14 @@ func (c *Contract) GetProposalVotes(
 	proposalID uint64,
 	pagination any,
 ) ([]generated.IGovernanceModuleVote, cbindings.CosmosPageResponse, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	res, err := c.querier.Votes(ctx, &v1.QueryVotesRequest{
 		ProposalId: proposalID,
-		Pagination: cosmlib.ExtractPageRequestFromInput(pagination),
+		Pagination: pageReq,
 	})
 	if err != nil {
 		return nil, cbindings.CosmosPageResponse{}, err
@@ -422,6 +578,31 @@ func (c *Contract) GetProposalVotesByVoter(
 	}, nil
 }
 
+// GetVotingTimeRemaining is the method for the `getVotingTimeRemaining` method of the governance
+// precompile contract.
+func (c *Contract) GetVotingTimeRemaining(
+	ctx context.Context,
+	proposalID uint64,
+) (uint64, error) {
+	res, err := c.querier.Proposal(ctx, &v1.QueryProposalRequest{
+		ProposalId: proposalID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if res.Proposal.Status == v1.StatusDepositPeriod || res.Proposal.VotingEndTime == nil {
+		return 0, errorslib.Wrapf(
+			precompile.ErrProposalNotInVoting, "proposal %d has status %s", proposalID, res.Proposal.Status,
+		)
+	}
+
+	blockTime := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()).BlockTime()
+	if !res.Proposal.VotingEndTime.After(blockTime) {
+		return 0, nil
+	}
+	return uint64(res.Proposal.VotingEndTime.Sub(blockTime).Seconds()), nil
+}
+
 // GetProposalVoteByVoter is the method for the `getProposalVoteByVoter` method of the governance
 // precompile contract.
 func (c *Contract) GetParams(
@@ -518,6 +699,32 @@ func (c *Contract) GetTallyParams(
 	}, nil
 }
 
+// GetScaledTallyParams is the method for the `getScaledTallyParams` method of the governance
+// precompile contract. The quorum, threshold and veto threshold are scaled by 1e18.
+func (c *Contract) GetScaledTallyParams(
+	ctx context.Context,
+) (*big.Int, *big.Int, *big.Int, error) {
+	res, err := c.querier.Params(ctx, &v1.QueryParamsRequest{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	quorum, err := sdkmath.LegacyNewDecFromStr(res.Params.Quorum)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	threshold, err := sdkmath.LegacyNewDecFromStr(res.Params.Threshold)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	vetoThreshold, err := sdkmath.LegacyNewDecFromStr(res.Params.VetoThreshold)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return quorum.BigInt(), threshold.BigInt(), vetoThreshold.BigInt(), nil
+}
+
 // GetConstitution is the method for the `getConstitution` method of the governance precompile contract.
 func (c *Contract) GetConstitution(
 	ctx context.Context,
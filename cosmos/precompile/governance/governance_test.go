@@ -33,8 +33,10 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	governancekeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	governancetypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -42,6 +44,7 @@ import (
 	cbindings "pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
 	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/governance"
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
 	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
 	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
 	"pkg.berachain.dev/polaris/cosmos/types"
@@ -80,6 +83,7 @@ var _ = Describe("Governance Precompile", func() {
 		sdkCtx, ak, bk, gk = testutil.Setup(mockCtrl, caller)
 		contract = utils.MustGetAs[*Contract](NewPrecompileContract(
 			ak,
+			testutils.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{}).Codec,
 			governancekeeper.NewMsgServerImpl(gk),
 			governancekeeper.NewQueryServer(gk),
 		))
@@ -100,7 +104,7 @@ var _ = Describe("Governance Precompile", func() {
 	It("Should have precompile tests and custom value decoders", func() {
 		_, err := sf.Build(contract, nil)
 		Expect(err).ToNot(HaveOccurred())
-		Expect(contract.CustomValueDecoders()).To(HaveLen(2))
+		Expect(contract.CustomValueDecoders()).To(HaveLen(1))
 	})
 
 	When("Unmarshal message and return any", func() {
@@ -172,6 +176,119 @@ var _ = Describe("Governance Precompile", func() {
 		})
 	})
 
+	When("Submitting a proposal with messages", func() {
+		var (
+			initDeposit sdk.Coins
+			send        *banktypes.MsgSend
+		)
+
+		BeforeEach(func() {
+			initDeposit = sdk.NewCoins(sdk.NewInt64Coin("abera", 100))
+			send = &banktypes.MsgSend{
+				FromAddress: gk.GetGovernanceAccount(ctx).GetAddress().String(),
+				ToAddress:   testutils.Bob.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("abera", 10)),
+			}
+		})
+
+		marshalAny := func(msg sdk.Msg) []byte {
+			msgAny, err := codectypes.NewAnyWithValue(msg)
+			Expect(err).ToNot(HaveOccurred())
+			bz, err := msgAny.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			return bz
+		}
+
+		It("should submit the proposal with the caller as proposer", func() {
+			id, err := contract.SubmitProposal0(
+				ctx,
+				[][]byte{marshalAny(send)},
+				testutil.SdkCoinsToEvmCoins(initDeposit),
+				"metadata",
+				"title",
+				"summary",
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			proposal, err := gk.Proposals.Get(ctx, id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proposal.Proposer).To(Equal(caller.String()))
+			Expect(proposal.Title).To(Equal("title"))
+			Expect(proposal.Summary).To(Equal("summary"))
+			Expect(proposal.Metadata).To(Equal("metadata"))
+			Expect(proposal.Messages).To(HaveLen(1))
+			Expect(proposal.Messages[0].TypeUrl).To(Equal(sdk.MsgTypeURL(send)))
+		})
+
+		It("should return the registered proposal messages ABI encoded", func() {
+			govAddr := gk.GetGovernanceAccount(ctx).GetAddress()
+			send.ToAddress = sdk.AccAddress(testutils.Bob.Bytes()).String()
+			multiSend := &banktypes.MsgMultiSend{
+				Inputs: []banktypes.Input{{
+					Address: govAddr.String(),
+					Coins:   sdk.NewCoins(sdk.NewInt64Coin("abera", 10)),
+				}},
+				Outputs: []banktypes.Output{{
+					Address: send.ToAddress,
+					Coins:   sdk.NewCoins(sdk.NewInt64Coin("abera", 10)),
+				}},
+			}
+			id, err := contract.SubmitProposal0(
+				ctx,
+				[][]byte{marshalAny(send), marshalAny(multiSend)},
+				testutil.SdkCoinsToEvmCoins(initDeposit),
+				"metadata",
+				"title",
+				"summary",
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			proposal, err := contract.GetProposal(ctx, id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proposal.Messages).To(HaveLen(2))
+
+			// the bank send is ABI encoded.
+			Expect(proposal.Messages[0].TypeUrl).To(Equal(sdk.MsgTypeURL(send)))
+			values, err := cosmlib.MsgSendArguments.Unpack(proposal.Messages[0].Value)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(HaveLen(3))
+			Expect(values[0]).To(Equal(common.BytesToAddress(govAddr)))
+			Expect(values[1]).To(Equal(testutils.Bob))
+			Expect(values[2]).To(HaveLen(1))
+
+			// the multi send has no registered encoder, so it is protobuf encoded.
+			Expect(proposal.Messages[1].TypeUrl).To(Equal(sdk.MsgTypeURL(multiSend)))
+			bz, err := multiSend.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proposal.Messages[1].Value).To(Equal(bz))
+		})
+
+		It("should fail if a message is not an encoded any", func() {
+			_, err := contract.SubmitProposal0(
+				ctx,
+				[][]byte{[]byte("invalid")},
+				testutil.SdkCoinsToEvmCoins(initDeposit),
+				"metadata",
+				"title",
+				"summary",
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidAny))
+		})
+
+		It("should fail if a message is not signed by the governance module", func() {
+			send.FromAddress = caller.String()
+			_, err := contract.SubmitProposal0(
+				ctx,
+				[][]byte{marshalAny(send)},
+				testutil.SdkCoinsToEvmCoins(initDeposit),
+				"metadata",
+				"title",
+				"summary",
+			)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	When("Canceling a proposal", func() {
 		It("should succeed", func() {
 			err := gk.SetProposal(ctx, v1.Proposal{
@@ -364,6 +481,58 @@ var _ = Describe("Governance Precompile", func() {
 						Expect(res[0].Amount).To(Equal(coins[0].Amount.BigInt()))
 					})
 				})
+				When("GetDeposits", func() {
+					It("should get the deposit of each depositor", func() {
+						res, pageRes, err := contract.GetDeposits(
+							ctx,
+							uint64(2),
+							cbindings.CosmosPageRequest{
+								Limit:      10,
+								CountTotal: true,
+							},
+						)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(res).To(Equal([]generated.IGovernanceModuleDeposit{{
+							ProposalId: 2,
+							Depositor:  common.BytesToAddress(caller),
+							Amount: []generated.CosmosCoin{{
+								Denom:  coins[0].Denom,
+								Amount: coins[0].Amount.BigInt(),
+							}},
+						}}))
+						Expect(pageRes.Total).To(Equal(uint64(1)))
+					})
+				})
+				When("Deposit", func() {
+					It("should add to the deposit of the caller", func() {
+						ok, err := contract.Deposit(
+							ctx,
+							uint64(2),
+							testutil.SdkCoinsToEvmCoins(coins),
+						)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(ok).To(BeTrue())
+
+						res, err := contract.GetProposalDepositsByDepositor(
+							ctx,
+							uint64(2),
+							common.BytesToAddress(caller),
+						)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(res).To(HaveLen(1))
+						Expect(res[0].Amount).To(Equal(coins[0].Amount.MulRaw(2).BigInt()))
+					})
+
+					It("should fail if the proposal does not exist", func() {
+						ok, err := contract.Deposit(
+							ctx,
+							uint64(1000),
+							testutil.SdkCoinsToEvmCoins(coins),
+						)
+						Expect(err).To(HaveOccurred())
+						Expect(ok).To(BeFalse())
+					})
+				})
 			})
 			Context("Votes", func() {
 				var vote generated.IGovernanceModuleVote
@@ -424,6 +593,69 @@ var _ = Describe("Governance Precompile", func() {
 					})
 				})
 			})
+			When("GetVotingTimeRemaining", func() {
+				var blockTime time.Time
+
+				BeforeEach(func() {
+					blockTime = time.Unix(1_700_000_000, 0).UTC()
+					votingEndTime := blockTime.Add(time.Hour)
+					err := gk.SetProposal(ctx, v1.Proposal{
+						Id:               4,
+						Proposer:         caller.String(),
+						Messages:         []*codectypes.Any{},
+						Status:           v1.StatusVotingPeriod,
+						FinalTallyResult: &v1.TallyResult{},
+						SubmitTime:       &blockTime,
+						DepositEndTime:   &blockTime,
+						TotalDeposit:     sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+						VotingStartTime:  &blockTime,
+						VotingEndTime:    &votingEndTime,
+						Metadata:         "metadata",
+						Title:            "title",
+						Summary:          "summary",
+					})
+					Expect(err).ToNot(HaveOccurred())
+					err = gk.SetProposal(ctx, v1.Proposal{
+						Id:               5,
+						Proposer:         caller.String(),
+						Messages:         []*codectypes.Any{},
+						Status:           v1.StatusDepositPeriod,
+						FinalTallyResult: &v1.TallyResult{},
+						SubmitTime:       &blockTime,
+						DepositEndTime:   &votingEndTime,
+						TotalDeposit:     sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+						Metadata:         "metadata",
+						Title:            "title",
+						Summary:          "summary",
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					ctx = vm.NewPolarContext(
+						sdkCtx.WithBlockTime(blockTime),
+						nil,
+						common.BytesToAddress(caller),
+						big.NewInt(0),
+					)
+				})
+
+				It("should return the seconds remaining for an active proposal", func() {
+					res, err := contract.GetVotingTimeRemaining(ctx, uint64(4))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res).To(Equal(uint64(time.Hour.Seconds())))
+				})
+
+				It("should return zero for a proposal whose voting has ended", func() {
+					res, err := contract.GetVotingTimeRemaining(ctx, uint64(2))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res).To(BeZero())
+				})
+
+				It("should fail for a proposal not in voting", func() {
+					_, err := contract.GetVotingTimeRemaining(ctx, uint64(5))
+					Expect(err).To(MatchError(precompile.ErrProposalNotInVoting))
+				})
+			})
+
 			When("GetParams", func() {
 				It("should get the params", func() {
 					res, err := contract.GetParams(
@@ -460,6 +692,21 @@ var _ = Describe("Governance Precompile", func() {
 					Expect(res).ToNot(BeNil())
 				})
 			})
+			When("GetScaledTallyParams", func() {
+				It("should match the gov params scaled by 1e18", func() {
+					params, err := gk.Params.Get(ctx)
+					Expect(err).ToNot(HaveOccurred())
+
+					quorum, threshold, vetoThreshold, err := contract.GetScaledTallyParams(ctx)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(quorum).To(Equal(sdkmath.LegacyMustNewDecFromStr(params.Quorum).BigInt()))
+					Expect(threshold).To(Equal(sdkmath.LegacyMustNewDecFromStr(params.Threshold).BigInt()))
+					Expect(vetoThreshold).To(Equal(sdkmath.LegacyMustNewDecFromStr(params.VetoThreshold).BigInt()))
+
+					// The default quorum is 33.4%.
+					Expect(quorum).To(Equal(new(big.Int).Mul(big.NewInt(334), big.NewInt(1e15))))
+				})
+			})
 			When("GetConstitution", func() {
 				It("should get the constitution", func() {
 					err := gk.Constitution.Set(ctx, "constitution")
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package group
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/group"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// Contract is the precompile contract for the group module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	addressCodec address.Codec
+	cdc          codec.BinaryCodec
+	msgServer    group.MsgServer
+}
+
+// NewPrecompileContract creates a new precompile contract for the group module.
+func NewPrecompileContract(
+	ak cosmlib.CodecProvider, cdc codec.BinaryCodec, m group.MsgServer,
+) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.GroupModuleMetaData.ABI,
+			// Precompile Address: 0xAD936FCBEd631fA67E05C3eA03953905221C9d46
+			common.BytesToAddress(authtypes.NewModuleAddress(group.ModuleName)),
+		),
+		addressCodec: ak.AddressCodec(),
+		cdc:          cdc,
+		msgServer:    m,
+	}
+}
+
+// CreateGroup is the method for the `createGroup` method of the group precompile contract.
+func (c *Contract) CreateGroup(
+	ctx context.Context,
+	members any,
+	metadata string,
+) (uint64, error) {
+	admin, err := c.caller(ctx)
+	if err != nil {
+		return 0, err
+	}
+	memberRequests, err := c.extractMembers(members)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.msgServer.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:    admin,
+		Members:  memberRequests,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.GroupId, nil
+}
+
+// CreateGroupPolicy is the method for the `createGroupPolicy` method of the group precompile
+// contract.
+func (c *Contract) CreateGroupPolicy(
+	ctx context.Context,
+	groupID uint64,
+	metadata string,
+	threshold string,
+	votingPeriod uint64,
+	minExecutionPeriod uint64,
+) (string, error) {
+	admin, err := c.caller(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	msg := &group.MsgCreateGroupPolicy{
+		Admin:    admin,
+		GroupId:  groupID,
+		Metadata: metadata,
+	}
+	if err = msg.SetDecisionPolicy(group.NewThresholdDecisionPolicy(
+		threshold,
+		time.Duration(votingPeriod)*time.Second,
+		time.Duration(minExecutionPeriod)*time.Second,
+	)); err != nil {
+		return "", err
+	}
+
+	res, err := c.msgServer.CreateGroupPolicy(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+	return res.Address, nil
+}
+
+// SubmitProposal is the method for the `submitProposal` method of the group precompile contract.
+func (c *Contract) SubmitProposal(
+	ctx context.Context,
+	groupPolicy string,
+	messages [][]byte,
+	metadata string,
+	title string,
+	summary string,
+	tryExec bool,
+) (uint64, error) {
+	proposer, err := c.caller(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Decode the messages and cache their values, which the msg server needs to route them.
+	anyMsgs := make([]*codectypes.Any, len(messages))
+	for i, bz := range messages {
+		anyMsg := new(codectypes.Any)
+		if err = c.cdc.Unmarshal(bz, anyMsg); err != nil {
+			return 0, errorslib.Wrapf(precompile.ErrInvalidAny, "message %d: %v", i, err)
+		}
+		var msg sdk.Msg
+		if err = c.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return 0, errorslib.Wrapf(precompile.ErrInvalidAny, "message %d: %v", i, err)
+		}
+		anyMsgs[i] = anyMsg
+	}
+
+	res, err := c.msgServer.SubmitProposal(ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: groupPolicy,
+		Proposers:          []string{proposer},
+		Metadata:           metadata,
+		Messages:           anyMsgs,
+		Exec:               execMode(tryExec),
+		Title:              title,
+		Summary:            summary,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.ProposalId, nil
+}
+
+// Vote is the method for the `vote` method of the group precompile contract.
+func (c *Contract) Vote(
+	ctx context.Context,
+	proposalID uint64,
+	option int32,
+	metadata string,
+	tryExec bool,
+) (bool, error) {
+	voter, err := c.caller(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = c.msgServer.Vote(ctx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter,
+		Option:     group.VoteOption(option),
+		Metadata:   metadata,
+		Exec:       execMode(tryExec),
+	})
+	return err == nil, err
+}
+
+// Exec is the method for the `exec` method of the group precompile contract.
+func (c *Contract) Exec(
+	ctx context.Context,
+	proposalID uint64,
+) (bool, error) {
+	executor, err := c.caller(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := c.msgServer.Exec(ctx, &group.MsgExec{
+		ProposalId: proposalID,
+		Executor:   executor,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Result == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, nil
+}
+
+// caller returns the bech32 address of the caller of the precompile.
+func (c *Contract) caller(ctx context.Context) (string, error) {
+	return cosmlib.StringFromEthAddress(c.addressCodec, vm.UnwrapPolarContext(ctx).MsgSender())
+}
+
+// extractMembers converts the `Member[]` input of the precompile into group member requests.
+func (c *Contract) extractMembers(members any) ([]group.MemberRequest, error) {
+	// note: we have to use unnamed struct here, otherwise the compiler cannot cast
+	// the any type input into IGroupModuleMember.
+	evmMembers, ok := utils.GetAs[[]struct {
+		Member   common.Address `json:"member"`
+		Weight   string         `json:"weight"`
+		Metadata string         `json:"metadata"`
+	}](members)
+	if !ok {
+		return nil, precompile.ErrInvalidMembers
+	}
+
+	memberRequests := make([]group.MemberRequest, len(evmMembers))
+	for i, member := range evmMembers {
+		addr, err := cosmlib.StringFromEthAddress(c.addressCodec, member.Member)
+		if err != nil {
+			return nil, err
+		}
+		memberRequests[i] = group.MemberRequest{
+			Address:  addr,
+			Weight:   member.Weight,
+			Metadata: member.Metadata,
+		}
+	}
+	return memberRequests, nil
+}
+
+// execMode returns the group exec mode for the given `tryExec` flag.
+func execMode(tryExec bool) group.Exec {
+	if tryExec {
+		return group.Exec_EXEC_TRY
+	}
+	return group.Exec_EXEC_UNSPECIFIED
+}
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package group_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankmodule "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	sdkgroup "github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/group"
+	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGroupPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/group")
+}
+
+// member is an alias, since the precompile only accepts the unnamed struct type as input.
+type member = struct {
+	Member   common.Address `json:"member"`
+	Weight   string         `json:"weight"`
+	Metadata string         `json:"metadata"`
+}
+
+var _ = Describe("Group Precompile Test", func() {
+	var (
+		contract *group.Contract
+		cdc      codec.Codec
+		ak       authkeeper.AccountKeeper
+		bk       bankkeeper.BaseKeeper
+		gk       groupkeeper.Keeper
+		sdkCtx   sdk.Context
+		aliceCtx context.Context
+		bobCtx   context.Context
+		alice    = testutils.Alice
+		bob      = testutils.Bob
+		now      = time.Unix(1_700_000_000, 0).UTC()
+	)
+
+	BeforeEach(func() {
+		var baseCtx sdk.Context
+		baseCtx, ak, bk, _ = testutils.SetupMinimalKeepers()
+		sdkCtx = baseCtx.WithBlockTime(now)
+		aliceCtx = testutil.NewCallerContext(sdkCtx, alice)
+		bobCtx = testutil.NewCallerContext(sdkCtx, bob)
+		encCfg := testutils.MakeTestEncodingConfig(
+			groupmodule.AppModuleBasic{},
+			bankmodule.AppModuleBasic{},
+		)
+		cdc = encCfg.Codec
+
+		// group proposals execute msgs through the router as the group policy account
+		router := baseapp.NewMsgServiceRouter()
+		router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		banktypes.RegisterMsgServer(router, bankkeeper.NewMsgServerImpl(bk))
+		gk = groupkeeper.NewKeeper(
+			storetypes.NewKVStoreKey(sdkgroup.StoreKey),
+			cdc,
+			router,
+			ak,
+			sdkgroup.DefaultConfig(),
+		)
+
+		contract = group.NewPrecompileContract(ak, cdc, gk)
+	})
+
+	createGroupWithPolicy := func() (uint64, string) {
+		groupID, err := contract.CreateGroup(aliceCtx, []member{
+			{Member: alice, Weight: "1", Metadata: "alice"},
+			{Member: bob, Weight: "1", Metadata: "bob"},
+		}, "metadata")
+		Expect(err).ToNot(HaveOccurred())
+
+		policy, err := contract.CreateGroupPolicy(aliceCtx, groupID, "policy", "1", 3600, 0)
+		Expect(err).ToNot(HaveOccurred())
+		return groupID, policy
+	}
+
+	It("should have the group module account address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(sdkgroup.ModuleName)),
+		))
+	})
+
+	It("should build a valid stateful precompile", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("creating a group", func() {
+		It("should fail on invalid members", func() {
+			_, err := contract.CreateGroup(aliceCtx, "invalid", "metadata")
+			Expect(err).To(MatchError(precompile.ErrInvalidMembers))
+		})
+
+		It("should create a group administered by the caller", func() {
+			groupID, err := contract.CreateGroup(aliceCtx, []member{
+				{Member: bob, Weight: "2", Metadata: "bob"},
+			}, "metadata")
+			Expect(err).ToNot(HaveOccurred())
+
+			info, err := gk.GroupInfo(sdkCtx, &sdkgroup.QueryGroupInfoRequest{GroupId: groupID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Info.Admin).To(Equal(sdk.AccAddress(alice.Bytes()).String()))
+			Expect(info.Info.TotalWeight).To(Equal("2"))
+			Expect(info.Info.Metadata).To(Equal("metadata"))
+		})
+	})
+
+	When("creating a group policy", func() {
+		It("should fail if the caller is not the group admin", func() {
+			groupID, err := contract.CreateGroup(aliceCtx, []member{
+				{Member: bob, Weight: "1", Metadata: "bob"},
+			}, "metadata")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = contract.CreateGroupPolicy(bobCtx, groupID, "policy", "1", 3600, 0)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should create a threshold group policy", func() {
+			groupID, policy := createGroupWithPolicy()
+
+			res, err := gk.GroupPolicyInfo(
+				sdkCtx, &sdkgroup.QueryGroupPolicyInfoRequest{Address: policy},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Info.GroupId).To(Equal(groupID))
+			decisionPolicy, err := res.Info.GetDecisionPolicy()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decisionPolicy.GetVotingPeriod()).To(Equal(time.Hour))
+		})
+	})
+
+	When("submitting, voting on and executing a proposal", func() {
+		var (
+			policy   string
+			policyAc sdk.AccAddress
+			msgBz    []byte
+		)
+
+		BeforeEach(func() {
+			var err error
+			_, policy = createGroupWithPolicy()
+			policyAc, err = sdk.AccAddressFromBech32(policy)
+			Expect(err).ToNot(HaveOccurred())
+
+			// fund the group policy account
+			coins := sdk.NewCoins(sdk.NewInt64Coin("abera", 100))
+			Expect(cosmlib.MintCoinsToAddress(
+				sdkCtx, bk, minttypes.ModuleName, alice, "abera", big.NewInt(100),
+			)).To(Succeed())
+			Expect(bk.SendCoins(sdkCtx, alice.Bytes(), policyAc, coins)).To(Succeed())
+
+			anyMsg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+				FromAddress: policy,
+				ToAddress:   sdk.AccAddress(bob.Bytes()).String(),
+				Amount:      coins,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			msgBz, err = cdc.Marshal(anyMsg)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail on an invalid message", func() {
+			_, err := contract.SubmitProposal(
+				aliceCtx, policy, [][]byte{[]byte("invalid")}, "", "title", "summary", false,
+			)
+			Expect(err).To(MatchError(ContainSubstring(precompile.ErrInvalidAny.Error())))
+		})
+
+		It("should fail if the caller is not a group member", func() {
+			_, err := contract.SubmitProposal(
+				testutil.NewCallerContext(sdkCtx, common.BytesToAddress([]byte("stranger"))),
+				policy, [][]byte{msgBz}, "", "title", "summary", false,
+			)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should execute the proposal once it is accepted", func() {
+			proposalID, err := contract.SubmitProposal(
+				aliceCtx, policy, [][]byte{msgBz}, "", "title", "summary", false,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			ok, err := contract.Vote(bobCtx, proposalID, int32(sdkgroup.VOTE_OPTION_YES), "lgtm", false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			ok, err = contract.Exec(aliceCtx, proposalID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(bk.GetBalance(sdkCtx, bob.Bytes(), "abera").Amount.Int64()).To(Equal(int64(100)))
+			Expect(bk.GetBalance(sdkCtx, policyAc, "abera").IsZero()).To(BeTrue())
+		})
+
+		It("should try to execute the proposal on vote", func() {
+			proposalID, err := contract.SubmitProposal(
+				aliceCtx, policy, [][]byte{msgBz}, "", "title", "summary", false,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = contract.Vote(aliceCtx, proposalID, int32(sdkgroup.VOTE_OPTION_YES), "", true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bk.GetBalance(sdkCtx, bob.Bytes(), "abera").Amount.Int64()).To(Equal(int64(100)))
+		})
+	})
+})
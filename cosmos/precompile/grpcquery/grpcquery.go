@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package grpcquery
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/grpcquery"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// ModuleName is the name used to derive the address of the gRPC query precompile.
+const ModuleName = "grpcquery"
+
+// QueryRouter routes gRPC query paths to their handlers, e.g. the `baseapp.GRPCQueryRouter`.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
+
+// Contract is the precompile contract for running whitelisted Cosmos gRPC queries.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	router QueryRouter
+
+	// allowedPaths are the gRPC method paths that `query` may route.
+	allowedPaths map[string]struct{}
+}
+
+// NewPrecompileContract creates a new precompile contract for running whitelisted gRPC queries,
+// which can only route queries to one of the given gRPC method paths, e.g.
+// `/cosmos.bank.v1beta1.Query/Balance`.
+func NewPrecompileContract(router QueryRouter, allowedQueryPaths []string) *Contract {
+	allowedPaths := make(map[string]struct{}, len(allowedQueryPaths))
+	for _, path := range allowedQueryPaths {
+		allowedPaths[path] = struct{}{}
+	}
+
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.GRPCQueryMetaData.ABI,
+			// Precompile Address: 0x792880e1d61AB1e29028FADf6E9F04C051297918
+			common.BytesToAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+		router:       router,
+		allowedPaths: allowedPaths,
+	}
+}
+
+// Query implements the `query(string,bytes)` method.
+func (c *Contract) Query(
+	ctx context.Context,
+	path string,
+	request []byte,
+) ([]byte, error) {
+	if _, allowed := c.allowedPaths[path]; !allowed {
+		return nil, errorslib.Wrapf(precompile.ErrQueryNotAllowed, "path %s", path)
+	}
+	handler := c.router.Route(path)
+	if handler == nil {
+		return nil, errorslib.Wrapf(precompile.ErrUnknownQueryPath, "path %s", path)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+	res, err := handler(sdkCtx, &abci.RequestQuery{
+		Data:   request,
+		Path:   path,
+		Height: sdkCtx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, precompile.NewCosmosRevertError(c.ABIErrors()["CosmosError"], err)
+	}
+	return res.Value, nil
+}
+
+// IsQueryAllowed implements the `isQueryAllowed(string)` method.
+func (c *Contract) IsQueryAllowed(
+	_ context.Context,
+	path string,
+) (bool, error) {
+	_, allowed := c.allowedPaths[path]
+	return allowed, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package grpcquery_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankmodule "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/grpcquery"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/grpcquery"
+	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGRPCQueryPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/grpcquery")
+}
+
+var _ = Describe("gRPC Query Precompile Test", func() {
+	const balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+
+	var (
+		contract *grpcquery.Contract
+		router   *baseapp.GRPCQueryRouter
+		sdkCtx   sdk.Context
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		var bk bankkeeper.BaseKeeper
+		sdkCtx, _, bk, _ = testutils.SetupMinimalKeepers()
+		ctx = testutil.NewCallerContext(sdkCtx, testutils.Alice)
+		encCfg := testutils.MakeTestEncodingConfig(bankmodule.AppModuleBasic{})
+
+		router = baseapp.NewGRPCQueryRouter()
+		router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		banktypes.RegisterQueryServer(router, bk)
+		contract = grpcquery.NewPrecompileContract(router, []string{balancePath})
+
+		Expect(cosmlib.MintCoinsToAddress(
+			sdkCtx, bk, minttypes.ModuleName, testutils.Alice, "abera", big.NewInt(100),
+		)).To(Succeed())
+
+	})
+
+	balanceRequest := func() []byte {
+		bz, err := (&banktypes.QueryBalanceRequest{
+			Address: sdk.AccAddress(testutils.Alice.Bytes()).String(),
+			Denom:   "abera",
+		}).Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		return bz
+	}
+
+	It("should have the gRPC query address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(grpcquery.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should only allow the query paths it is created with", func() {
+		allowed, err := contract.IsQueryAllowed(ctx, balancePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		allowed, err = contract.IsQueryAllowed(ctx, "/cosmos.bank.v1beta1.Query/AllBalances")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	When("querying", func() {
+		It("should fail if the path is not whitelisted", func() {
+			contract = grpcquery.NewPrecompileContract(router, nil)
+			_, err := contract.Query(ctx, balancePath, balanceRequest())
+			Expect(err).To(MatchError(ContainSubstring(precompile.ErrQueryNotAllowed.Error())))
+		})
+
+		It("should fail if the whitelisted path has no handler", func() {
+			path := "/cosmos.unknown.v1beta1.Query/Unknown"
+			contract = grpcquery.NewPrecompileContract(router, []string{path})
+			_, err := contract.Query(ctx, path, nil)
+			Expect(err).To(MatchError(ContainSubstring(precompile.ErrUnknownQueryPath.Error())))
+		})
+
+		It("should return the protobuf-encoded response", func() {
+			bz, err := contract.Query(ctx, balancePath, balanceRequest())
+			Expect(err).ToNot(HaveOccurred())
+
+			var res banktypes.QueryBalanceResponse
+			Expect(res.Unmarshal(bz)).To(Succeed())
+			Expect(res.Balance.Amount.Int64()).To(Equal(int64(100)))
+		})
+
+		It("should revert with the Cosmos error if the query handler fails", func() {
+			_, err := contract.Query(ctx, balancePath, []byte("invalid"))
+			Expect(err).To(HaveOccurred())
+
+			var revertErr ethprecompile.RevertError
+			Expect(errors.As(err, &revertErr)).To(BeTrue())
+			queryABI, err := generated.GRPCQueryMetaData.GetAbi()
+			Expect(err).ToNot(HaveOccurred())
+			customErr := queryABI.Errors["CosmosError"]
+			Expect(revertErr.RevertData()[:4]).To(Equal(customErr.ID.Bytes()[:4]))
+			args, err := customErr.Inputs.Unpack(revertErr.RevertData()[4:])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(HaveLen(3))
+		})
+	})
+})
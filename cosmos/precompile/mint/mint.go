@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mint
+
+import (
+	"context"
+	"math/big"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/mint"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// Contract is the precompile contract for the mint module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	querier minttypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the mint module precompile contract.
+func NewPrecompileContract(q minttypes.QueryServer) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.MintModuleMetaData.ABI,
+			// Precompile Address: 0xDc6F17BBEc824FFf8F86587966B2047Db6aB7367
+			common.BytesToAddress(authtypes.NewModuleAddress(minttypes.ModuleName)),
+		),
+		querier: q,
+	}
+}
+
+// GetInflation implements `getInflation()` method.
+func (c *Contract) GetInflation(
+	ctx context.Context,
+) (*big.Int, error) {
+	res, err := c.querier.Inflation(ctx, &minttypes.QueryInflationRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Inflation.BigInt(), nil
+}
+
+// GetAnnualProvisions implements `getAnnualProvisions()` method.
+func (c *Contract) GetAnnualProvisions(
+	ctx context.Context,
+) (*big.Int, error) {
+	res, err := c.querier.AnnualProvisions(ctx, &minttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.AnnualProvisions.BigInt(), nil
+}
+
+// GetParams implements `getParams()` method.
+func (c *Contract) GetParams(
+	ctx context.Context,
+) (generated.IMintModuleParams, error) {
+	res, err := c.querier.Params(ctx, &minttypes.QueryParamsRequest{})
+	if err != nil {
+		return generated.IMintModuleParams{}, err
+	}
+
+	return generated.IMintModuleParams{
+		MintDenom:           res.Params.MintDenom,
+		InflationRateChange: res.Params.InflationRateChange.BigInt(),
+		InflationMax:        res.Params.InflationMax.BigInt(),
+		InflationMin:        res.Params.InflationMin.BigInt(),
+		GoalBonded:          res.Params.GoalBonded.BigInt(),
+		BlocksPerYear:       res.Params.BlocksPerYear,
+	}, nil
+}
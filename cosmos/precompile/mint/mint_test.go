@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mint_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	mintmodule "github.com/cosmos/cosmos-sdk/x/mint"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/mint"
+	"pkg.berachain.dev/polaris/cosmos/precompile/mint"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMintPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/mint")
+}
+
+var _ = Describe("Mint Precompile Test", func() {
+	var (
+		contract *mint.Contract
+		mk       mintkeeper.Keeper
+		sdkCtx   sdk.Context
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		var (
+			ak authkeeper.AccountKeeper
+			bk bankkeeper.BaseKeeper
+			sk stakingkeeper.Keeper
+		)
+		sdkCtx, ak, bk, sk = testutils.SetupMinimalKeepers()
+		encCfg := testutils.MakeTestEncodingConfig(mintmodule.AppModuleBasic{})
+		mk = mintkeeper.NewKeeper(
+			encCfg.Codec,
+			runtime.NewKVStoreService(storetypes.NewKVStoreKey(minttypes.StoreKey)),
+			&sk,
+			ak,
+			bk,
+			authtypes.FeeCollectorName,
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+		Expect(mk.Params.Set(sdkCtx, minttypes.DefaultParams())).To(Succeed())
+		Expect(mk.Minter.Set(sdkCtx, minttypes.NewMinter(
+			sdkmath.LegacyNewDecWithPrec(13, 2), sdkmath.LegacyNewDec(1_000_000),
+		))).To(Succeed())
+
+		contract = mint.NewPrecompileContract(mintkeeper.NewQueryServerImpl(mk))
+		ctx = vm.NewPolarContext(sdkCtx, nil, common.Address{}, big.NewInt(0))
+	})
+
+	It("should have the mint module account address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(minttypes.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return the inflation scaled by 1e18", func() {
+		inflation, err := contract.GetInflation(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inflation).To(Equal(sdkmath.LegacyNewDecWithPrec(13, 2).BigInt()))
+	})
+
+	It("should return the annual provisions scaled by 1e18", func() {
+		provisions, err := contract.GetAnnualProvisions(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provisions).To(Equal(sdkmath.LegacyNewDec(1_000_000).BigInt()))
+	})
+
+	It("should return the params", func() {
+		params := minttypes.DefaultParams()
+		params.MintDenom = "abera"
+		params.BlocksPerYear = 10_000
+		Expect(mk.Params.Set(sdkCtx, params)).To(Succeed())
+
+		res, err := contract.GetParams(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(generated.IMintModuleParams{
+			MintDenom:           "abera",
+			InflationRateChange: params.InflationRateChange.BigInt(),
+			InflationMax:        params.InflationMax.BigInt(),
+			InflationMin:        params.InflationMin.BigInt(),
+			GoalBonded:          params.GoalBonded.BigInt(),
+			BlocksPerYear:       10_000,
+		}))
+	})
+})
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package msgexecutor
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/msgexecutor"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// ModuleName is the name used to derive the address of the msg executor precompile.
+const ModuleName = "msgexecutor"
+
+// Contract is the precompile contract for executing whitelisted Cosmos messages.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	cdc    codec.Codec
+	router baseapp.MessageRouter
+
+	// allowedMsgs are the type URLs of the messages that `execute` may dispatch.
+	allowedMsgs map[string]struct{}
+}
+
+// NewPrecompileContract creates a new precompile contract for executing whitelisted messages,
+// which can only execute messages with one of the given type URLs, e.g.
+// `/cosmos.bank.v1beta1.MsgSend`.
+func NewPrecompileContract(
+	cdc codec.Codec,
+	router baseapp.MessageRouter,
+	allowedMsgTypeURLs []string,
+) *Contract {
+	allowedMsgs := make(map[string]struct{}, len(allowedMsgTypeURLs))
+	for _, msgTypeURL := range allowedMsgTypeURLs {
+		allowedMsgs[msgTypeURL] = struct{}{}
+	}
+
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.MsgExecutorMetaData.ABI,
+			// Precompile Address: 0xF4F24Ea3344709ff979BF2d054d0608791AaC56D
+			common.BytesToAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+		cdc:         cdc,
+		router:      router,
+		allowedMsgs: allowedMsgs,
+	}
+}
+
+// Execute implements the `execute(bytes)` method.
+func (c *Contract) Execute(
+	ctx context.Context,
+	message []byte,
+) ([]byte, error) {
+	anyMsg := new(codectypes.Any)
+	if err := c.cdc.Unmarshal(message, anyMsg); err != nil {
+		return nil, errorslib.Wrap(precompile.ErrInvalidAny, err.Error())
+	}
+	if _, allowed := c.allowedMsgs[anyMsg.TypeUrl]; !allowed {
+		return nil, ethprecompile.NewRevertError(
+			c.ABIErrors()["MsgNotAllowed"], precompile.ErrMsgNotAllowed, anyMsg.TypeUrl,
+		)
+	}
+	var msg sdk.Msg
+	if err := c.cdc.UnpackAny(anyMsg, &msg); err != nil {
+		return nil, errorslib.Wrap(precompile.ErrInvalidAny, err.Error())
+	}
+	// run the stateless checks of the msg, as baseapp does for the msgs of a tx.
+	if vb, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := vb.ValidateBasic(); err != nil {
+			return nil, precompile.NewCosmosRevertError(c.ABIErrors()["CosmosError"], err)
+		}
+	}
+
+	// the caller must be the only signer of the msg, as it is executed without a signature.
+	signers, _, err := c.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+	caller := vm.UnwrapPolarContext(ctx).MsgSender()
+	if len(signers) != 1 || !bytes.Equal(signers[0], caller.Bytes()) {
+		return nil, errorslib.Wrapf(precompile.ErrSignerNotCaller, "caller %s", caller.Hex())
+	}
+
+	handler := c.router.Handler(msg)
+	if handler == nil {
+		return nil, errorslib.Wrapf(precompile.ErrUnknownMsgHandler, "%s", anyMsg.TypeUrl)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context())
+	res, err := handler(sdkCtx, msg)
+	if err != nil {
+		return nil, precompile.NewCosmosRevertError(c.ABIErrors()["CosmosError"], err)
+	}
+	// emit the events of the msg, as baseapp does for the msgs of a tx.
+	sdkCtx.EventManager().EmitEvents(res.GetEvents())
+	// the msg may have changed the bank balance of its signer, e.g. a send or a delegation.
+	precompile.SyncBalances(ctx, caller)
+
+	if len(res.MsgResponses) == 0 {
+		return []byte{}, nil
+	}
+	return c.cdc.Marshal(res.MsgResponses[0])
+}
+
+// IsMsgAllowed implements the `isMsgAllowed(string)` method.
+func (c *Contract) IsMsgAllowed(
+	_ context.Context,
+	msgTypeURL string,
+) (bool, error) {
+	_, allowed := c.allowedMsgs[msgTypeURL]
+	return allowed, nil
+}
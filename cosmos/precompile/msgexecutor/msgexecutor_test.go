@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package msgexecutor_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankmodule "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/msgexecutor"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/msgexecutor"
+	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMsgExecutorPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/msgexecutor")
+}
+
+var _ = Describe("Msg Executor Precompile Test", func() {
+	var (
+		contract *msgexecutor.Contract
+		cdc      codec.Codec
+		bk       bankkeeper.BaseKeeper
+		sdkCtx   sdk.Context
+		aliceCtx *vm.PolarContext
+		bobCtx   *vm.PolarContext
+		router   *baseapp.MsgServiceRouter
+		alice    = testutils.Alice
+		bob      = testutils.Bob
+		sendURL  = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	)
+
+	BeforeEach(func() {
+		sdkCtx, _, bk, _ = testutils.SetupMinimalKeepers()
+		encCfg := testutils.MakeTestEncodingConfig(bankmodule.AppModuleBasic{})
+		cdc = encCfg.Codec
+
+		router = testutil.NewMsgRouter(encCfg.InterfaceRegistry, func(s gogogrpc.Server) {
+			banktypes.RegisterMsgServer(s, bankkeeper.NewMsgServerImpl(bk))
+		})
+		contract = msgexecutor.NewPrecompileContract(cdc, router, []string{sendURL})
+
+		Expect(cosmlib.MintCoinsToAddress(
+			sdkCtx, bk, minttypes.ModuleName, alice, "abera", big.NewInt(100),
+		)).To(Succeed())
+
+		aliceCtx = testutil.NewCallerContext(sdkCtx, alice)
+		bobCtx = testutil.NewCallerContext(sdkCtx, bob)
+	})
+
+	sendMsg := func(from common.Address) []byte {
+		anyMsg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+			FromAddress: sdk.AccAddress(from.Bytes()).String(),
+			ToAddress:   sdk.AccAddress(bob.Bytes()).String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("abera", 40)),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		bz, err := cdc.Marshal(anyMsg)
+		Expect(err).ToNot(HaveOccurred())
+		return bz
+	}
+
+	It("should have the msg executor address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(msgexecutor.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should round-trip the calls through the ABI", func() {
+		bz, err := testutil.CallRaw(aliceCtx, contract, "isMsgAllowed", sendURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testutil.CheckGolden("testdata/is_msg_allowed.golden", bz)).To(Succeed())
+	})
+
+	It("should only allow the msg type URLs it is created with", func() {
+		allowed, err := contract.IsMsgAllowed(aliceCtx, sendURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		allowed, err = contract.IsMsgAllowed(aliceCtx, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+	})
+
+	When("executing a msg", func() {
+		It("should fail on an invalid msg", func() {
+			_, err := contract.Execute(aliceCtx, []byte("invalid"))
+			Expect(err).To(MatchError(ContainSubstring(precompile.ErrInvalidAny.Error())))
+		})
+
+		It("should revert with MsgNotAllowed if the msg type is not whitelisted", func() {
+			contract = msgexecutor.NewPrecompileContract(cdc, router, nil)
+			_, err := contract.Execute(aliceCtx, sendMsg(alice))
+			Expect(err).To(MatchError(precompile.ErrMsgNotAllowed))
+
+			var revertErr ethprecompile.RevertError
+			Expect(errors.As(err, &revertErr)).To(BeTrue())
+			executorABI, err := generated.MsgExecutorMetaData.GetAbi()
+			Expect(err).ToNot(HaveOccurred())
+			customErr := executorABI.Errors["MsgNotAllowed"]
+			Expect(revertErr.RevertData()[:4]).To(Equal(customErr.ID.Bytes()[:4]))
+			args, err := customErr.Inputs.Unpack(revertErr.RevertData()[4:])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(Equal([]any{sendURL}))
+		})
+
+		It("should fail if the caller is not the signer", func() {
+			_, err := contract.Execute(bobCtx, sendMsg(alice))
+			Expect(err).To(MatchError(ContainSubstring(precompile.ErrSignerNotCaller.Error())))
+			Expect(bk.GetBalance(sdkCtx, bob.Bytes(), "abera").IsZero()).To(BeTrue())
+		})
+
+		It("should revert with the Cosmos error if the msg handler fails", func() {
+			// bob has no funds to send.
+			_, err := contract.Execute(bobCtx, sendMsg(bob))
+			Expect(err).To(MatchError(sdkerrors.ErrInsufficientFunds))
+
+			var revertErr ethprecompile.RevertError
+			Expect(errors.As(err, &revertErr)).To(BeTrue())
+			executorABI, err := generated.MsgExecutorMetaData.GetAbi()
+			Expect(err).ToNot(HaveOccurred())
+			customErr := executorABI.Errors["CosmosError"]
+			Expect(revertErr.RevertData()[:4]).To(Equal(customErr.ID.Bytes()[:4]))
+			args, err := customErr.Inputs.Unpack(revertErr.RevertData()[4:])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(HaveLen(3))
+			Expect(args[0]).To(Equal(sdkerrors.ErrInsufficientFunds.Codespace()))
+			Expect(args[1]).To(Equal(sdkerrors.ErrInsufficientFunds.ABCICode()))
+			Expect(args[2]).To(ContainSubstring(sdkerrors.ErrInsufficientFunds.Error()))
+		})
+
+		It("should execute the msg and return its response", func() {
+			bz, err := contract.Execute(aliceCtx, sendMsg(alice))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bk.GetBalance(sdkCtx, bob.Bytes(), "abera").Amount.Int64()).To(Equal(int64(40)))
+			Expect(bk.GetBalance(sdkCtx, alice.Bytes(), "abera").Amount.Int64()).To(Equal(int64(60)))
+
+			var res codectypes.Any
+			Expect(cdc.Unmarshal(bz, &res)).To(Succeed())
+			Expect(res.TypeUrl).To(Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{})))
+		})
+
+		It("should sync the balance of the signer after executing the msg", func() {
+			bs := &mockBalanceSyncer{}
+			syncCtx := sdkCtx.WithValue(precompile.BalanceSyncerContextKey, bs)
+
+			// bob has no funds to send.
+			_, err := contract.Execute(testutil.NewCallerContext(syncCtx, bob), sendMsg(bob))
+			Expect(err).To(HaveOccurred())
+			Expect(bs.synced).To(BeEmpty())
+
+			_, err = contract.Execute(testutil.NewCallerContext(syncCtx, alice), sendMsg(alice))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bs.synced).To(Equal([]common.Address{alice}))
+		})
+	})
+})
+
+type mockBalanceSyncer struct {
+	synced []common.Address
+}
+
+func (mbs *mockBalanceSyncer) SyncBalance(addr common.Address) {
+	mbs.synced = append(mbs.synced, addr)
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package multicall
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/multicall"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// ModuleName is the name used to derive the address of the multicall precompile.
+const ModuleName = "multicall"
+
+// Contract is the precompile contract for executing a batch of calls within one EVM message.
+type Contract struct {
+	ethprecompile.BaseContract
+}
+
+// NewPrecompileContract creates a new precompile contract for executing batches of calls.
+func NewPrecompileContract() *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.MulticallMetaData.ABI,
+			// Precompile Address: 0x38B11101eC4aaf40A4a7f45945771BF7d8faAda0
+			common.BytesToAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+	}
+}
+
+// Aggregate implements the `aggregate((address,bytes)[],bool)` method. The calls are executed in
+// order with the caller of the precompile as their caller, and the gas used by each call is
+// consumed from the gas of the precompile.
+func (c *Contract) Aggregate(
+	ctx context.Context,
+	calls []struct {
+		Target   common.Address `json:"target"`
+		CallData []byte         `json:"callData"`
+	},
+	allowFailure bool,
+) ([]generated.IMulticallResult, uint64, error) {
+	polarCtx := vm.UnwrapPolarContext(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(polarCtx.Context())
+
+	results := make([]generated.IMulticallResult, len(calls))
+	var gasUsed uint64
+	for i, call := range calls {
+		ret, callGasUsed, err := cosmlib.CallEVMFromPrecompileRaw(
+			sdkCtx, c.GetPlugin(), polarCtx.Evm(), polarCtx.MsgSender(),
+			call.Target, call.CallData, big.NewInt(0),
+		)
+		gasUsed += callGasUsed
+		if err != nil && !allowFailure {
+			// the failed call reverts the whole batch, as the precompile call reverts.
+			return nil, 0, ethprecompile.NewRevertError(
+				c.ABIErrors()["CallFailed"],
+				errorslib.Wrapf(precompile.ErrMulticallFailed, "call %d to %s: %v", i, call.Target.Hex(), err),
+				big.NewInt(int64(i)),
+				ret,
+			)
+		}
+		results[i] = generated.IMulticallResult{
+			Success:    err == nil,
+			ReturnData: ret,
+			GasUsed:    callGasUsed,
+		}
+	}
+	return results, gasUsed, nil
+}
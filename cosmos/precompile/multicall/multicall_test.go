@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package multicall_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/multicall"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/multicall"
+	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	pcplugin "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/precompile"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/store/snapmulti"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/core/vm/mock"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMulticallPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/multicall")
+}
+
+var _ = Describe("Multicall Precompile Test", func() {
+	var (
+		contract  *multicall.Contract
+		sdkCtx    sdk.Context
+		mockEVM   *mock.PrecompileEVMMock
+		pCtx      *vm.PolarContext
+		caller    = testutils.Alice
+		okTarget  = common.HexToAddress("0x1")
+		badTarget = common.HexToAddress("0x2")
+	)
+
+	BeforeEach(func() {
+		contract = multicall.NewPrecompileContract()
+		contract.SetPlugin(ethprecompile.NewDefaultPlugin())
+
+		sdkCtx = testutils.NewContext().
+			WithMultiStore(snapmulti.NewStoreFrom(testutils.NewContext().MultiStore())).
+			WithGasMeter(storetypes.NewGasMeter(1_000_000))
+
+		// the ok target echoes the calldata and the bad target reverts with it, both using 100 gas.
+		mockEVM = testutil.NewEVM(&vm.BlockContext{BlockNumber: big.NewInt(1)})
+		mockEVM.CallFunc = func(
+			from vm.ContractRef, addr common.Address, input []byte, gas uint64, _ *big.Int,
+		) ([]byte, uint64, error) {
+			Expect(from.Address()).To(Equal(caller))
+			if addr == badTarget {
+				return input, gas - 100, vm.ErrExecutionReverted
+			}
+			return input, gas - 100, nil
+		}
+		pCtx = vm.NewPolarContext(sdkCtx, mockEVM, caller, big.NewInt(0))
+	})
+
+	It("should have the multicall address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(multicall.ModuleName)),
+		))
+	})
+
+	It("should execute the calls in order and sum their gas", func() {
+		ret, err := testutil.Call(pCtx, contract, "aggregate", []generated.IMulticallCall{
+			{Target: okTarget, CallData: []byte{1}},
+			{Target: okTarget, CallData: []byte{2}},
+		}, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ret).To(HaveLen(2))
+		Expect(ret[1]).To(Equal(uint64(200)))
+		Expect(sdkCtx.GasMeter().GasConsumed()).To(Equal(storetypes.Gas(200)))
+		Expect(mockEVM.CallCalls()).To(HaveLen(2))
+		Expect(mockEVM.CallCalls()[0].Input).To(Equal([]byte{1}))
+		Expect(mockEVM.CallCalls()[1].Input).To(Equal([]byte{2}))
+	})
+
+	It("should report failed calls if failures are allowed", func() {
+		results, gasUsed, err := contract.Aggregate(pCtx, []struct {
+			Target   common.Address `json:"target"`
+			CallData []byte         `json:"callData"`
+		}{
+			{Target: badTarget, CallData: []byte{1}},
+			{Target: okTarget, CallData: []byte{2}},
+		}, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gasUsed).To(Equal(uint64(200)))
+		Expect(results).To(Equal([]generated.IMulticallResult{
+			{Success: false, ReturnData: []byte{1}, GasUsed: 100},
+			{Success: true, ReturnData: []byte{2}, GasUsed: 100},
+		}))
+	})
+
+	It("should revert with CallFailed if failures are not allowed", func() {
+		_, err := contract.Aggregate(pCtx, []struct {
+			Target   common.Address `json:"target"`
+			CallData []byte         `json:"callData"`
+		}{
+			{Target: okTarget, CallData: []byte{1}},
+			{Target: badTarget, CallData: []byte{2}},
+		}, false)
+		Expect(err).To(MatchError(precompile.ErrMulticallFailed))
+
+		var revertErr ethprecompile.RevertError
+		Expect(errors.As(err, &revertErr)).To(BeTrue())
+		multicallABI, err := generated.MulticallMetaData.GetAbi()
+		Expect(err).ToNot(HaveOccurred())
+		customErr := multicallABI.Errors["CallFailed"]
+		Expect(revertErr.RevertData()[:4]).To(Equal(customErr.ID.Bytes()[:4]))
+		args, err := customErr.Inputs.Unpack(revertErr.RevertData()[4:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(args).To(Equal([]any{big.NewInt(1), []byte{2}}))
+	})
+
+	It("should not execute calls in a read-only context", func() {
+		utils.MustGetAs[pcplugin.MultiStore](sdkCtx.MultiStore()).SetReadOnly(true)
+		_, _, err := contract.Aggregate(pCtx, []struct {
+			Target   common.Address `json:"target"`
+			CallData []byte         `json:"callData"`
+		}{{Target: okTarget}}, false)
+		Expect(err).To(MatchError(ContainSubstring(vm.ErrWriteProtection.Error())))
+		Expect(mockEVM.CallCalls()).To(BeEmpty())
+	})
+})
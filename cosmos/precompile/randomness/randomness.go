@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package randomness
+
+import (
+	"context"
+	"encoding/binary"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/randomness"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/crypto"
+)
+
+// ModuleName is the name used to derive the address of the randomness precompile.
+const ModuleName = "randomness"
+
+// callCounterKey is the transient storage slot of the precompile that counts the calls of
+// `getRandomSeed` in the current tx.
+var callCounterKey = common.Hash{}
+
+// Contract is the precompile contract for deriving random seeds from the CometBFT block header.
+type Contract struct {
+	ethprecompile.BaseContract
+}
+
+// NewPrecompileContract creates a new precompile contract for deriving random seeds.
+func NewPrecompileContract() *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.RandomnessMetaData.ABI,
+			// Precompile Address: 0x1c26c5668E69e892e29576c7D33DA04E36d8a234
+			common.BytesToAddress(authtypes.NewModuleAddress(ModuleName)),
+		),
+	}
+}
+
+// GetRandomSeed implements the `getRandomSeed()` method. The seed is the keccak256 hash of the
+// block hash, the app hash of the previous block, the big endian block height, the CometBFT hash
+// of the tx, the caller and the big endian index of the call among the calls of this method in
+// the tx, so that every call returns a different seed.
+//
+// NOTE: the seed is not secure randomness. The block proposer knows the block inputs in advance
+// and can bias the seed by choosing which txs to include and in which order, and every input is
+// known to the caller once the block is proposed. It must not decide outcomes worth more than the
+// proposer's reward.
+func (c *Contract) GetRandomSeed(ctx context.Context) ([32]byte, error) {
+	polarCtx := vm.UnwrapPolarContext(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(polarCtx.Context())
+	return crypto.Keccak256Hash(
+		sdkCtx.HeaderHash(),
+		sdkCtx.BlockHeader().AppHash,
+		sdk.Uint64ToBigEndian(uint64(sdkCtx.BlockHeight())),
+		cmttypes.Tx(sdkCtx.TxBytes()).Hash(),
+		polarCtx.MsgSender().Bytes(),
+		sdk.Uint64ToBigEndian(c.nextCallIndex(polarCtx)),
+	), nil
+}
+
+// nextCallIndex returns the index of the current call among the calls of `getRandomSeed` in the
+// tx, which is counted in the transient storage of the precompile.
+func (c *Contract) nextCallIndex(polarCtx *vm.PolarContext) uint64 {
+	sdb := polarCtx.Evm().GetStateDB()
+	index := binary.BigEndian.Uint64(
+		sdb.GetTransientState(c.RegistryKey(), callCounterKey).Bytes()[common.HashLength-8:],
+	)
+	sdb.SetTransientState(
+		c.RegistryKey(), callCounterKey, common.BytesToHash(sdk.Uint64ToBigEndian(index+1)),
+	)
+	return index
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package randomness_test
+
+import (
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile/randomness"
+	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRandomnessPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/randomness")
+}
+
+var _ = Describe("Randomness Precompile Test", func() {
+	var (
+		contract *randomness.Contract
+		sdkCtx   sdk.Context
+		caller   = common.BytesToAddress([]byte("caller"))
+	)
+
+	BeforeEach(func() {
+		contract = randomness.NewPrecompileContract()
+		header := testutils.NewContext().BlockHeader()
+		header.Height = 10
+		header.AppHash = []byte("app hash")
+		sdkCtx = testutils.NewContext().
+			WithBlockHeader(header).
+			WithHeaderHash([]byte("block hash")).
+			WithTxBytes([]byte("tx"))
+	})
+
+	It("should have the randomness address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(randomness.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not be callable in a read-only call, as it counts its calls", func() {
+		_, err := testutil.CallRaw(testutil.NewPolarContext(
+			sdkCtx, testutil.ContextConfig{Sender: caller, ReadOnly: true},
+		), contract, "getRandomSeed")
+		Expect(err).To(MatchError(ContainSubstring(vm.ErrWriteProtection.Error())))
+
+		_, err = testutil.CallRaw(testutil.NewCallerContext(sdkCtx, caller), contract, "getRandomSeed")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should derive the seed from the block, the tx, the caller and the call index", func() {
+		ctx := testutil.NewCallerContext(sdkCtx, caller)
+		for i := uint64(0); i < 2; i++ {
+			seed, err := contract.GetRandomSeed(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(common.Hash(seed)).To(Equal(crypto.Keccak256Hash(
+				[]byte("block hash"),
+				[]byte("app hash"),
+				sdk.Uint64ToBigEndian(10),
+				cmttypes.Tx("tx").Hash(),
+				caller.Bytes(),
+				sdk.Uint64ToBigEndian(i),
+			)))
+		}
+	})
+
+	It("should differ between calls in a tx", func() {
+		ctx := testutil.NewCallerContext(sdkCtx, caller)
+		seed, err := contract.GetRandomSeed(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		again, err := contract.GetRandomSeed(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again).ToNot(Equal(seed))
+	})
+
+	It("should differ between callers and txs", func() {
+		seed, err := contract.GetRandomSeed(testutil.NewCallerContext(sdkCtx, caller))
+		Expect(err).ToNot(HaveOccurred())
+
+		other, err := contract.GetRandomSeed(testutil.NewCallerContext(sdkCtx, common.Address{}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(other).ToNot(Equal(seed))
+
+		other, err = contract.GetRandomSeed(
+			testutil.NewCallerContext(sdkCtx.WithTxBytes([]byte("other tx")), caller),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(other).ToNot(Equal(seed))
+	})
+
+	It("should change with the block", func() {
+		seed, err := contract.GetRandomSeed(testutil.NewCallerContext(sdkCtx, caller))
+		Expect(err).ToNot(HaveOccurred())
+
+		other, err := contract.GetRandomSeed(
+			testutil.NewCallerContext(sdkCtx.WithHeaderHash([]byte("other hash")), caller),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(other).ToNot(Equal(seed))
+	})
+})
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package registry
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/registry"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// ModuleName is the name used to derive the address of the precompile registry.
+const ModuleName = "precompileregistry"
+
+// Address is the address of the precompile registry.
+//
+// Precompile Address: 0xbE713D1AA0745CA81D5366160c070922C4783F5f
+var Address = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName))
+
+// ParamsKeeper returns the x/evm params, which hold the enabled gated precompiles and the
+// restricted precompile methods.
+type ParamsKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// Contract is the precompile contract for reading which gated precompiles are enabled and which
+// precompile methods are restricted to their allowed callers. Both are set in the x/evm params,
+// which governance updates with `MsgUpdateParams`.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	// pk reads the x/evm params.
+	pk ParamsKeeper
+}
+
+// NewPrecompileContract creates a new precompile registry contract, which reads the x/evm params
+// from the given keeper.
+func NewPrecompileContract(pk ParamsKeeper) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(generated.PrecompileRegistryMetaData.ABI, Address),
+		pk:           pk,
+	}
+}
+
+// IsPrecompileEnabled implements the `isPrecompileEnabled(address)` method.
+func (c *Contract) IsPrecompileEnabled(
+	ctx context.Context,
+	pc common.Address,
+) (bool, error) {
+	return c.pk.GetParams(sdk.UnwrapSDKContext(ctx)).IsPrecompileEnabled(pc), nil
+}
+
+// IsMethodRestricted implements the `isMethodRestricted(address,bytes4)` method.
+func (c *Contract) IsMethodRestricted(
+	ctx context.Context,
+	pc common.Address,
+	method [4]byte,
+) (bool, error) {
+	return c.pk.GetParams(sdk.UnwrapSDKContext(ctx)).IsMethodRestricted(pc, method), nil
+}
+
+// IsMethodCallerAllowed implements the `isMethodCallerAllowed(address,bytes4,address)` method.
+func (c *Contract) IsMethodCallerAllowed(
+	ctx context.Context,
+	pc common.Address,
+	method [4]byte,
+	caller common.Address,
+) (bool, error) {
+	return c.pk.GetParams(sdk.UnwrapSDKContext(ctx)).IsMethodCallerAllowed(pc, method, caller), nil
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package registry_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"pkg.berachain.dev/polaris/cosmos/precompile/registry"
+	"pkg.berachain.dev/polaris/cosmos/precompile/testutil"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRegistryPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/registry")
+}
+
+var _ = Describe("Precompile Registry Test", func() {
+	var (
+		contract *registry.Contract
+		pk       *mockParamsKeeper
+		ctx      context.Context
+		gated    = common.BytesToAddress([]byte("gated"))
+		method   = [4]byte{0xa9, 0x05, 0x9c, 0xbb}
+	)
+
+	BeforeEach(func() {
+		sdkCtx, _, _, _ := testutils.SetupMinimalKeepers()
+		ctx = testutil.NewCallerContext(sdkCtx, testutils.Alice)
+		pk = &mockParamsKeeper{params: evmtypes.DefaultParams()}
+		contract = registry.NewPrecompileContract(pk)
+	})
+
+	It("should have the precompile registry address", func() {
+		Expect(contract.RegistryKey()).To(Equal(registry.Address))
+		Expect(registry.Address).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(registry.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should read the enabled precompiles from the params", func() {
+		enabled, err := contract.IsPrecompileEnabled(ctx, gated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+
+		pk.params.EnabledPrecompiles = []string{gated.Hex()}
+		enabled, err = contract.IsPrecompileEnabled(ctx, gated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+		enabled, err = contract.IsPrecompileEnabled(ctx, testutils.Alice)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("should read the restricted methods from the params", func() {
+		// methods are not restricted by default
+		restricted, err := contract.IsMethodRestricted(ctx, gated, method)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restricted).To(BeFalse())
+		allowed, err := contract.IsMethodCallerAllowed(ctx, gated, method, testutils.Bob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+
+		pk.params.RestrictedMethods = []*evmtypes.RestrictedMethod{{
+			Precompile:     gated.Hex(),
+			Method:         "0xa9059cbb",
+			AllowedCallers: []string{testutils.Alice.Hex()},
+		}}
+		restricted, err = contract.IsMethodRestricted(ctx, gated, method)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restricted).To(BeTrue())
+		allowed, err = contract.IsMethodCallerAllowed(ctx, gated, method, testutils.Alice)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeTrue())
+		allowed, err = contract.IsMethodCallerAllowed(ctx, gated, method, testutils.Bob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+
+		// other methods and precompiles are not restricted
+		restricted, err = contract.IsMethodRestricted(ctx, gated, [4]byte{1, 2, 3, 4})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restricted).To(BeFalse())
+		restricted, err = contract.IsMethodRestricted(ctx, registry.Address, method)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restricted).To(BeFalse())
+	})
+})
+
+type mockParamsKeeper struct {
+	params evmtypes.Params
+}
+
+func (mpk *mockParamsKeeper) GetParams(sdk.Context) evmtypes.Params {
+	return mpk.params
+}
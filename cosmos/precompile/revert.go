@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// NewCosmosRevertError returns a `RevertError` that reverts with the given ABI custom error, which
+// must have the `(string codespace, uint32 code, string message)` inputs, for the codespace, code
+// and message of the given Cosmos error. The message of errors that are not registered by a module
+// is redacted, as it may not be deterministic.
+func NewCosmosRevertError(abiErr abi.Error, err error) error {
+	codespace, code, log := errorsmod.ABCIInfo(err, false)
+	return ethprecompile.NewRevertError(abiErr, err, codespace, code, log)
+}
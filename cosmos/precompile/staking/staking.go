@@ -31,7 +31,9 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -57,23 +59,27 @@ type ValidatorStore interface {
 type Contract struct {
 	ethprecompile.BaseContract
 
-	accAddrCodec address.Codec
-	vs           ValidatorStore
-	msgServer    stakingtypes.MsgServer
-	querier      stakingtypes.QueryServer
+	accAddrCodec    address.Codec
+	vs              ValidatorStore
+	msgServer       stakingtypes.MsgServer
+	querier         stakingtypes.QueryServer
+	slashingQuerier slashingtypes.QueryServer
 }
 
 // NewContract is the constructor of the staking contract.
-func NewPrecompileContract(ak cosmlib.CodecProvider, sk *stakingkeeper.Keeper) *Contract {
+func NewPrecompileContract(
+	ak cosmlib.CodecProvider, sk *stakingkeeper.Keeper, slq slashingtypes.QueryServer,
+) *Contract {
 	return &Contract{
 		BaseContract: ethprecompile.NewBaseContract(
 			generated.StakingModuleMetaData.ABI,
 			common.BytesToAddress(authtypes.NewModuleAddress(stakingtypes.ModuleName)),
 		),
-		accAddrCodec: ak.AddressCodec(),
-		vs:           sk,
-		msgServer:    stakingkeeper.NewMsgServerImpl(sk),
-		querier:      stakingkeeper.Querier{Keeper: sk},
+		accAddrCodec:    ak.AddressCodec(),
+		vs:              sk,
+		msgServer:       stakingkeeper.NewMsgServerImpl(sk),
+		querier:         stakingkeeper.Querier{Keeper: sk},
+		slashingQuerier: slq,
 	}
 }
 
@@ -102,9 +108,14 @@ func (c *Contract) GetBondedValidators(
 	ctx context.Context,
 	pagination any,
 ) ([]generated.IStakingModuleValidator, cbindings.CosmosPageResponse, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	res, err := c.querier.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
 		Status:     stakingtypes.BondStatusBonded,
-		Pagination: cosmlib.ExtractPageRequestFromInput(pagination),
+		Pagination: pageReq,
 	})
 	if err != nil {
 		return nil, cbindings.CosmosPageResponse{}, err
@@ -155,8 +166,13 @@ func (c *Contract) GetValidators(
 	ctx context.Context,
 	pagination any,
 ) ([]generated.IStakingModuleValidator, cbindings.CosmosPageResponse, error) {
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	res, err := c.querier.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
-		Pagination: cosmlib.ExtractPageRequestFromInput(pagination),
+		Pagination: pageReq,
 	})
 	if err != nil {
 		return nil, cbindings.CosmosPageResponse{}, err
@@ -209,9 +225,14 @@ func (c *Contract) GetDelegatorValidators(
 	if err != nil {
 		return nil, cbindings.CosmosPageResponse{}, err
 	}
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	res, err := c.querier.DelegatorValidators(ctx, &stakingtypes.QueryDelegatorValidatorsRequest{
 		DelegatorAddr: delegator,
-		Pagination:    cosmlib.ExtractPageRequestFromInput(pagination),
+		Pagination:    pageReq,
 	})
 	if err != nil {
 		return nil, cbindings.CosmosPageResponse{}, err
@@ -237,9 +258,14 @@ func (c *Contract) GetValidatorDelegations(
 	if err != nil {
 		return nil, cbindings.CosmosPageResponse{}, err
 	}
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	res, err := c.querier.ValidatorDelegations(ctx, &stakingtypes.QueryValidatorDelegationsRequest{
 		ValidatorAddr: valAddr,
-		Pagination:    cosmlib.ExtractPageRequestFromInput(pagination),
+		Pagination:    pageReq,
 	})
 	if status.Code(err) == codes.NotFound {
 		return []generated.IStakingModuleDelegation{}, cbindings.CosmosPageResponse{}, nil
@@ -257,7 +283,7 @@ func (c *Contract) GetValidatorDelegations(
 		delegations = append(delegations, generated.IStakingModuleDelegation{
 			Delegator: delegator,
 			Balance:   d.Balance.Amount.BigInt(),
-			Shares:    d.Delegation.Shares.BigInt(),
+			Shares:    cosmlib.DecToBigIntScaled(d.Delegation.Shares, cosmlib.DecDecimals),
 		})
 	}
 
@@ -298,6 +324,140 @@ func (c *Contract) GetDelegation(
 	return delegation.Balance.Amount.BigInt(), nil
 }
 
+// GetDelegation0 implements `getDelegation(address,address,string)` method.
+func (c *Contract) GetDelegation0(
+	ctx context.Context,
+	delegatorAddress common.Address,
+	validatorAddress common.Address,
+	denom string,
+) (*big.Int, error) {
+	bondDenom, err := c.bondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if denom != bondDenom {
+		// only the bond denom can be staked
+		return big.NewInt(0), nil
+	}
+	valAddr, err := cosmlib.StringFromEthAddress(c.vs.ValidatorAddressCodec(), validatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delAddr, err := cosmlib.StringFromEthAddress(c.accAddrCodec, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.querier.Delegation(ctx, &stakingtypes.QueryDelegationRequest{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+	})
+	if status.Code(err) == codes.NotFound {
+		// handle the case where the delegation does not exist
+		return big.NewInt(0), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	delegation := res.GetDelegationResponse()
+	if delegation == nil {
+		return big.NewInt(0), nil
+	}
+	validator, err := c.vs.GetValidator(ctx, validatorAddress.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.TokensFromShares(delegation.Delegation.Shares).TruncateInt().BigInt(), nil
+}
+
+// GetStakedBalance implements `getStakedBalance(address,string)` method.
+func (c *Contract) GetStakedBalance(
+	ctx context.Context,
+	delegatorAddress common.Address,
+	denom string,
+) (*big.Int, error) {
+	bondDenom, err := c.bondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if denom != bondDenom {
+		// only the bond denom can be staked
+		return big.NewInt(0), nil
+	}
+	delAddr, err := cosmlib.StringFromEthAddress(c.accAddrCodec, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdkmath.LegacyZeroDec()
+	var nextKey []byte
+	for {
+		res, err := c.querier.DelegatorDelegations(ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: delAddr,
+			Pagination:    &query.PageRequest{Key: nextKey},
+		})
+		if status.Code(err) == codes.NotFound {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, d := range res.GetDelegationResponses() {
+			valAddr, err := c.vs.ValidatorAddressCodec().StringToBytes(d.Delegation.ValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+			validator, err := c.vs.GetValidator(ctx, valAddr)
+			if err != nil {
+				return nil, err
+			}
+			total = total.Add(validator.TokensFromShares(d.Delegation.Shares))
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	return total.TruncateInt().BigInt(), nil
+}
+
+// GetValidatorUptime implements `getValidatorUptime(address)` method.
+func (c *Contract) GetValidatorUptime(
+	ctx context.Context,
+	validatorAddress common.Address,
+) (uint64, uint64, error) {
+	validator, err := c.vs.GetValidator(ctx, sdk.ValAddress(validatorAddress.Bytes()))
+	if err != nil {
+		return 0, 0, err
+	}
+	consAddrBz, err := validator.GetConsAddr()
+	if err != nil {
+		return 0, 0, err
+	}
+	consAddr, err := c.vs.ConsensusAddressCodec().BytesToString(consAddrBz)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	infoRes, err := c.slashingQuerier.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: consAddr,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	paramsRes, err := c.slashingQuerier.Params(ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uint64(infoRes.ValSigningInfo.MissedBlocksCounter),
+		uint64(paramsRes.Params.SignedBlocksWindow),
+		nil
+}
+
 // GetUnbondingDelegation implements the `getUnbondingDelegation(address,address)` method.
 func (c *Contract) GetUnbondingDelegation(
 	ctx context.Context,
@@ -337,9 +497,14 @@ func (c *Contract) GetDelegatorUnbondingDelegations(
 		return nil, cbindings.CosmosPageResponse{}, err
 	}
 
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	res, err := c.querier.DelegatorUnbondingDelegations(ctx, &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
 		DelegatorAddr: delAddr,
-		Pagination:    cosmlib.ExtractPageRequestFromInput(pagination),
+		Pagination:    pageReq,
 	})
 	if status.Code(err) == codes.NotFound {
 		return []generated.IStakingModuleUnbondingDelegation{},
@@ -397,13 +562,18 @@ func (c *Contract) GetRedelegations(
 		return nil, cbindings.CosmosPageResponse{}, err
 	}
 
+	pageReq, err := cosmlib.ExtractPageRequestFromInput(pagination)
+	if err != nil {
+		return nil, cbindings.CosmosPageResponse{}, err
+	}
+
 	rsp, err := c.querier.Redelegations(
 		ctx,
 		&stakingtypes.QueryRedelegationsRequest{
 			DelegatorAddr:    delAddr,
 			SrcValidatorAddr: srcValAddr,
 			DstValidatorAddr: destValAddr,
-			Pagination:       cosmlib.ExtractPageRequestFromInput(pagination),
+			Pagination:       pageReq,
 		},
 	)
 	if status.Code(err) == codes.NotFound {
@@ -434,6 +604,93 @@ func (c *Contract) GetRedelegations(
 		err
 }
 
+// GetRedelegations0 implements the `getRedelegations(address,address,address)` method. The zero
+// address matches any source and/or destination validator.
+func (c *Contract) GetRedelegations0(
+	ctx context.Context,
+	delegatorAddress common.Address,
+	srcValidator common.Address,
+	dstValidator common.Address,
+) ([]generated.IStakingModuleRedelegationEntry, error) {
+	delAddr, err := cosmlib.StringFromEthAddress(c.accAddrCodec, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	srcValAddr, err := c.valAddressOrWildcard(srcValidator)
+	if err != nil {
+		return nil, err
+	}
+	dstValAddr, err := c.valAddressOrWildcard(dstValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &stakingtypes.QueryRedelegationsRequest{DelegatorAddr: delAddr}
+	if srcValAddr != "" && dstValAddr != "" {
+		req.SrcValidatorAddr = srcValAddr
+		req.DstValidatorAddr = dstValAddr
+	}
+
+	var redelegationEntries []stakingtypes.RedelegationEntry
+	for {
+		rsp, err := c.querier.Redelegations(ctx, req)
+		if status.Code(err) == codes.NotFound {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, r := range rsp.GetRedelegationResponses() {
+			redel := r.GetRedelegation()
+			if (srcValAddr != "" && redel.ValidatorSrcAddress != srcValAddr) ||
+				(dstValAddr != "" && redel.ValidatorDstAddress != dstValAddr) {
+				continue
+			}
+			for _, entryRsp := range r.GetEntries() {
+				redelegationEntries = append(redelegationEntries, entryRsp.GetRedelegationEntry())
+			}
+		}
+
+		if rsp.Pagination == nil || len(rsp.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: rsp.Pagination.NextKey}
+	}
+
+	return cosmlib.SdkREToStakingRE(redelegationEntries), nil
+}
+
+// GetPool implements the `getPool()` method.
+func (c *Contract) GetPool(
+	ctx context.Context,
+) (*big.Int, *big.Int, error) {
+	res, err := c.querier.Pool(ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Pool.NotBondedTokens.BigInt(), res.Pool.BondedTokens.BigInt(), nil
+}
+
+// GetParams implements the `getParams()` method.
+func (c *Contract) GetParams(
+	ctx context.Context,
+) (generated.IStakingModuleParams, error) {
+	res, err := c.querier.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return generated.IStakingModuleParams{}, err
+	}
+
+	return generated.IStakingModuleParams{
+		UnbondingTime:     uint64(res.Params.UnbondingTime.Seconds()),
+		MaxValidators:     res.Params.MaxValidators,
+		MaxEntries:        res.Params.MaxEntries,
+		HistoricalEntries: res.Params.HistoricalEntries,
+		BondDenom:         res.Params.BondDenom,
+		MinCommissionRate: cosmlib.DecToBigIntScaled(res.Params.MinCommissionRate, cosmlib.DecDecimals),
+	}, nil
+}
+
 // Delegate implements the `delegate(address,uint256)` method.
 func (c *Contract) Delegate(
 	ctx context.Context,
@@ -530,7 +787,8 @@ func (c *Contract) BeginRedelegate(
 	return err == nil, err
 }
 
-// CancelRedelegate implements the `cancelRedelegate(address,address,uint256,int64)` method.
+// CancelUnbondingDelegation implements the `cancelUnbondingDelegation(address,uint256,int64)`
+// method.
 func (c *Contract) CancelUnbondingDelegation(
 	ctx context.Context,
 	validatorAddress common.Address,
@@ -561,7 +819,16 @@ func (c *Contract) CancelUnbondingDelegation(
 			sdk.Coin{Denom: bondDenom, Amount: sdkmath.NewIntFromBigInt(amount)},
 		),
 	)
-	return err != nil, err
+	return err == nil, err
+}
+
+// valAddressOrWildcard returns the bech32 validator address of the given address, or an empty
+// string if it is the zero address.
+func (c *Contract) valAddressOrWildcard(validator common.Address) (string, error) {
+	if validator == (common.Address{}) {
+		return "", nil
+	}
+	return cosmlib.StringFromEthAddress(c.vs.ValidatorAddressCodec(), validator)
 }
 
 // bondDenom returns the bond denom from the staking module.
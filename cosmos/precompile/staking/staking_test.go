@@ -24,14 +24,22 @@ import (
 	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -72,9 +80,10 @@ var (
 
 var _ = Describe("Staking", func() {
 	var (
-		ak authkeeper.AccountKeeperI
-		sk stakingkeeper.Keeper
-		bk bankkeeper.BaseKeeper
+		ak  authkeeper.AccountKeeperI
+		sk  stakingkeeper.Keeper
+		bk  bankkeeper.BaseKeeper
+		slk slashingkeeper.Keeper
 
 		sdkCtx sdk.Context
 
@@ -85,7 +94,17 @@ var _ = Describe("Staking", func() {
 
 	BeforeEach(func() {
 		sdkCtx, ak, bk, sk = testutil.SetupMinimalKeepers()
-		contract = libutils.MustGetAs[*Contract](NewPrecompileContract(ak, &sk))
+		encCfg := testutil.MakeTestEncodingConfig(slashing.AppModuleBasic{})
+		slk = slashingkeeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.Amino,
+			runtime.NewKVStoreService(storetypes.NewKVStoreKey(slashingtypes.StoreKey)),
+			&sk,
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+		contract = libutils.MustGetAs[*Contract](NewPrecompileContract(
+			ak, &sk, slashingkeeper.NewQuerier(slk),
+		))
 		sf = ethprecompile.NewStatefulFactory()
 	})
 
@@ -257,6 +276,64 @@ var _ = Describe("Staking", func() {
 			})
 		})
 
+		When("GetDelegation0", func() {
+			It("should convert the shares at the validator's exchange rate", func() {
+				// Double the other validator's tokens so each of its shares is worth 2 tokens.
+				otherValidator.Tokens = otherValidator.Tokens.MulRaw(2)
+				Expect(sk.SetValidator(ctx, otherValidator)).To(Succeed())
+				Expect(sk.SetDelegation(ctx, stakingtypes.NewDelegation(
+					del.String(), otherVal.String(), sdkmath.LegacyNewDec(10),
+				))).To(Succeed())
+
+				res, err := contract.GetDelegation0(ctx, common.BytesToAddress(del), otherValAddr, "stake")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(big.NewInt(20)))
+
+				res, err = contract.GetDelegation0(ctx, common.BytesToAddress(del), valAddr, "stake")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(big.NewInt(9)))
+			})
+
+			It("should return zero for an absent delegation", func() {
+				res, err := contract.GetDelegation0(ctx, common.BytesToAddress(del), otherValAddr, "stake")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Sign()).To(BeZero())
+			})
+
+			It("should return zero for a denom other than the bond denom", func() {
+				res, err := contract.GetDelegation0(ctx, common.BytesToAddress(del), valAddr, "abera")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Sign()).To(BeZero())
+			})
+		})
+
+		When("GetStakedBalance", func() {
+			It("should sum the tokens across validators with different exchange rates", func() {
+				// Double the other validator's tokens so each of its shares is worth 2 tokens.
+				otherValidator.Tokens = otherValidator.Tokens.MulRaw(2)
+				Expect(sk.SetValidator(ctx, otherValidator)).To(Succeed())
+				Expect(sk.SetDelegation(ctx, stakingtypes.NewDelegation(
+					del.String(), otherVal.String(), sdkmath.LegacyNewDec(10),
+				))).To(Succeed())
+
+				res, err := contract.GetStakedBalance(ctx, common.BytesToAddress(del), "stake")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal(big.NewInt(29))) // 9 shares * 1 + 10 shares * 2
+			})
+
+			It("should return zero without delegations", func() {
+				res, err := contract.GetStakedBalance(ctx, common.BytesToAddress([]byte("nobody")), "stake")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Sign()).To(BeZero())
+			})
+
+			It("should return zero for a denom other than the bond denom", func() {
+				res, err := contract.GetStakedBalance(ctx, common.BytesToAddress(del), "abera")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Sign()).To(BeZero())
+			})
+		})
+
 		When("GetValidatorDelegations", func() {
 			It("should return the validators correct delegations", func() {
 				res, _, err := contract.GetValidatorDelegations(
@@ -329,13 +406,74 @@ var _ = Describe("Staking", func() {
 				)
 				Expect(err).ToNot(HaveOccurred())
 
-				_, err = contract.CancelUnbondingDelegation(
+				ok, err = contract.CancelUnbondingDelegation(
 					ctx,
 					valAddr,
 					amount,
 					creationHeight,
 				)
 				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		When("GetPool", func() {
+			It("should return the not bonded and bonded tokens", func() {
+				Expect(bk.MintCoins(
+					sdkCtx, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+				)).To(Succeed())
+				Expect(bk.MintCoins(
+					sdkCtx, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewInt64Coin("stake", 7)),
+				)).To(Succeed())
+
+				notBonded, bonded, err := contract.GetPool(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(notBonded).To(Equal(bk.GetBalance(
+					sdkCtx, ak.GetModuleAddress(stakingtypes.NotBondedPoolName), "stake",
+				).Amount.BigInt()))
+				Expect(bonded).To(Equal(bk.GetBalance(
+					sdkCtx, ak.GetModuleAddress(stakingtypes.BondedPoolName), "stake",
+				).Amount.BigInt()))
+				Expect(bonded.Int64()).To(BeNumerically(">=", 7))
+			})
+		})
+
+		When("GetParams", func() {
+			It("should return the staking params", func() {
+				params, err := contract.GetParams(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				defaultParams := stakingtypes.DefaultParams()
+				Expect(params).To(Equal(generated.IStakingModuleParams{
+					UnbondingTime:     uint64(defaultParams.UnbondingTime.Seconds()),
+					MaxValidators:     defaultParams.MaxValidators,
+					MaxEntries:        defaultParams.MaxEntries,
+					HistoricalEntries: defaultParams.HistoricalEntries,
+					BondDenom:         "stake",
+					MinCommissionRate: defaultParams.MinCommissionRate.BigInt(),
+				}))
+			})
+		})
+
+		When("GetValidatorUptime", func() {
+			It("should return the missed blocks and the window size", func() {
+				params := slashingtypes.DefaultParams()
+				params.SignedBlocksWindow = 200
+				Expect(slk.SetParams(ctx, params)).To(Succeed())
+				Expect(slk.SetValidatorSigningInfo(ctx, valConsAddr, slashingtypes.NewValidatorSigningInfo(
+					valConsAddr, 1, 20, time.Unix(0, 0).UTC(), false, 7,
+				))).To(Succeed())
+
+				missed, window, err := contract.GetValidatorUptime(ctx, valAddr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(missed).To(Equal(uint64(7)))
+				Expect(window).To(Equal(uint64(200)))
+			})
+
+			It("should fail without signing info", func() {
+				Expect(slk.SetParams(ctx, slashingtypes.DefaultParams())).To(Succeed())
+				_, _, err := contract.GetValidatorUptime(ctx, otherValAddr)
+				Expect(err).To(HaveOccurred())
 			})
 		})
 
@@ -468,6 +606,56 @@ var _ = Describe("Staking", func() {
 		})
 
 		When("GetRedelegations0", func() {
+			redelegate := func(amount *big.Int) {
+				Expect(FundAccount(
+					sdk.UnwrapSDKContext(vm.UnwrapPolarContext(ctx).Context()),
+					bk,
+					caller.Bytes(),
+					sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewIntFromBigInt(amount))),
+				)).To(Succeed())
+
+				validator.Status = stakingtypes.Bonded
+				Expect(sk.SetValidator(ctx, validator)).To(Succeed())
+				otherValidator.Status = stakingtypes.Bonded
+				Expect(sk.SetValidator(ctx, otherValidator)).To(Succeed())
+
+				ret, err := contract.Delegate(ctx, valAddr, amount)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ret).To(BeTrue())
+				ret, err = contract.BeginRedelegate(ctx, valAddr, otherValAddr, amount)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ret).To(BeTrue())
+			}
+
+			It("should return the entries for a specific validator pair", func() {
+				redelegate(big.NewInt(1000))
+
+				entries, err := contract.GetRedelegations0(ctx, caller, valAddr, otherValAddr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].InitialBalance).To(Equal(big.NewInt(1000)))
+
+				entries, err = contract.GetRedelegations0(ctx, caller, otherValAddr, valAddr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(BeEmpty())
+			})
+
+			It("should match any validator for the zero address", func() {
+				redelegate(big.NewInt(1000))
+
+				entries, err := contract.GetRedelegations0(ctx, caller, common.Address{}, common.Address{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+
+				entries, err = contract.GetRedelegations0(ctx, caller, valAddr, common.Address{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+
+				entries, err = contract.GetRedelegations0(ctx, caller, common.Address{}, valAddr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(BeEmpty())
+			})
+
 			When("Calling Helper Methods", func() {
 				When("delegationHelper", func() {
 					It("should not found if the del address is not valid", func() {
@@ -598,6 +786,8 @@ var _ = Describe("Staking", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(res).To(HaveLen(1))
 				Expect(res[0].OperatorAddr).To(Equal(valAddr))
+				Expect(res[0].ConsPubKeyType).To(Equal("ed25519"))
+				Expect(res[0].ConsPubKey).To(Equal(PKs[0].Bytes()))
 			})
 		})
 	})
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// UpdateGoldenEnv is the environment variable which, if set to `true`, makes `CheckGolden`
+// (re)write the golden files instead of comparing against them.
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// Call calls the given method of the stateful precompile with the ABI encoded args, and returns
+// the unpacked return values. As the args and return values are round-tripped through the ABI
+// encoding like in the EVM, this catches mismatches between the Go methods of the precompile and
+// its Solidity interface, which calling the Go methods directly does not.
+func Call(
+	ctx *vm.PolarContext, impl ethprecompile.StatefulImpl, method string, args ...any,
+) ([]any, error) {
+	ret, err := CallRaw(ctx, impl, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return impl.ABIMethods()[method].Outputs.Unpack(ret)
+}
+
+// CallRaw calls the given method of the stateful precompile with the ABI encoded args, and returns
+// the raw return data. If the call reverts with a custom error, the returned data is the ABI
+// encoded custom error.
+func CallRaw(
+	ctx *vm.PolarContext, impl ethprecompile.StatefulImpl, method string, args ...any,
+) ([]byte, error) {
+	abiMethod, found := impl.ABIMethods()[method]
+	if !found {
+		return nil, errorslib.Wrap(ethprecompile.ErrMethodNotFound, method)
+	}
+	packed, err := abiMethod.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := ethprecompile.NewStatefulFactory().Build(impl, nil)
+	if err != nil {
+		return nil, err
+	}
+	return pc.Run(
+		ctx.Context(),
+		ctx.Evm(),
+		append(append([]byte{}, abiMethod.ID...), packed...),
+		ctx.MsgSender(),
+		ctx.MsgValue(),
+	)
+}
+
+// CheckGolden compares the given ABI encoded data with the hex encoded golden file at the given
+// path, and returns an error if they differ. If the `UPDATE_GOLDEN` environment variable is set to
+// `true`, the golden file is written with the data instead.
+func CheckGolden(path string, data []byte) error {
+	if os.Getenv(UpdateGoldenEnv) == "true" {
+		//nolint:gomnd // file permissions.
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		//nolint:gosec,gomnd // golden files are checked in.
+		return os.WriteFile(path, []byte(hex.EncodeToString(data)+"\n"), 0o644)
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	want, err := hex.DecodeString(strings.TrimSpace(string(golden)))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(data, want) {
+		return fmt.Errorf("data 0x%x differs from golden file %s: 0x%x", data, path, want)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testutil
+
+import (
+	"context"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/core/vm/mock"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// ContextConfig configures the Polar context returned by `NewPolarContext`.
+type ContextConfig struct {
+	// Sender is the msg sender of the call.
+	Sender common.Address
+	// Value is the msg value of the call, which defaults to 0.
+	Value *big.Int
+	// BlockNumber is the number of the current block, which defaults to 1.
+	BlockNumber *big.Int
+	// Time is the timestamp of the current block.
+	Time uint64
+	// ReadOnly is whether the precompile is called in a read-only call, e.g. a STATICCALL.
+	ReadOnly bool
+}
+
+// NewPolarContext returns a Polar context for calling the methods of a precompile contract in unit
+// tests, without running an EVM. The base context is usually an `sdk.Context`, and the EVM of the
+// Polar context is a mock built by `NewEVM`.
+func NewPolarContext(baseCtx context.Context, cfg ContextConfig) *vm.PolarContext {
+	if cfg.Value == nil {
+		cfg.Value = big.NewInt(0)
+	}
+	if cfg.BlockNumber == nil {
+		cfg.BlockNumber = big.NewInt(1)
+	}
+	if cfg.ReadOnly {
+		baseCtx = context.WithValue(baseCtx, vm.ReadOnlyContextKey, true)
+	}
+	evm := NewEVM(&vm.BlockContext{BlockNumber: cfg.BlockNumber, Time: cfg.Time})
+	return vm.NewPolarContext(baseCtx, evm, cfg.Sender, cfg.Value)
+}
+
+// NewCallerContext returns a Polar context for calling the methods of a precompile contract as the
+// given caller in unit tests, with the defaults of `NewPolarContext`.
+func NewCallerContext(baseCtx context.Context, caller common.Address) *vm.PolarContext {
+	return NewPolarContext(baseCtx, ContextConfig{Sender: caller})
+}
+
+// NewEVM returns a mocked precompile EVM with the given block context. Its state DB keeps the
+// contract storage, the transient storage and the logs added by precompiles in memory, so they
+// can be inspected with `GetState`, `GetTransientState` and `Logs`.
+func NewEVM(block *vm.BlockContext) *mock.PrecompileEVMMock {
+	evm := mock.NewEVM()
+	evm.GetContextFunc = func() *vm.BlockContext {
+		return block
+	}
+
+	sdb := utils.MustGetAs[*mock.PolarisStateDBMock](evm.GetStateDB())
+	storage := make(map[common.Address]map[common.Hash]common.Hash)
+	sdb.GetStateFunc = func(addr common.Address, key common.Hash) common.Hash {
+		return storage[addr][key]
+	}
+	sdb.SetStateFunc = func(addr common.Address, key common.Hash, value common.Hash) {
+		if storage[addr] == nil {
+			storage[addr] = make(map[common.Hash]common.Hash)
+		}
+		storage[addr][key] = value
+	}
+	transient := make(map[common.Address]map[common.Hash]common.Hash)
+	sdb.GetTransientStateFunc = func(addr common.Address, key common.Hash) common.Hash {
+		return transient[addr][key]
+	}
+	sdb.SetTransientStateFunc = func(addr common.Address, key common.Hash, value common.Hash) {
+		if transient[addr] == nil {
+			transient[addr] = make(map[common.Hash]common.Hash)
+		}
+		transient[addr][key] = value
+	}
+	return evm
+}
+
+// Logs returns the logs added to the state DB of a mocked EVM built by `NewEVM`.
+func Logs(evm *mock.PrecompileEVMMock) []*types.Log {
+	sdb := utils.MustGetAs[*mock.PolarisStateDBMock](evm.GetStateDB())
+	calls := sdb.AddLogCalls()
+	logs := make([]*types.Log, len(calls))
+	for i, call := range calls {
+		logs[i] = call.Log
+	}
+	return logs
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testutil
+
+import (
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// NewMsgRouter returns an in-memory msg service router, which routes msgs to the msg servers
+// registered by the given functions, e.g.:
+//
+//	testutil.NewMsgRouter(ir, func(s gogogrpc.Server) {
+//		banktypes.RegisterMsgServer(s, bankkeeper.NewMsgServerImpl(bk))
+//	})
+func NewMsgRouter(
+	ir codectypes.InterfaceRegistry, registerFns ...func(gogogrpc.Server),
+) *baseapp.MsgServiceRouter {
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(ir)
+	for _, register := range registerFns {
+		register(router)
+	}
+	return router
+}
+
+// NewQueryRouter returns an in-memory gRPC query router, which routes queries to the query
+// servers registered by the given functions, e.g.:
+//
+//	testutil.NewQueryRouter(ir, func(s gogogrpc.Server) {
+//		banktypes.RegisterQueryServer(s, bk)
+//	})
+func NewQueryRouter(
+	ir codectypes.InterfaceRegistry, registerFns ...func(gogogrpc.Server),
+) *baseapp.GRPCQueryRouter {
+	router := baseapp.NewGRPCQueryRouter()
+	router.SetInterfaceRegistry(ir)
+	for _, register := range registerFns {
+		register(router)
+	}
+	return router
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/upgrade"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// Contract is the precompile contract for the upgrade module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	querier upgradetypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the upgrade module precompile contract.
+func NewPrecompileContract(q upgradetypes.QueryServer) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.UpgradeModuleMetaData.ABI,
+			// Precompile Address: 0x7FEF9479c8bc5a3c86891CB82969cbf2ffc73C73
+			common.BytesToAddress(authtypes.NewModuleAddress(upgradetypes.ModuleName)),
+		),
+		querier: q,
+	}
+}
+
+// GetCurrentPlan implements `getCurrentPlan()` method.
+func (c *Contract) GetCurrentPlan(
+	ctx context.Context,
+) (generated.IUpgradeModulePlan, error) {
+	res, err := c.querier.CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+	if err != nil {
+		return generated.IUpgradeModulePlan{}, err
+	}
+
+	// handle the case where no upgrade is scheduled
+	if res.Plan == nil {
+		return generated.IUpgradeModulePlan{}, nil
+	}
+	return generated.IUpgradeModulePlan{
+		Name:   res.Plan.Name,
+		Height: res.Plan.Height,
+		Info:   res.Plan.Info,
+	}, nil
+}
+
+// GetAppliedPlanHeight implements `getAppliedPlanHeight(string)` method.
+func (c *Contract) GetAppliedPlanHeight(
+	ctx context.Context,
+	name string,
+) (int64, error) {
+	res, err := c.querier.AppliedPlan(ctx, &upgradetypes.QueryAppliedPlanRequest{Name: name})
+	if err != nil {
+		return 0, err
+	}
+	return res.Height, nil
+}
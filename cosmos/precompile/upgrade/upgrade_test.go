@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/upgrade"
+	"pkg.berachain.dev/polaris/cosmos/precompile/upgrade"
+	testutils "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestUpgradePrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/upgrade")
+}
+
+var _ = Describe("Upgrade Precompile Test", func() {
+	var (
+		contract *upgrade.Contract
+		uk       *upgradekeeper.Keeper
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		sdkCtx := testutils.NewContext().WithBlockHeight(1)
+		uk = upgradekeeper.NewKeeper(
+			map[int64]bool{},
+			runtime.NewKVStoreService(storetypes.NewKVStoreKey(upgradetypes.StoreKey)),
+			testutils.GetEncodingConfig().Codec,
+			GinkgoT().TempDir(),
+			nil,
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+
+		contract = upgrade.NewPrecompileContract(uk)
+		ctx = vm.NewPolarContext(sdkCtx, nil, common.Address{}, big.NewInt(0))
+	})
+
+	It("should have the upgrade module account address", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			common.BytesToAddress(authtypes.NewModuleAddress(upgradetypes.ModuleName)),
+		))
+	})
+
+	It("should build the precompile methods", func() {
+		_, err := ethprecompile.NewStatefulFactory().Build(contract, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("GetCurrentPlan", func() {
+		It("should return an empty plan if no upgrade is scheduled", func() {
+			plan, err := contract.GetCurrentPlan(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan).To(Equal(generated.IUpgradeModulePlan{}))
+		})
+
+		It("should return the scheduled plan", func() {
+			Expect(uk.ScheduleUpgrade(ctx, upgradetypes.Plan{
+				Name:   "v2",
+				Height: 100,
+				Info:   "info",
+			})).To(Succeed())
+
+			plan, err := contract.GetCurrentPlan(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan).To(Equal(generated.IUpgradeModulePlan{
+				Name:   "v2",
+				Height: 100,
+				Info:   "info",
+			}))
+		})
+	})
+
+	When("GetAppliedPlanHeight", func() {
+		It("should return zero if the plan has not been applied", func() {
+			height, err := contract.GetAppliedPlanHeight(ctx, "v2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(height).To(BeZero())
+		})
+	})
+})
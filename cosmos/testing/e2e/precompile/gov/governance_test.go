@@ -92,7 +92,7 @@ var _ = Describe("Call the Precompile Directly", func() {
 		amt := sdkmath.NewInt(100000000)
 		prop, _ := propAndMsgBz(accCodec, cosmlib.MustStringFromEthAddress(accCodec, tf.Address("alice")), amt)
 		txr := tf.GenerateTransactOpts("alice")
-		tx, err = precompile.SubmitProposal(txr, prop)
+		tx, err = precompile.SubmitProposal0(txr, prop)
 		Expect(err).ToNot(HaveOccurred())
 		ExpectSuccessReceipt(tf.EthClient(), tx)
 
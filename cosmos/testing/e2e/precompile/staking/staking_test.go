@@ -118,7 +118,7 @@ var _ = Describe("Staking", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(ude).To(HaveLen(1))
 		Expect(ude[0].CreationHeight).To(BeNumerically(">=", 1))
-		Expect(ude[0].CompletionTime).ToNot(BeEmpty())
+		Expect(ude[0].CompletionTime).To(BeNumerically(">", 0))
 		Expect(ude[0].Balance.Cmp(undelegateAmt)).To(Equal(0))
 
 		vals, _, err := stakingPrecompile.GetValidators(nil, bindings.CosmosPageRequest{})
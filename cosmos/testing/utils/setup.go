@@ -42,11 +42,13 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authz "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -61,6 +63,7 @@ var (
 	AccKey     = storetypes.NewKVStoreKey("acc")
 	BankKey    = storetypes.NewKVStoreKey("bank")
 	EvmKey     = storetypes.NewKVStoreKey("evm")
+	EvmTKey    = storetypes.NewTransientStoreKey("transient_evm")
 	StakingKey = storetypes.NewKVStoreKey("staking")
 	Alice      = common.BytesToAddress([]byte("alice"))
 	Bob        = common.BytesToAddress([]byte("bob"))
@@ -123,6 +126,7 @@ func SetupMinimalKeepers() (
 
 	encodingConfig := testutil.MakeTestEncodingConfig(
 		auth.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 		bank.AppModuleBasic{},
 		staking.AppModuleBasic{},
 		authz.AppModuleBasic{},
@@ -144,6 +148,7 @@ func SetupMinimalKeepers() (
 			stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 			govtypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
 			distrtypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+			minttypes.ModuleName:           {authtypes.Minter},
 		},
 		// TODO: switch to eip-55 fuck bech32.
 		addrCodec,
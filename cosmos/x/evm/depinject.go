@@ -46,6 +46,7 @@ type DepInjectInput struct {
 	ModuleKey depinject.OwnModuleKey
 	Config    *modulev1alpha1.Module
 	Key       *store.KVStoreKey
+	TKey      *store.TransientStoreKey
 
 	Mempool           sdkmempool.Mempool
 	CustomPrecompiles func() *ethprecompile.Injector `optional:"true"`
@@ -75,6 +76,7 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 		in.BankKeeper,
 		in.StakingKeeper,
 		in.Key,
+		in.TKey,
 		in.Mempool,
 		in.CustomPrecompiles,
 	)
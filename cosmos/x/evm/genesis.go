@@ -29,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/core"
 )
 
@@ -39,13 +40,26 @@ func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
 	if err != nil {
 		panic(err)
 	}
-	return ethGen
+	gen, err := types.WithGenesisParams(ethGen, types.DefaultParams())
+	if err != nil {
+		panic(err)
+	}
+	return gen
 }
 
 // ValidateGenesis performs genesis state validation for the evm module.
 func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
 	ethGen := new(core.Genesis)
-	return ethGen.UnmarshalJSON(bz) // todo: improve
+	if err := ethGen.UnmarshalJSON(bz); err != nil { // todo: improve
+		return err
+	}
+	// an unset evm denom defaults to the bond denom of the staking module at genesis, so it is
+	// validated as the default bond denom.
+	params, err := types.GenesisParams(bz, sdk.DefaultBondDenom)
+	if err != nil {
+		return err
+	}
+	return params.Validate()
 }
 
 // InitGenesis performs genesis initialization for the evm module. It returns
@@ -56,7 +70,15 @@ func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.Ra
 		panic(err)
 	}
 
-	if err := am.keeper.InitGenesis(ctx, &ethGen); err != nil {
+	params, err := types.GenesisParams(data, am.keeper.StakingDenom(ctx))
+	if err != nil {
+		panic(err)
+	}
+	if err = am.keeper.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
+
+	if err = am.keeper.InitGenesis(ctx, &ethGen); err != nil {
 		panic(err)
 	}
 	return []abci.ValidatorUpdate{}
@@ -70,5 +92,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 	if err != nil {
 		panic(err)
 	}
-	return ethGenBz
+	gen, err := types.WithGenesisParams(ethGenBz, am.keeper.GetParams(ctx))
+	if err != nil {
+		panic(err)
+	}
+	return gen
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"pkg.berachain.dev/polaris/cosmos/precompile/staking"
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
@@ -38,6 +39,7 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/core"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 
@@ -62,10 +64,14 @@ var _ = Describe("", func() {
 		ethGen = core.DefaultGenesis
 		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
 		ctx = ctx.WithBlockHeight(0)
-		sc = staking.NewPrecompileContract(ak, &sk)
+		stakingParams := stakingtypes.DefaultParams()
+		stakingParams.BondDenom = "abgt"
+		Expect(sk.SetParams(ctx, stakingParams)).To(Succeed())
+		sc = staking.NewPrecompileContract(ak, &sk, nil)
 		k = keeper.NewKeeper(
 			ak, sk,
 			storetypes.NewKVStoreKey("evm"),
+			storetypes.NewTransientStoreKey("transient_evm"),
 			evmmempool.NewPolarisEthereumTxPool(),
 			func() *ethprecompile.Injector {
 				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{sc}...)
@@ -172,4 +178,49 @@ var _ = Describe("", func() {
 			})
 		})
 	})
+
+	Context("With params", func() {
+		It("should default the evm denom to the staking bond denom", func() {
+			var bz []byte
+			bz, err = json.Marshal(ethGen)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).To(Succeed())
+
+			am.InitGenesis(ctx, cdc, bz)
+			Expect(k.GetParams(ctx)).To(Equal(types.Params{EvmDenom: "abgt"}))
+
+			bz = am.DefaultGenesis(cdc)
+			Expect(am.ValidateGenesis(cdc, nil, bz)).To(Succeed())
+			var params types.Params
+			params, err = types.GenesisParams(bz, "abgt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params.EvmDenom).To(Equal("abgt"))
+		})
+
+		It("should init and export the params", func() {
+			var bz []byte
+			bz, err = json.Marshal(ethGen)
+			Expect(err).ToNot(HaveOccurred())
+			bz, err = types.WithGenesisParams(bz, types.Params{EvmDenom: "abera"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).To(Succeed())
+
+			am.InitGenesis(ctx, cdc, bz)
+			Expect(k.GetParams(ctx)).To(Equal(types.Params{EvmDenom: "abera"}))
+
+			var params types.Params
+			params, err = types.GenesisParams(am.ExportGenesis(ctx, cdc), "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params).To(Equal(types.Params{EvmDenom: "abera"}))
+		})
+
+		It("should reject an invalid evm denom", func() {
+			var bz []byte
+			bz, err = json.Marshal(ethGen)
+			Expect(err).ToNot(HaveOccurred())
+			bz, err = types.WithGenesisParams(bz, types.Params{EvmDenom: "1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(am.ValidateGenesis(cdc, nil, bz)).To(MatchError(types.ErrInvalidParams))
+		})
+	})
 })
@@ -34,6 +34,7 @@ type AccountKeeper interface {
 	AddressCodec() addresscodec.Codec
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 	GetSequence(context.Context, sdk.AccAddress) (uint64, error)
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
@@ -56,4 +57,5 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, err error)
 	ValidatorAddressCodec() addresscodec.Codec
+	BondDenom(ctx context.Context) (string, error)
 }
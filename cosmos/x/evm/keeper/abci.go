@@ -35,6 +35,8 @@ func (k *Keeper) BeginBlocker(ctx context.Context) error {
 }
 
 func (k *Keeper) EndBlock(ctx context.Context) error {
+	// Emit the settlement events aggregated over the block and clear its settlement state.
+	k.settlement.EndBlock(sdk.UnwrapSDKContext(ctx))
 	// Finalize the Polaris Ethereum block.
 	return k.polaris.Finalize(ctx)
 }
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// AccountFirstSeen returns the block height at which the account with the given address was first
+// created in the EVM. It returns false if the account has never been created.
+func (k *Keeper) AccountFirstSeen(ctx sdk.Context, addr common.Address) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(state.AccountFirstSeenKeyFor(addr))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// CodeAt returns the code of the contract at the given address as of the given height, resolving
+// past heights through the historical query context. It returns nil if there was no code at the
+// address at that height, and an error for heights after the current height.
+func (k *Keeper) CodeAt(ctx sdk.Context, addr common.Address, height int64) ([]byte, error) {
+	queryCtx, err := k.queryContext(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	store := queryCtx.KVStore(k.storeKey)
+	codeHash := store.Get(state.CodeHashKeyFor(addr))
+	if codeHash == nil {
+		return nil, nil
+	}
+	return store.Get(state.CodeKeyFor(common.BytesToHash(codeHash))), nil
+}
+
+// TransactionCount returns the committed nonce of the given address or, if includePending is set,
+// the next nonce after the address' contiguous pending txs in the mempool. Queued txs beyond a
+// nonce gap are not counted.
+func (k *Keeper) TransactionCount(
+	ctx sdk.Context, addr common.Address, includePending bool,
+) (uint64, error) {
+	committed := k.committedNonce(ctx, addr)
+	if !includePending {
+		return committed, nil
+	}
+
+	if pending := k.txPool.Nonce(addr); pending > committed {
+		return pending, nil
+	}
+	return committed, nil
+}
+
+// committedNonce returns the nonce of the given address in the given context.
+func (k *Keeper) committedNonce(ctx sdk.Context, addr common.Address) uint64 {
+	acc := k.ak.GetAccount(ctx, addr.Bytes())
+	if acc == nil {
+		return 0
+	}
+	return acc.GetSequence()
+}
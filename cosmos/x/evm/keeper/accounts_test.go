@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Accounts", func() {
+	var (
+		k   *keeper.Keeper
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx, k, _, _ = setupKeeper()
+	})
+
+	When("AccountFirstSeen", func() {
+		It("should record the height an account was first created at", func() {
+			sp := k.GetHost().GetStatePlugin()
+
+			ctx = ctx.WithBlockHeight(5)
+			sp.Reset(ctx)
+			sp.CreateAccount(testutil.Alice)
+			sp.Finalize()
+
+			height, found := k.AccountFirstSeen(ctx, testutil.Alice)
+			Expect(found).To(BeTrue())
+			Expect(height).To(Equal(int64(5)))
+
+			// Re-creating the account later must not move its first-seen height.
+			ctx = ctx.WithBlockHeight(9)
+			sp.Reset(ctx)
+			sp.CreateAccount(testutil.Alice)
+			sp.Finalize()
+
+			height, found = k.AccountFirstSeen(ctx, testutil.Alice)
+			Expect(found).To(BeTrue())
+			Expect(height).To(Equal(int64(5)))
+		})
+
+		It("should return false for an unknown account", func() {
+			_, found := k.AccountFirstSeen(ctx, testutil.Bob)
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	When("CodeAt", func() {
+		var (
+			beforeDeploy sdk.Context
+			deployed     sdk.Context
+			code         = []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+		)
+
+		BeforeEach(func() {
+			ctx, k, _, _ = setupKeeperWith(
+				evmmempool.NewPolarisEthereumTxPool(),
+				func(height int64, _ bool) (sdk.Context, error) {
+					if height < 2 {
+						return beforeDeploy, nil
+					}
+					return deployed, nil
+				},
+			)
+
+			// The contract is deployed at height 2 and the current height is 3.
+			beforeDeploy = ctx.WithBlockHeight(1)
+			deployed, _ = beforeDeploy.CacheContext()
+			deployed = deployed.WithBlockHeight(2)
+
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(deployed)
+			sp.CreateAccount(testutil.Alice)
+			sp.SetCode(testutil.Alice, code)
+			sp.Finalize()
+
+			ctx, _ = deployed.CacheContext()
+			ctx = ctx.WithBlockHeight(3)
+		})
+
+		It("should be absent before the deploy height", func() {
+			bz, err := k.CodeAt(ctx, testutil.Alice, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bz).To(BeNil())
+		})
+
+		It("should be present at and after the deploy height", func() {
+			for _, height := range []int64{2, 3} {
+				bz, err := k.CodeAt(ctx, testutil.Alice, height)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bz).To(Equal(code))
+			}
+		})
+
+		It("should reject a future height", func() {
+			_, err := k.CodeAt(ctx, testutil.Alice, 4)
+			Expect(err).To(MatchError(keeper.ErrFutureHeight))
+		})
+
+		It("should be absent for an account without code", func() {
+			bz, err := k.CodeAt(ctx, testutil.Bob, 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bz).To(BeNil())
+		})
+	})
+
+	When("TransactionCount", func() {
+		var (
+			etp    *evmmempool.EthTxPool
+			key, _ = crypto.GenerateEthKey()
+			alice  = crypto.PubkeyToAddress(key.PublicKey)
+		)
+
+		insert := func(nonce uint64) {
+			tx := buildSdkEthTx(key, &coretypes.LegacyTx{
+				Nonce: nonce, To: &common.Address{0x2}, Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1),
+			})
+			Expect(etp.Insert(ctx, tx)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			etp = evmmempool.NewPolarisEthereumTxPool()
+			ctx, k, _, _ = setupKeeperWith(etp, nil)
+
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(ctx)
+			sp.SetNonce(alice, 2)
+			sp.Finalize()
+			sp.Reset(ctx)
+
+			// Nonces 2 and 3 are pending, nonce 6 is queued behind a gap.
+			insert(2)
+			insert(3)
+			insert(6)
+		})
+
+		It("should return the committed nonce", func() {
+			count, err := k.TransactionCount(ctx, alice, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(uint64(2)))
+		})
+
+		It("should include the contiguous pending txs", func() {
+			count, err := k.TransactionCount(ctx, alice, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(uint64(4)))
+		})
+
+		It("should return zero for an unknown account", func() {
+			for _, includePending := range []bool{false, true} {
+				count, err := k.TransactionCount(ctx, testutil.Bob, includePending)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(BeZero())
+			}
+		})
+	})
+})
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/bank"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+const (
+	// blockTagLatest resolves to the state at the current height.
+	blockTagLatest = "latest"
+	// blockTagPending resolves to the latest state, adjusted by the pending mempool txs.
+	blockTagPending = "pending"
+	// blockTagEarliest resolves to the state at the first committed height.
+	blockTagEarliest = "earliest"
+
+	// earliestHeight is the first height with committed state. Height 0 cannot be used as the
+	// query context treats it as the latest height.
+	earliestHeight = 1
+)
+
+// BalanceByTag returns the EVM balance of the given address at the given block tag, which is one
+// of "latest", "pending", "earliest" or a hex encoded height. The "pending" balance is the latest
+// balance minus the cost of the address' pending txs in the mempool.
+func (k *Keeper) BalanceByTag(ctx sdk.Context, addr common.Address, tag string) (*big.Int, error) {
+	switch tag {
+	case blockTagLatest:
+		return k.balanceAt(ctx, addr), nil
+	case blockTagPending:
+		return k.pendingBalance(ctx, addr), nil
+	case blockTagEarliest:
+		return k.balanceAtHeight(ctx, addr, earliestHeight)
+	}
+
+	height, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return nil, errorslib.Wrapf(ErrInvalidBlockTag, "%q: %v", tag, err)
+	}
+	return k.balanceAtHeight(ctx, addr, int64(height))
+}
+
+// queryContext returns the context to read state at the given height, resolving past heights
+// through the query context function. It returns an error for heights after the current height,
+// which have no state yet.
+func (k *Keeper) queryContext(ctx sdk.Context, height int64) (sdk.Context, error) {
+	if height > ctx.BlockHeight() {
+		return sdk.Context{}, errorslib.Wrapf(
+			ErrFutureHeight, "cannot query height %d at height %d", height, ctx.BlockHeight(),
+		)
+	}
+	if height == ctx.BlockHeight() {
+		return ctx, nil
+	}
+	if k.qc == nil {
+		return sdk.Context{}, errorslib.Wrapf(
+			ErrNoQueryContext, "cannot query height %d", height,
+		)
+	}
+	return k.qc(height, false)
+}
+
+// balanceAtHeight returns the balance of the given address at the given height.
+func (k *Keeper) balanceAtHeight(ctx sdk.Context, addr common.Address, height int64) (*big.Int, error) {
+	queryCtx, err := k.queryContext(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return k.balanceAt(queryCtx, addr), nil
+}
+
+// balanceAt returns the committed balance of the given address in the given context.
+func (k *Keeper) balanceAt(ctx sdk.Context, addr common.Address) *big.Int {
+	return k.bankManager(ctx).GetBalance(ctx, addr)
+}
+
+// SettlementModuleBalance returns the EVM module account's balance of the settlement denom. It is
+// zero in steady state, a nonzero balance indicates funds stuck in the module account.
+func (k *Keeper) SettlementModuleBalance(ctx sdk.Context) *big.Int {
+	return k.bankManager(ctx).ModuleBalance(ctx)
+}
+
+// bankManager returns a bank manager that reads the EVM balances with the x/evm params.
+func (k *Keeper) bankManager(ctx sdk.Context) *bank.Manager {
+	params := k.GetParams(ctx)
+	bm := bank.NewManager(k.bk, params.EvmDenom)
+	bm.SetStoreKey(k.storeKey)
+	bm.SetDecimalConversion(params.ExtraDecimals)
+	return bm
+}
+
+// pendingBalance returns the latest balance of the given address minus the cost of its pending
+// txs in the mempool, floored at zero.
+func (k *Keeper) pendingBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	balance := k.balanceAt(ctx, addr)
+	pending, _ := k.txPool.ContentFrom(addr)
+	for _, tx := range pending {
+		balance.Sub(balance, tx.Cost())
+	}
+	if balance.Sign() < 0 {
+		return new(big.Int)
+	}
+	return balance
+}
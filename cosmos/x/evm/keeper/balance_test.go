@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdkmath "cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"pkg.berachain.dev/polaris/cosmos/crypto/keys/ethsecp256k1"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BalanceByTag", func() {
+	var (
+		k          *keeper.Keeper
+		bk         bankkeeper.BaseKeeper
+		etp        *evmmempool.EthTxPool
+		ctx        sdk.Context
+		historical sdk.Context
+		key, _     = crypto.GenerateEthKey()
+		alice      = crypto.PubkeyToAddress(key.PublicKey)
+	)
+
+	fund := func(ctx sdk.Context, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin("umito", sdkmath.NewInt(amount)))
+		Expect(bk.MintCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+		Expect(bk.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, alice.Bytes(), coins)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		etp = evmmempool.NewPolarisEthereumTxPool()
+		ctx, k, bk, _ = setupKeeperWith(etp, func(height int64, _ bool) (sdk.Context, error) {
+			if height > 2 {
+				return sdk.Context{}, errors.New("height not available")
+			}
+			return historical, nil
+		})
+
+		// Heights 1 and 2 have a balance of 100000, the latest height 3 has a balance of 150000.
+		historical = ctx.WithBlockHeight(1)
+		fund(historical, 100000)
+		ctx, _ = historical.CacheContext()
+		ctx = ctx.WithBlockHeight(3)
+		fund(ctx, 50000)
+		k.GetHost().GetStatePlugin().Reset(ctx)
+	})
+
+	It("should return the latest balance", func() {
+		balance, err := k.BalanceByTag(ctx, alice, "latest")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(150000)))
+	})
+
+	It("should return the earliest balance", func() {
+		balance, err := k.BalanceByTag(ctx, alice, "earliest")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(100000)))
+	})
+
+	It("should return the balance at a hex height", func() {
+		balance, err := k.BalanceByTag(ctx, alice, "0x2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(100000)))
+
+		balance, err = k.BalanceByTag(ctx, alice, "0x3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(150000)))
+	})
+
+	It("should subtract the cost of pending txs", func() {
+		balance, err := k.BalanceByTag(ctx, alice, "pending")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(150000)))
+
+		tx := buildSdkEthTx(key, &coretypes.LegacyTx{
+			Nonce: 0, To: &common.Address{0x2}, Value: big.NewInt(10), Gas: 21000, GasPrice: big.NewInt(1),
+		})
+		Expect(etp.Insert(ctx, tx)).To(Succeed())
+
+		balance, err = k.BalanceByTag(ctx, alice, "pending")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(150000 - 21010)))
+	})
+
+	It("should reject a future height", func() {
+		_, err := k.BalanceByTag(ctx, alice, "0x4")
+		Expect(err).To(MatchError(keeper.ErrFutureHeight))
+	})
+
+	It("should reject an invalid tag", func() {
+		_, err := k.BalanceByTag(ctx, alice, "safe")
+		Expect(err).To(MatchError(keeper.ErrInvalidBlockTag))
+
+		_, err = k.BalanceByTag(ctx, alice, "0xzz")
+		Expect(err).To(MatchError(keeper.ErrInvalidBlockTag))
+	})
+})
+
+var _ = Describe("SettlementModuleBalance", func() {
+	var (
+		k   *keeper.Keeper
+		bk  bankkeeper.BaseKeeper
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx, k, bk, _ = setupKeeper()
+		ctx = ctx.WithBlockHeight(1)
+		k.SetTestMode(true)
+		k.GetHost().GetStatePlugin().Reset(ctx)
+	})
+
+	It("should be zero after a balanced mint and burn cycle", func() {
+		sp := k.GetHost().GetStatePlugin()
+		sp.AddBalance(common.Address{0x1}, big.NewInt(100))
+		Expect(k.FlushBankChanges(ctx)).To(Succeed())
+		sp.SubBalance(common.Address{0x1}, big.NewInt(60))
+		Expect(k.FlushBankChanges(ctx)).To(Succeed())
+		sp.Finalize()
+
+		Expect(bk.GetBalance(ctx, common.Address{0x1}.Bytes(), "umito").Amount.Int64()).To(Equal(int64(40)))
+		Expect(k.SettlementModuleBalance(ctx).Sign()).To(BeZero())
+	})
+
+	It("should be nonzero when funds are stuck in the module account", func() {
+		coins := sdk.NewCoins(sdk.NewCoin("umito", sdkmath.NewInt(7)))
+		Expect(bk.MintCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+
+		Expect(k.SettlementModuleBalance(ctx)).To(Equal(big.NewInt(7)))
+	})
+})
+
+// buildSdkEthTx signs the given tx data and wraps it in an sdk.Tx that can be inserted into the
+// mempool.
+func buildSdkEthTx(from *ecdsa.PrivateKey, txData coretypes.TxData) sdk.Tx {
+	signer := coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+	signedEthTx := coretypes.MustSignNewTx(from, signer, txData)
+	addr, err := signer.Sender(signedEthTx)
+	Expect(err).ToNot(HaveOccurred())
+
+	pubKey := &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(&from.PublicKey)}
+	return &mockSdkTx{
+		signers: [][]byte{addr.Bytes()},
+		msgs:    []sdk.Msg{evmtypes.NewFromTransaction(signedEthTx)},
+		pubKeys: []cryptotypes.PubKey{pubKey},
+		signatures: []signing.SignatureV2{
+			{
+				PubKey: pubKey,
+				// NOTE: not including the signature data for the mock
+				Sequence: signedEthTx.Nonce(),
+			},
+		},
+	}
+}
+
+type mockSdkTx struct {
+	signers    [][]byte
+	msgs       []sdk.Msg
+	pubKeys    []cryptotypes.PubKey
+	signatures []signing.SignatureV2
+}
+
+func (m *mockSdkTx) ValidateBasic() error { return nil }
+
+func (m *mockSdkTx) GetMsgs() []sdk.Msg                             { return m.msgs }
+func (m mockSdkTx) GetMsgsV2() ([]protoreflect.ProtoMessage, error) { return nil, nil }
+func (m *mockSdkTx) GetSigners() ([][]byte, error)                  { return m.signers, nil }
+
+func (m *mockSdkTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return m.pubKeys, nil }
+
+func (m *mockSdkTx) GetSignaturesV2() ([]signing.SignatureV2, error) { return m.signatures, nil }
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// SetDACostPerByte sets the data availability cost charged per byte of a serialized transaction.
+// A nil or zero cost disables data availability pricing.
+func (k *Keeper) SetDACostPerByte(costPerByte *big.Int) {
+	k.daCostPerByte = costPerByte
+}
+
+// EstimateDACost returns the data availability cost of posting the given transaction, computed
+// from its serialized size. It returns zero if data availability pricing is disabled.
+func (k *Keeper) EstimateDACost(_ sdk.Context, tx *coretypes.Transaction) (*big.Int, error) {
+	if k.daCostPerByte == nil || k.daCostPerByte.Sign() == 0 {
+		return new(big.Int), nil
+	}
+
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Mul(k.daCostPerByte, big.NewInt(int64(len(bz)))), nil
+}
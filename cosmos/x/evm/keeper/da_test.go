@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Data Availability", func() {
+	var (
+		k   *keeper.Keeper
+		ctx sdk.Context
+		tx  *coretypes.Transaction
+	)
+
+	BeforeEach(func() {
+		ctx, k, _, _ = setupKeeper()
+		tx = coretypes.NewTransaction(
+			0, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), []byte{0x1, 0x2, 0x3},
+		)
+	})
+
+	It("should return zero when pricing is disabled", func() {
+		cost, err := k.EstimateDACost(ctx, tx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cost.Sign()).To(BeZero())
+	})
+
+	It("should charge per serialized byte when pricing is enabled", func() {
+		k.SetDACostPerByte(big.NewInt(16))
+		bz, err := tx.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+
+		cost, err := k.EstimateDACost(ctx, tx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cost).To(Equal(big.NewInt(int64(16 * len(bz)))))
+	})
+})
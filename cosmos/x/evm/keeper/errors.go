@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import "errors"
+
+var (
+	// ErrNotTestMode is returned when a test-only helper is called outside of test mode.
+	ErrNotTestMode = errors.New("keeper is not in test mode")
+	// ErrInvalidBlockTag is returned when a block tag is neither a known tag nor a hex height.
+	ErrInvalidBlockTag = errors.New("invalid block tag")
+	// ErrNoQueryContext is returned when a historical height is queried without a query context.
+	ErrNoQueryContext = errors.New("no query context function set")
+	// ErrFutureHeight is returned when a height after the current height is queried.
+	ErrFutureHeight = errors.New("height is in the future")
+	// ErrInvalidBlockRange is returned when a block range is empty, negative or too large.
+	ErrInvalidBlockRange = errors.New("invalid block range")
+	// ErrUnauthorized is returned when the params are updated by an address other than the
+	// authority.
+	ErrUnauthorized = errors.New("unauthorized params update")
+	// ErrImmutableParam is returned when a params update changes a param that is fixed at genesis.
+	ErrImmutableParam = errors.New("param cannot change after genesis")
+)
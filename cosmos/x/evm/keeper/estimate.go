@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/state"
+	"pkg.berachain.dev/polaris/eth/params"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// CallMsg is a call to estimate the gas of. A zero `Gas` defaults to the block gas limit.
+type CallMsg struct {
+	From  common.Address
+	To    *common.Address
+	Gas   uint64
+	Value *big.Int
+	Data  []byte
+}
+
+// EstimateGasBatch estimates the gas of each of the given calls at the given height. Like
+// `eth_estimateGas`, the estimate of a call is the lowest gas limit (up to its `Gas`) with which
+// it succeeds, found by binary search. The calls are executed in order against a shared statedb
+// of the state at that height, so that each call sees the state changes of the calls before it.
+// No state changes are persisted.
+func (k *Keeper) EstimateGasBatch(ctx sdk.Context, msgs []CallMsg, height int64) ([]uint64, error) {
+	bc := k.polaris.Blockchain()
+	header := bc.GetHeaderByNumber(uint64(height))
+	if header == nil {
+		return nil, errorslib.Wrapf(core.ErrHeaderNotFound, "height %d", height)
+	}
+
+	sp, err := k.host.GetStatePlugin().StateAtBlockNumber(uint64(height))
+	if err != nil {
+		return nil, err
+	}
+	statedb := state.NewStateDB(sp)
+
+	vmConfig := *bc.GetVMConfig()
+	vmConfig.NoBaseFee = true
+
+	estimates := make([]uint64, len(msgs))
+	for i, msg := range msgs {
+		hi := msg.Gas
+		if hi == 0 {
+			hi = header.GasLimit
+		}
+		value := msg.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+
+		// apply executes the call with the given gas limit. The state changes are reverted unless
+		// commit is set.
+		apply := func(gasLimit uint64, commit bool) (*core.ExecutionResult, error) {
+			coreMsg := &core.Message{
+				From:              msg.From,
+				To:                msg.To,
+				Nonce:             statedb.GetNonce(msg.From),
+				Value:             value,
+				GasLimit:          gasLimit,
+				GasPrice:          new(big.Int),
+				GasFeeCap:         new(big.Int),
+				GasTipCap:         new(big.Int),
+				Data:              msg.Data,
+				SkipAccountChecks: true,
+			}
+			snapshot := statedb.Snapshot()
+			evm := bc.GetEVM(ctx, core.NewEVMTxContext(coreMsg), statedb, header, &vmConfig)
+			res, err := core.ApplyMessage(evm, coreMsg, new(core.GasPool).AddGas(math.MaxUint64))
+			if !commit || err != nil || res.Failed() {
+				statedb.RevertToSnapshot(snapshot)
+			}
+			return res, err
+		}
+
+		// The call must succeed with the highest allowed gas limit.
+		res, err := apply(hi, false)
+		if err != nil {
+			return nil, errorslib.Wrapf(err, "call %d", i)
+		}
+		if res.Failed() {
+			return nil, errorslib.Wrapf(res.Err, "call %d", i)
+		}
+
+		// Binary search the lowest gas limit with which the call succeeds. The call can never
+		// succeed with less than the gas it used, minus refunds, or its intrinsic gas.
+		lo := params.TxGas - 1
+		if res.UsedGas > lo+1 {
+			lo = res.UsedGas - 1
+		}
+		for lo+1 < hi {
+			mid := lo + (hi-lo)/2
+			res, err = apply(mid, false)
+			switch {
+			case errors.Is(err, core.ErrIntrinsicGas) || (err == nil && res.Failed()):
+				lo = mid
+			case err != nil:
+				return nil, errorslib.Wrapf(err, "call %d", i)
+			default:
+				hi = mid
+			}
+		}
+
+		// Apply the call with its estimate so that the following calls see its state changes.
+		if _, err = apply(hi, true); err != nil {
+			return nil, errorslib.Wrapf(err, "call %d", i)
+		}
+		estimates[i] = hi
+	}
+
+	k.Logger(ctx).Debug("estimated gas batch", "height", height, "calls", len(msgs))
+	return estimates, nil
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EstimateGasBatch", func() {
+	var (
+		k       *keeper.Keeper
+		bk      bankkeeper.BaseKeeper
+		ctx     sdk.Context
+		carol   = common.BytesToAddress([]byte("carol"))
+		batch   []keeper.CallMsg
+		current sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx, k, bk, _ = setupKeeperWith(
+			evmmempool.NewPolarisEthereumTxPool(),
+			func(int64, bool) (sdk.Context, error) { return current, nil },
+		)
+		ctx = ctx.WithBlockHeight(1)
+		current = ctx
+
+		bp := k.GetHost().GetBlockPlugin()
+		bp.Prepare(ctx)
+		Expect(bp.StoreHeader(&coretypes.Header{
+			Number:     big.NewInt(1),
+			GasLimit:   30_000_000,
+			BaseFee:    big.NewInt(1),
+			Difficulty: big.NewInt(0),
+			Time:       1,
+		})).To(Succeed())
+		k.GetHost().GetStatePlugin().Reset(ctx)
+
+		coins := sdk.NewCoins(sdk.NewCoin("umito", sdkmath.NewInt(1000)))
+		Expect(bk.MintCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+		Expect(bk.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, testutil.Alice.Bytes(), coins)).To(Succeed())
+
+		// Bob can only pay carol with the funds received from alice in the first call.
+		batch = []keeper.CallMsg{
+			{From: testutil.Alice, To: &testutil.Bob, Value: big.NewInt(100)},
+			{From: testutil.Bob, To: &carol, Value: big.NewInt(50)},
+		}
+	})
+
+	It("should let later calls see the state changes of earlier calls", func() {
+		estimates, err := k.EstimateGasBatch(ctx, batch, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(estimates).To(Equal([]uint64{21000, 21000}))
+	})
+
+	It("should search the lowest gas limit up to the gas of the call", func() {
+		batch[0].Gas = 21000
+		estimates, err := k.EstimateGasBatch(ctx, batch, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(estimates).To(Equal([]uint64{21000, 21000}))
+
+		batch[0].Gas = 20999
+		_, err = k.EstimateGasBatch(ctx, batch, 1)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail a call that depends on state it does not see", func() {
+		_, err := k.EstimateGasBatch(ctx, batch[1:], 1)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not persist any state changes", func() {
+		_, err := k.EstimateGasBatch(ctx, batch, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bk.GetBalance(ctx, testutil.Bob.Bytes(), "umito").Amount.Int64()).To(BeZero())
+		Expect(bk.GetBalance(ctx, testutil.Alice.Bytes(), "umito").Amount.Int64()).To(Equal(int64(1000)))
+	})
+})
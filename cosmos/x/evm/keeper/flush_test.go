@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	bankplugin "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/bank"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FlushBankChanges", func() {
+	var (
+		k   *keeper.Keeper
+		bk  bankkeeper.BaseKeeper
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx, k, bk, _ = setupKeeper()
+		ctx = ctx.WithBlockHeight(1)
+		k.GetHost().GetStatePlugin().Reset(ctx)
+	})
+
+	It("should fail outside of test mode", func() {
+		Expect(k.FlushBankChanges(ctx)).To(MatchError(keeper.ErrNotTestMode))
+	})
+
+	It("should commit pending balance changes to the bank module", func() {
+		k.SetTestMode(true)
+		sp := k.GetHost().GetStatePlugin()
+		sp.AddBalance(testutil.Alice, big.NewInt(100))
+		Expect(bk.GetBalance(ctx, testutil.Alice.Bytes(), "umito").Amount.Int64()).To(BeZero())
+
+		Expect(k.FlushBankChanges(ctx)).To(Succeed())
+		sp.Finalize()
+		Expect(bk.GetBalance(ctx, testutil.Alice.Bytes(), "umito").Amount.Int64()).To(Equal(int64(100)))
+
+		// Flushed changes must not be committed a second time at the block boundary.
+		sp.CommitToBank()
+		sp.Finalize()
+		Expect(bk.GetBalance(ctx, testutil.Alice.Bytes(), "umito").Amount.Int64()).To(Equal(int64(100)))
+	})
+})
+
+var _ = Describe("Reconcile", func() {
+	var (
+		k   *keeper.Keeper
+		bk  bankkeeper.BaseKeeper
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx, k, bk, _ = setupKeeper()
+		ctx = ctx.WithBlockHeight(1)
+		k.GetHost().GetStatePlugin().Reset(ctx)
+	})
+
+	It("should find no discrepancies for consistent pending balances", func() {
+		sp := k.GetHost().GetStatePlugin()
+		sp.AddBalance(testutil.Alice, big.NewInt(100))
+		sp.SubBalance(testutil.Alice, big.NewInt(40))
+
+		for _, correct := range []bool{false, true} {
+			count, err := k.Reconcile(ctx, correct)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		}
+		Expect(sp.GetBalance(testutil.Alice)).To(Equal(big.NewInt(60)))
+	})
+
+	When("an account with a pending debit is drained natively", func() {
+		BeforeEach(func() {
+			sp := k.GetHost().GetStatePlugin()
+			sp.AddBalance(testutil.Alice, big.NewInt(100))
+			sp.CommitToBank()
+			sp.Finalize()
+			sp.Reset(ctx)
+
+			// the EVM observes a balance of 100 and debits 40 of it
+			sp.SubBalance(testutil.Alice, big.NewInt(40))
+			drain(ctx, bk, 30)
+		})
+
+		It("should report the discrepancy without correcting it", func() {
+			for i := 0; i < 2; i++ {
+				count, err := k.Reconcile(ctx, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(1))
+			}
+			Expect(k.GetHost().GetStatePlugin().GetBalance(testutil.Alice)).To(
+				Equal(big.NewInt(60)),
+			)
+		})
+
+		It("should rebase the pending balance onto the bank balance in correct mode", func() {
+			count, err := k.Reconcile(ctx, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+
+			count, err = k.Reconcile(ctx, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+			Expect(k.GetHost().GetStatePlugin().GetBalance(testutil.Alice)).To(
+				Equal(big.NewInt(30)),
+			)
+		})
+	})
+
+	It("should not report settled changes again", func() {
+		sp := k.GetHost().GetStatePlugin()
+		sp.AddBalance(testutil.Alice, big.NewInt(100))
+		sp.CommitToBank()
+		sp.Finalize()
+
+		count, err := k.Reconcile(ctx, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+		Expect(bk.GetBalance(ctx, testutil.Alice.Bytes(), "umito").Amount.Int64()).To(
+			Equal(int64(100)),
+		)
+	})
+
+	It("should not report native bank activity on accounts without pending changes", func() {
+		sp := k.GetHost().GetStatePlugin()
+		sp.AddBalance(testutil.Alice, big.NewInt(100))
+		sp.CommitToBank()
+		sp.Finalize()
+		drain(ctx, bk, 30)
+
+		count, err := k.Reconcile(ctx, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(BeZero())
+		Expect(bk.GetBalance(ctx, testutil.Alice.Bytes(), "umito").Amount.Int64()).To(
+			Equal(int64(70)),
+		)
+	})
+})
+
+// drain burns the given amount of the bank balance of Alice natively.
+func drain(ctx sdk.Context, bk bankkeeper.BaseKeeper, amount int64) {
+	coins := sdk.NewCoins(sdk.NewCoin("umito", sdkmath.NewInt(amount)))
+	Expect(bk.SendCoinsFromAccountToModule(
+		ctx, testutil.Alice.Bytes(), evmtypes.ModuleName, coins,
+	)).To(Succeed())
+	Expect(bk.BurnCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+}
+
+var _ = Describe("RegisterCommitHook", func() {
+	It("should run the hooks on the bank manager of every tx", func() {
+		ctx, k, _, _ := setupKeeper()
+		ctx = ctx.WithBlockHeight(1)
+		var settled []bankplugin.BalanceChange
+		k.RegisterCommitHook(func(_ sdk.Context, changes []bankplugin.BalanceChange) {
+			settled = append(settled, changes...)
+		})
+
+		sp := k.GetHost().GetStatePlugin()
+		for _, amount := range []int64{100, 50} {
+			sp.Reset(ctx)
+			sp.AddBalance(testutil.Alice, big.NewInt(amount))
+			sp.CommitToBank()
+			sp.Finalize()
+		}
+		Expect(settled).To(Equal([]bankplugin.BalanceChange{
+			{Addr: testutil.Alice, Delta: big.NewInt(100)},
+			{Addr: testutil.Alice, Delta: big.NewInt(50)},
+		}))
+	})
+})
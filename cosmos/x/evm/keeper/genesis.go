@@ -24,7 +24,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
@@ -50,3 +53,21 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *core.Genesis {
 	}
 	return genesisState
 }
+
+// GenesisInfo returns the hash and timestamp of the EVM genesis block, which clients can use to
+// verify they are connected to the expected chain. It returns zero values if the genesis header
+// cannot be read. The header is read from the store with the given context, so that the query
+// does not change the context of the block plugin of the host.
+func (k *Keeper) GenesisInfo(ctx sdk.Context) (common.Hash, uint64) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.GenesisHeaderKey})
+	if bz == nil {
+		k.Logger(ctx).Error("failed to read genesis header", "err", core.ErrHeaderNotFound)
+		return common.Hash{}, 0
+	}
+	header, err := coretypes.UnmarshalHeader(bz)
+	if err != nil {
+		k.Logger(ctx).Error("failed to read genesis header", "err", err)
+		return common.Hash{}, 0
+	}
+	return header.Hash(), header.Time
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Genesis", func() {
+	var (
+		k   *keeper.Keeper
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx, k, _, _ = setupKeeper()
+	})
+
+	When("GenesisInfo", func() {
+		It("should return the genesis block hash and timestamp", func() {
+			hash, timestamp := k.GenesisInfo(ctx)
+			Expect(hash).ToNot(Equal(common.Hash{}))
+			Expect(hash).To(Equal(core.DefaultGenesis.ToBlock().Hash()))
+			Expect(timestamp).To(Equal(core.DefaultGenesis.Timestamp))
+		})
+
+		It("should be stable across calls and heights", func() {
+			hash, timestamp := k.GenesisInfo(ctx)
+
+			ctx = ctx.WithBlockHeight(10)
+			otherHash, otherTimestamp := k.GenesisInfo(ctx)
+			Expect(otherHash).To(Equal(hash))
+			Expect(otherTimestamp).To(Equal(timestamp))
+		})
+
+		It("should return zero values without a genesis header", func() {
+			hash, timestamp := k.GenesisInfo(testutil.NewContext())
+			Expect(hash).To(Equal(common.Hash{}))
+			Expect(timestamp).To(BeZero())
+		})
+	})
+})
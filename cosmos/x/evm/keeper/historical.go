@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+const (
+	// maxFeesPaidRange is the maximum number of blocks `FeesPaid` scans in a single query.
+	maxFeesPaidRange = 1000
+	// maxGasPriceStatsBlocks is the maximum number of blocks `GasPriceStats` scans in a single
+	// query.
+	maxGasPriceStatsBlocks = 1000
+)
+
+// GetBlockReceipts returns all the receipts of the block with the given hash, in transaction
+// order. It returns `core.ErrBlockNotFound` if the block is unknown.
+func (k *Keeper) GetBlockReceipts(ctx sdk.Context, blockHash common.Hash) ([]*coretypes.Receipt, error) {
+	hp := k.historicalPlugin(ctx)
+
+	if _, err := hp.GetBlockByHash(blockHash); err != nil {
+		return nil, err
+	}
+	return hp.GetReceiptsByHash(blockHash)
+}
+
+// TxGasUsed returns the gas used by the transaction with the given hash, as recorded in its
+// receipt. It returns `core.ErrTxNotFound` if the transaction is unknown.
+func (k *Keeper) TxGasUsed(ctx sdk.Context, txHash common.Hash) (uint64, error) {
+	hp := k.historicalPlugin(ctx)
+
+	tle, err := hp.GetTransactionByHash(txHash)
+	if err != nil {
+		return 0, err
+	}
+	receipts, err := hp.GetReceiptsByHash(tle.BlockHash)
+	if err != nil {
+		return 0, err
+	}
+	if tle.TxIndex >= uint64(len(receipts)) {
+		return 0, errorslib.Wrapf(core.ErrReceiptsNotFound, "tx %s", txHash.Hex())
+	}
+	return receipts[tle.TxIndex].GasUsed, nil
+}
+
+// FeesPaid returns the sum of the gas fees, i.e. gas used times effective gas price, paid by the
+// txs sent from the given address in the blocks from fromHeight to toHeight inclusive. The range
+// may span at most `maxFeesPaidRange` blocks.
+func (k *Keeper) FeesPaid(
+	ctx sdk.Context, addr common.Address, fromHeight, toHeight int64,
+) (*big.Int, error) {
+	if fromHeight < 0 || fromHeight > toHeight || toHeight-fromHeight >= maxFeesPaidRange {
+		return nil, errorslib.Wrapf(
+			ErrInvalidBlockRange, "[%d, %d], max %d blocks", fromHeight, toHeight, maxFeesPaidRange,
+		)
+	}
+
+	hp := k.historicalPlugin(ctx)
+
+	fees := new(big.Int)
+	for height := fromHeight; height <= toHeight; height++ {
+		block, err := hp.GetBlockByNumber(uint64(height))
+		if err != nil {
+			return nil, errorslib.Wrapf(err, "block %d", height)
+		}
+		if len(block.Transactions()) == 0 {
+			continue
+		}
+		receipts, err := hp.GetReceiptsByHash(block.Hash())
+		if err != nil {
+			return nil, err
+		}
+
+		for i, tx := range block.Transactions() {
+			if i >= len(receipts) || coretypes.GetSender(tx) != addr {
+				continue
+			}
+			fee := new(big.Int).SetUint64(receipts[i].GasUsed)
+			fees.Add(fees, fee.Mul(fee, receipts[i].EffectiveGasPrice))
+		}
+	}
+	return fees, nil
+}
+
+// GasPriceStats returns the minimum, maximum and median effective gas price of the txs included in
+// the last blockCount blocks up to the current height. The median of an even number of prices is
+// the floored mean of the two middle prices. All stats are zero if the blocks contain no txs.
+func (k *Keeper) GasPriceStats(
+	ctx sdk.Context, blockCount int,
+) (*big.Int, *big.Int, *big.Int, error) {
+	if blockCount <= 0 || blockCount > maxGasPriceStatsBlocks {
+		return nil, nil, nil, errorslib.Wrapf(
+			ErrInvalidBlockRange, "%d blocks, max %d", blockCount, maxGasPriceStatsBlocks,
+		)
+	}
+
+	hp := k.historicalPlugin(ctx)
+
+	var prices []*big.Int
+	fromHeight := ctx.BlockHeight() - int64(blockCount) + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+	for height := fromHeight; height <= ctx.BlockHeight(); height++ {
+		block, err := hp.GetBlockByNumber(uint64(height))
+		if err != nil {
+			return nil, nil, nil, errorslib.Wrapf(err, "block %d", height)
+		}
+		for _, tx := range block.Transactions() {
+			prices = append(prices, effectiveGasPrice(tx, block.BaseFee()))
+		}
+	}
+
+	if len(prices) == 0 {
+		return new(big.Int), new(big.Int), new(big.Int), nil
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Cmp(prices[j]) < 0
+	})
+
+	mid := len(prices) / 2 //nolint:gomnd // half.
+	median := new(big.Int).Set(prices[mid])
+	if len(prices)%2 == 0 {
+		median.Add(median, prices[mid-1])
+		median.Rsh(median, 1)
+	}
+	return prices[0], prices[len(prices)-1], median, nil
+}
+
+// historicalPlugin returns a historical plugin that reads the chain data with the given context.
+// Queries use their own plugins, so that they do not change the context of the plugins of the host,
+// which are shared with the execution of the current block.
+func (k *Keeper) historicalPlugin(ctx sdk.Context) core.HistoricalPlugin {
+	cp := configuration.NewPlugin(k.storeKey)
+	cp.Prepare(ctx)
+	bp := block.NewPlugin(k.storeKey, k.sk)
+	bp.Prepare(ctx)
+	hp := historical.NewPlugin(cp, bp, nil, k.storeKey)
+	hp.Prepare(ctx)
+	return hp
+}
+
+// effectiveGasPrice returns the gas price paid by the given tx in a block with the given base fee.
+func effectiveGasPrice(tx *coretypes.Transaction, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return tx.GasPrice()
+	}
+	return new(big.Int).Add(tx.EffectiveGasTipValue(baseFee), baseFee)
+}
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/trie"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// setupKeeper returns a keeper whose plugins have been set up and initialized at genesis, along
+// with the bank and staking keepers backing it.
+func setupKeeper() (sdk.Context, *keeper.Keeper, bankkeeper.BaseKeeper, stakingkeeper.Keeper) {
+	return setupKeeperWith(evmmempool.NewPolarisEthereumTxPool(), nil)
+}
+
+// setupKeeperWith is like setupKeeper, but builds the keeper on the given mempool and query
+// context function.
+func setupKeeperWith(
+	etp *evmmempool.EthTxPool,
+	qc func(height int64, prove bool) (sdk.Context, error),
+) (sdk.Context, *keeper.Keeper, bankkeeper.BaseKeeper, stakingkeeper.Keeper) {
+	ctx, ak, bk, sk := testutil.SetupMinimalKeepers()
+	Expect(sk.SetParams(ctx, stakingtypes.DefaultParams())).To(Succeed())
+	k := keeper.NewKeeper(
+		ak, bk, &sk,
+		testutil.EvmKey,
+		testutil.EvmTKey,
+		etp,
+		func() *ethprecompile.Injector {
+			return ethprecompile.NewPrecompiles()
+		},
+	)
+	k.Setup(storetypes.NewKVStoreKey("offchain-evm"), qc, "", GinkgoT().TempDir(), log.NewNopLogger())
+
+	ctx = ctx.WithBlockHeight(0)
+	for _, plugin := range k.GetHost().GetAllPlugins() {
+		plugin, hasInitGenesis := utils.GetAs[plugins.HasGenesis](plugin)
+		if hasInitGenesis {
+			plugin.InitGenesis(ctx, core.DefaultGenesis)
+		}
+	}
+	return ctx, k, bk, sk
+}
+
+var _ = Describe("Historical Queries", func() {
+	var (
+		k         *keeper.Keeper
+		ctx       sdk.Context
+		txs       coretypes.Transactions
+		blockHash common.Hash
+	)
+
+	BeforeEach(func() {
+		ctx, k, _, _ = setupKeeper()
+		ctx = ctx.WithBlockHeight(1)
+
+		txs = coretypes.Transactions{
+			coretypes.NewTransaction(0, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), nil),
+			coretypes.NewTransaction(1, common.Address{0x2}, big.NewInt(1), 30000, big.NewInt(1), nil),
+		}
+		receipts := coretypes.Receipts{
+			{Status: 1, CumulativeGasUsed: 21000, GasUsed: 21000, TxHash: txs[0].Hash()},
+			{Status: 1, CumulativeGasUsed: 51000, GasUsed: 30000, TxHash: txs[1].Hash()},
+		}
+		header := &coretypes.Header{Number: big.NewInt(1), GasLimit: 100000}
+		block := coretypes.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+		blockHash = block.Hash()
+
+		hp := k.GetHost().GetHistoricalPlugin()
+		hp.Prepare(ctx)
+		Expect(hp.StoreBlock(block)).To(Succeed())
+		Expect(hp.StoreReceipts(blockHash, receipts)).To(Succeed())
+		Expect(hp.StoreTransactions(1, blockHash, txs)).To(Succeed())
+	})
+
+	When("GetBlockReceipts", func() {
+		It("should return the receipts in order", func() {
+			receipts, err := k.GetBlockReceipts(ctx, blockHash)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(2))
+			Expect(receipts[0].TxHash).To(Equal(txs[0].Hash()))
+			Expect(receipts[1].TxHash).To(Equal(txs[1].Hash()))
+			Expect(receipts[0].CumulativeGasUsed).To(Equal(uint64(21000)))
+			Expect(receipts[1].CumulativeGasUsed).To(Equal(uint64(51000)))
+			Expect(receipts[1].BlockHash).To(Equal(blockHash))
+		})
+
+		It("should fail on an unknown block hash", func() {
+			_, err := k.GetBlockReceipts(ctx, common.Hash{0x1})
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+		})
+
+		It("should not change the context of the plugins of the host", func() {
+			queryCtx, _ := ctx.CacheContext()
+			_, err := k.GetBlockReceipts(queryCtx, blockHash)
+			Expect(err).ToNot(HaveOccurred())
+
+			// the host keeps storing the chain data with the context of the block
+			header := &coretypes.Header{Number: big.NewInt(2), GasLimit: 100000}
+			block := coretypes.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+			Expect(k.GetHost().GetHistoricalPlugin().StoreBlock(block)).To(Succeed())
+			fees, err := k.FeesPaid(ctx, common.Address{}, 2, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fees.Sign()).To(BeZero())
+		})
+	})
+
+	When("TxGasUsed", func() {
+		It("should match the receipt of a known tx", func() {
+			gasUsed, err := k.TxGasUsed(ctx, txs[1].Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gasUsed).To(Equal(uint64(30000)))
+		})
+
+		It("should fail on an unknown tx hash", func() {
+			_, err := k.TxGasUsed(ctx, common.Hash{0x1})
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+		})
+	})
+
+	When("querying a range of blocks", func() {
+		var (
+			aliceKey, _ = crypto.GenerateEthKey()
+			bobKey, _   = crypto.GenerateEthKey()
+			alice       = crypto.PubkeyToAddress(aliceKey.PublicKey)
+			bob         = crypto.PubkeyToAddress(bobKey.PublicKey)
+		)
+
+		storeBlock := func(number int64, txs coretypes.Transactions, gasUsed []uint64) {
+			receipts := make(coretypes.Receipts, len(txs))
+			var cumulative uint64
+			for i, tx := range txs {
+				cumulative += gasUsed[i]
+				receipts[i] = &coretypes.Receipt{
+					Status: 1, CumulativeGasUsed: cumulative, GasUsed: gasUsed[i], TxHash: tx.Hash(),
+				}
+			}
+			header := &coretypes.Header{Number: big.NewInt(number), GasLimit: 100000}
+			block := coretypes.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+
+			hp := k.GetHost().GetHistoricalPlugin()
+			hp.Prepare(ctx)
+			Expect(hp.StoreBlock(block)).To(Succeed())
+			Expect(hp.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+			Expect(hp.StoreTransactions(uint64(number), block.Hash(), txs)).To(Succeed())
+		}
+
+		signedTx := func(key *ecdsa.PrivateKey, nonce uint64, gasPrice int64) *coretypes.Transaction {
+			signer := coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+			return coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+				Nonce:    nonce,
+				To:       &common.Address{0x1},
+				Value:    big.NewInt(1),
+				Gas:      50000,
+				GasPrice: big.NewInt(gasPrice),
+			})
+		}
+
+		BeforeEach(func() {
+			ctx, k, _, _ = setupKeeper()
+			ctx = ctx.WithBlockHeight(3)
+
+			// Block 1 has a tx from each of alice and bob, block 2 is empty and block 3 has another
+			// tx from alice.
+			storeBlock(
+				1,
+				coretypes.Transactions{signedTx(aliceKey, 0, 2), signedTx(bobKey, 0, 3)},
+				[]uint64{21000, 21000},
+			)
+			storeBlock(2, nil, nil)
+			storeBlock(3, coretypes.Transactions{signedTx(aliceKey, 1, 5)}, []uint64{30000})
+		})
+
+		When("FeesPaid", func() {
+			It("should sum the fees of the account's txs over the range", func() {
+				fees, err := k.FeesPaid(ctx, alice, 1, 3)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fees).To(Equal(big.NewInt(21000*2 + 30000*5)))
+
+				fees, err = k.FeesPaid(ctx, alice, 2, 3)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fees).To(Equal(big.NewInt(30000 * 5)))
+
+				fees, err = k.FeesPaid(ctx, bob, 1, 3)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fees).To(Equal(big.NewInt(21000 * 3)))
+			})
+
+			It("should be zero for an account without txs", func() {
+				fees, err := k.FeesPaid(ctx, common.Address{0x9}, 1, 3)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fees.Sign()).To(BeZero())
+			})
+
+			It("should reject invalid ranges", func() {
+				_, err := k.FeesPaid(ctx, alice, 3, 1)
+				Expect(err).To(MatchError(keeper.ErrInvalidBlockRange))
+
+				_, err = k.FeesPaid(ctx, alice, -1, 1)
+				Expect(err).To(MatchError(keeper.ErrInvalidBlockRange))
+
+				_, err = k.FeesPaid(ctx, alice, 1, 1000)
+				Expect(err).To(MatchError(keeper.ErrInvalidBlockRange))
+			})
+		})
+
+		When("GasPriceStats", func() {
+			It("should compute the stats over the recent blocks", func() {
+				minPrice, maxPrice, median, err := k.GasPriceStats(ctx, 3)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(minPrice).To(Equal(big.NewInt(2)))
+				Expect(maxPrice).To(Equal(big.NewInt(5)))
+				Expect(median).To(Equal(big.NewInt(3)))
+
+				minPrice, maxPrice, median, err = k.GasPriceStats(ctx, 2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(minPrice).To(Equal(big.NewInt(5)))
+				Expect(maxPrice).To(Equal(big.NewInt(5)))
+				Expect(median).To(Equal(big.NewInt(5)))
+			})
+
+			It("should average the middle prices for an even number of txs", func() {
+				// Blocks 1 and 2 hold the txs priced 2 and 3.
+				_, _, median, err := k.GasPriceStats(ctx.WithBlockHeight(2), 2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(median).To(Equal(big.NewInt(2)))
+			})
+
+			It("should be zero for blocks without txs", func() {
+				minPrice, maxPrice, median, err := k.GasPriceStats(ctx.WithBlockHeight(2), 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(minPrice.Sign()).To(BeZero())
+				Expect(maxPrice.Sign()).To(BeZero())
+				Expect(median.Sign()).To(BeZero())
+			})
+
+			It("should not look back past the first block", func() {
+				minPrice, _, _, err := k.GasPriceStats(ctx, 100)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(minPrice).To(Equal(big.NewInt(2)))
+			})
+
+			It("should reject an invalid block count", func() {
+				_, _, _, err := k.GasPriceStats(ctx, 0)
+				Expect(err).To(MatchError(keeper.ErrInvalidBlockRange))
+			})
+		})
+	})
+})
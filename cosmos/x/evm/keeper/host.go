@@ -101,8 +101,11 @@ func (h *host) Setup(
 	qc func(height int64, prove bool) (sdk.Context, error),
 ) {
 	// Setup the state, precompile, historical, and txpool plugins
-	h.sp = state.NewPlugin(ak, bk, storeKey, log.NewFactory(h.pcs().GetPrecompiles()))
-	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp)
+	pcs := h.pcs()
+	h.sp = state.NewPlugin(ak, bk, storeKey, log.NewFactory(pcs.GetPrecompiles()))
+	h.pp = precompile.NewPlugin(
+		pcs.GetPrecompiles(), h.sp, storeKey, pcs.GetGatedPrecompiles()...,
+	)
 	// TODO: re-enable historical plugin using ABCI listener.
 	h.hp = historical.NewPlugin(h.cp, h.bp, nil, storeKey)
 	h.txp.SetNonceRetriever(h.sp)
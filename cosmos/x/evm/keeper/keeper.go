@@ -21,6 +21,7 @@
 package keeper
 
 import (
+	"math/big"
 	"time"
 
 	"cosmossdk.io/log"
@@ -29,27 +30,46 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
-	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/bank"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 	ethlog "pkg.berachain.dev/polaris/eth/log"
 	"pkg.berachain.dev/polaris/eth/polar"
+	"pkg.berachain.dev/polaris/lib/utils"
 )
 
 type Keeper struct {
 	// ak is the reference to the AccountKeeper.
-	ak state.AccountKeeper
+	ak AccountKeeper
 	// bk is the reference to the BankKeeper.
 	bk state.BankKeeper
+	// sk is the reference to the StakingKeeper.
+	sk StakingKeeper
 	// provider is the struct that houses the Polaris EVM.
 	polaris *polar.Polaris
 	// The (unexposed) key used to access the store from the Context.
 	storeKey storetypes.StoreKey
 	// The host contains various plugins that are are used to implement `core.PolarisHostChain`.
 	host Host
+	// txPool is the Ethereum transaction mempool.
+	txPool *mempool.EthTxPool
+	// authority is the address allowed to update the params, i.e. the governance module account.
+	authority string
+	// qc returns the query context at a historical height.
+	qc func(height int64, prove bool) (sdk.Context, error)
+	// settlement holds the bank settlement configuration shared by the per-tx bank managers.
+	settlement *bank.Settlement
+
+	// daCostPerByte is the data availability cost per serialized tx byte, nil if disabled.
+	daCostPerByte *big.Int
+	// testMode enables helpers that are only meant to be used by test harnesses.
+	testMode bool
 
 	// temp syncing
 	lock bool
@@ -57,10 +77,11 @@ type Keeper struct {
 
 // NewKeeper creates new instances of the polaris Keeper.
 func NewKeeper(
-	ak state.AccountKeeper,
+	ak AccountKeeper,
 	bk state.BankKeeper,
-	sk block.StakingKeeper,
+	sk StakingKeeper,
 	storeKey storetypes.StoreKey,
+	tkey storetypes.StoreKey,
 	ethTxMempool sdkmempool.Mempool,
 	pcs func() *ethprecompile.Injector,
 ) *Keeper {
@@ -68,9 +89,16 @@ func NewKeeper(
 	k := &Keeper{
 		ak:       ak,
 		bk:       bk,
+		sk:       sk,
 		storeKey: storeKey,
-		lock:     true,
+		authority: sdk.AccAddress(
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+		).String(),
+		txPool:     utils.MustGetAs[*mempool.EthTxPool](ethTxMempool),
+		settlement: bank.NewSettlement(),
+		lock:       true,
 	}
+	k.settlement.SetTransientStoreKey(tkey)
 
 	k.host = NewHost(
 		storeKey,
@@ -91,6 +119,8 @@ func (k *Keeper) Setup(
 ) {
 	// Setup plugins in the Host
 	k.host.Setup(k.storeKey, nil, k.ak, k.bk, qc)
+	utils.MustGetAs[state.Plugin](k.host.GetStatePlugin()).SetSettlement(k.settlement)
+	k.qc = qc
 
 	// Build the Polaris EVM Provider
 	cfg, err := polar.LoadConfigFromFilePath(polarisConfigPath)
@@ -140,6 +170,89 @@ func (k *Keeper) GetPolaris() *polar.Polaris {
 	return k.polaris
 }
 
+// ModulePermissions returns the permissions of the EVM module account, which must include
+// "minter" and "burner" for balance changes to be committed to the bank module. It returns nil if
+// the module account is not registered.
+func (k *Keeper) ModulePermissions(ctx sdk.Context) []string {
+	acc := k.ak.GetModuleAccount(ctx, types.ModuleName)
+	if acc == nil {
+		k.Logger(ctx).Error("evm module account is not registered")
+		return nil
+	}
+	return acc.GetPermissions()
+}
+
+// StakingDenom returns the bond denom of the staking module, which is the native denom of the
+// chain. It returns an empty string if the staking params cannot be read.
+func (k *Keeper) StakingDenom(ctx sdk.Context) string {
+	denom, err := k.sk.BondDenom(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to read staking bond denom", "err", err)
+		return ""
+	}
+	return denom
+}
+
+// SetTestMode enables or disables the keeper helpers that are only meant for test harnesses.
+func (k *Keeper) SetTestMode(enabled bool) {
+	k.testMode = enabled
+}
+
+// FlushBankChanges commits the pending EVM balance changes of the state plugin to the bank module
+// on demand, instead of waiting for the block-boundary commit. It is only available in test mode.
+func (k *Keeper) FlushBankChanges(ctx sdk.Context) error {
+	if !k.testMode {
+		return ErrNotTestMode
+	}
+	if err := utils.MustGetAs[state.Plugin](k.host.GetStatePlugin()).FlushBankChanges(); err != nil {
+		return err
+	}
+	k.Logger(ctx).Debug("flushed pending bank changes")
+	return nil
+}
+
+// RegisterCommitHook registers a hook that is invoked with the balance changes every tx settles
+// to the bank module. Hooks run in registration order on a cache of the context that is discarded
+// if the hook panics.
+func (k *Keeper) RegisterCommitHook(hook bank.CommitHook) {
+	k.settlement.RegisterCommitHook(hook)
+}
+
+// SetMaxAddressesPerBlock caps the number of distinct addresses whose EVM balance changes a single
+// block may settle to the bank module. A tx that exceeds the cap fails without applying any of its
+// state changes. Zero, the default, is unlimited.
+func (k *Keeper) SetMaxAddressesPerBlock(maxAddresses int) {
+	k.settlement.SetMaxAddressesPerBlock(maxAddresses)
+}
+
+// SetReconcileMode toggles whether settling the EVM balance changes to the bank module clamps a
+// burn to the account's bank balance, instead of failing the tx, when the account was drained by
+// native bank activity after the EVM observed its balance.
+func (k *Keeper) SetReconcileMode(enabled bool) {
+	k.settlement.SetReconcileMode(enabled)
+}
+
+// SetSettlementEventMode selects how the EVM balance changes settled to the bank module are
+// reported as events, either per tx and address or aggregated at the end of the block. Defaults to
+// no events.
+func (k *Keeper) SetSettlementEventMode(mode bank.SettlementEventMode) {
+	k.settlement.SetSettlementEventMode(mode)
+}
+
+// Reconcile compares the balances the EVM observed for the accounts with pending balance changes
+// against their bank balances in the given context, e.g. after native bank activity touched a
+// bridged account. Discrepancies are logged and, if correct is set, fixed by
+// rebasing the EVM balance onto the bank balance. It returns the number of discrepancies.
+func (k *Keeper) Reconcile(ctx sdk.Context, correct bool) (int, error) {
+	sp := utils.MustGetAs[state.Plugin](k.host.GetStatePlugin())
+	count, err := sp.ReconcileBank(ctx, correct)
+	if err != nil {
+		return count, err
+	}
+	k.Logger(ctx).Info("reconciled evm balances", "discrepancies", count, "corrected", correct)
+	return count, nil
+}
+
 func (k *Keeper) SetClientCtx(clientContext client.Context) {
 	k.host.GetTxPoolPlugin().(txpool.Plugin).SetClientContext(clientContext)
 	// TODO: move this
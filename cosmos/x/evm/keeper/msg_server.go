@@ -25,6 +25,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 )
 
@@ -54,3 +56,28 @@ func (k *Keeper) EthTransaction(
 		ReturnData: result.ReturnData,
 	}, nil
 }
+
+// UpdateParams implements the MsgServiceServer interface. It sets the x/evm params on behalf of
+// the authority, which must be the governance module account. Params that are fixed at genesis
+// cannot be changed.
+func (k *Keeper) UpdateParams(
+	ctx context.Context, msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(
+			ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority,
+		)
+	}
+	if msg.Params == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, "params must be supplied")
+	}
+
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	if err := checkParamsUpdate(k.GetParams(sCtx), *msg.Params); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(sCtx, *msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// GetParams returns the x/evm params, or the default params if none are stored.
+func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
+	return configuration.LoadParams(ctx.KVStore(k.storeKey))
+}
+
+// SetParams validates and stores the given x/evm params.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	configuration.StoreParams(ctx.KVStore(k.storeKey), params)
+	return nil
+}
+
+// checkParamsUpdate returns an error if the given params change a param that is fixed at genesis.
+func checkParamsUpdate(old, params types.Params) error {
+	if params.EvmDenom != old.EvmDenom {
+		return errorslib.Wrapf(
+			ErrImmutableParam, "evm denom cannot change from %s to %s", old.EvmDenom, params.EvmDenom,
+		)
+	}
+	if params.ExtraDecimals != old.ExtraDecimals {
+		return errorslib.Wrapf(
+			ErrImmutableParam, "extra decimals cannot change from %d to %d",
+			old.ExtraDecimals, params.ExtraDecimals,
+		)
+	}
+	return nil
+}
+
+// GetAuthority returns the address allowed to update the x/evm params.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Params", func() {
+	var (
+		k   *keeper.Keeper
+		bk  bankkeeper.BaseKeeper
+		ctx sdk.Context
+		gov = sdk.AccAddress(authtypes.NewModuleAddress(govtypes.ModuleName)).String()
+		// the params of a chain that never stored params.
+		legacy = evmtypes.Params{EvmDenom: evmtypes.LegacyEvmDenom}
+	)
+
+	BeforeEach(func() {
+		ctx, k, bk, _ = setupKeeperWith(evmmempool.NewPolarisEthereumTxPool(), nil)
+	})
+
+	It("should default to umito", func() {
+		Expect(k.GetParams(ctx)).To(Equal(legacy))
+		Expect(k.GetParams(ctx).EvmDenom).To(Equal("umito"))
+	})
+
+	It("should only let the governance module update the params", func() {
+		params := legacy
+		Expect(k.GetAuthority()).To(Equal(gov))
+		_, err := k.UpdateParams(ctx, &evmtypes.MsgUpdateParams{
+			Authority: sdk.AccAddress(testutil.Alice.Bytes()).String(),
+			Params:    &params,
+		})
+		Expect(err).To(MatchError(keeper.ErrUnauthorized))
+		_, err = k.UpdateParams(ctx, &evmtypes.MsgUpdateParams{Authority: gov})
+		Expect(err).To(MatchError(evmtypes.ErrInvalidParams))
+
+		_, err = k.UpdateParams(ctx, &evmtypes.MsgUpdateParams{Authority: gov, Params: &params})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k.GetParams(ctx)).To(Equal(params))
+	})
+
+	It("should not let the evm denom or its decimals change after genesis", func() {
+		for _, params := range []evmtypes.Params{
+			{EvmDenom: "abera"},
+			{EvmDenom: evmtypes.LegacyEvmDenom, ExtraDecimals: 12},
+		} {
+			params := params
+			_, err := k.UpdateParams(ctx, &evmtypes.MsgUpdateParams{
+				Authority: gov,
+				Params:    &params,
+			})
+			Expect(err).To(MatchError(keeper.ErrImmutableParam))
+		}
+		Expect(k.GetParams(ctx)).To(Equal(legacy))
+	})
+
+	It("should only write the params with the given context", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		Expect(k.SetParams(cacheCtx, evmtypes.Params{EvmDenom: "abera"})).To(Succeed())
+		Expect(k.GetParams(cacheCtx).EvmDenom).To(Equal("abera"))
+		Expect(k.GetParams(ctx)).To(Equal(legacy))
+
+		// the configuration plugin of the host keeps reading the params with its own context
+		cp := utils.MustGetAs[configuration.Plugin](k.GetHost().GetConfigurationPlugin())
+		Expect(cp.Params()).To(Equal(legacy))
+	})
+
+	It("should read EVM balances in the configured denom", func() {
+		coins := sdk.NewCoins(sdk.NewCoin("abera", sdkmath.NewInt(42)))
+		Expect(bk.MintCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+		Expect(bk.SendCoinsFromModuleToAccount(
+			ctx, evmtypes.ModuleName, testutil.Alice.Bytes(), coins,
+		)).To(Succeed())
+
+		balance, err := k.BalanceByTag(ctx, testutil.Alice, "latest")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance.Sign()).To(BeZero())
+
+		Expect(k.SetParams(ctx, evmtypes.Params{EvmDenom: "abera"})).To(Succeed())
+		balance, err = k.BalanceByTag(ctx, testutil.Alice, "latest")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(big.NewInt(42)))
+	})
+})
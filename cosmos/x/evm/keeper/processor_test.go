@@ -90,6 +90,7 @@ var _ = Describe("Processor", func() {
 		k = keeper.NewKeeper(
 			ak, sk,
 			storetypes.NewKVStoreKey("evm"),
+			storetypes.NewTransientStoreKey("transient_evm"),
 			evmmempool.NewPolarisEthereumTxPool(),
 			func() *ethprecompile.Injector {
 				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{sc}...)
@@ -106,7 +107,7 @@ var _ = Describe("Processor", func() {
 		Expect(err).ToNot(HaveOccurred())
 		validator.Status = stakingtypes.Bonded
 		Expect(sk.SetValidator(ctx, validator)).To(Succeed())
-		sc = staking.NewPrecompileContract(ak, &sk)
+		sc = staking.NewPrecompileContract(ak, &sk, nil)
 		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger())
 		_ = sk.SetParams(ctx, stakingtypes.DefaultParams())
 
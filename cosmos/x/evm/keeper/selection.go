@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// SelectForBlock returns the executable txs in the mempool that fit in the given gas budget, in
+// priority order. Txs are picked greedily; a tx whose gas limit exceeds the remaining budget is
+// skipped, along with the later txs of its sender, which can no longer execute in nonce order.
+func (k *Keeper) SelectForBlock(ctx sdk.Context, gasBudget uint64) ([]*coretypes.Transaction, error) {
+	nextNonces := make(map[common.Address]uint64)
+	blocked := make(map[common.Address]struct{})
+	selected := make([]*coretypes.Transaction, 0)
+
+	remaining := gasBudget
+	for iter := k.txPool.Select(ctx, nil); iter != nil && remaining > 0; iter = iter.Next() {
+		ethTx := evmtypes.GetAsEthTx(iter.Tx())
+		if ethTx == nil {
+			continue
+		}
+
+		sender := coretypes.GetSender(ethTx)
+		if _, ok := blocked[sender]; ok {
+			continue
+		}
+		nextNonce, ok := nextNonces[sender]
+		if !ok {
+			nextNonce = k.committedNonce(ctx, sender)
+		}
+
+		// A stale nonce has already been used and can be skipped on its own, whereas a nonce gap
+		// makes this and all later txs of the sender non-executable.
+		if ethTx.Nonce() < nextNonce {
+			continue
+		}
+		if ethTx.Nonce() > nextNonce || ethTx.Gas() > remaining {
+			blocked[sender] = struct{}{}
+			continue
+		}
+
+		selected = append(selected, ethTx)
+		nextNonces[sender] = nextNonce + 1
+		remaining -= ethTx.Gas()
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SelectForBlock", func() {
+	var (
+		k          *keeper.Keeper
+		etp        *evmmempool.EthTxPool
+		ctx        sdk.Context
+		aliceKey   *ecdsa.PrivateKey
+		bobKey     *ecdsa.PrivateKey
+		aliceFirst common.Hash
+		aliceNext  common.Hash
+		bobFirst   common.Hash
+	)
+
+	insert := func(key *ecdsa.PrivateKey, nonce, gas uint64, gasPrice int64) common.Hash {
+		tx := buildSdkEthTx(key, &coretypes.LegacyTx{
+			Nonce: nonce, To: &common.Address{0x2}, Value: big.NewInt(1), Gas: gas, GasPrice: big.NewInt(gasPrice),
+		})
+		Expect(etp.Insert(ctx, tx)).To(Succeed())
+		return evmtypes.GetAsEthTx(tx).Hash()
+	}
+
+	hashes := func(txs []*coretypes.Transaction) []common.Hash {
+		res := make([]common.Hash, len(txs))
+		for i, tx := range txs {
+			res[i] = tx.Hash()
+		}
+		return res
+	}
+
+	BeforeEach(func() {
+		etp = evmmempool.NewPolarisEthereumTxPool()
+		ctx, k, _, _ = setupKeeperWith(etp, nil)
+		k.GetHost().GetStatePlugin().Reset(ctx)
+		aliceKey, _ = crypto.GenerateEthKey()
+		bobKey, _ = crypto.GenerateEthKey()
+
+		// Alice pays more than Bob, Bob's nonce 2 tx is queued behind a gap.
+		aliceFirst = insert(aliceKey, 0, 21000, 10)
+		aliceNext = insert(aliceKey, 1, 50000, 10)
+		bobFirst = insert(bobKey, 0, 21000, 5)
+		insert(bobKey, 2, 21000, 20)
+	})
+
+	It("should select the executable txs in priority order", func() {
+		txs, err := k.SelectForBlock(ctx, 100000)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hashes(txs)).To(Equal([]common.Hash{aliceFirst, aliceNext, bobFirst}))
+	})
+
+	It("should skip txs that exceed the remaining budget", func() {
+		txs, err := k.SelectForBlock(ctx, 60000)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hashes(txs)).To(Equal([]common.Hash{aliceFirst, bobFirst}))
+	})
+
+	It("should select nothing without budget", func() {
+		txs, err := k.SelectForBlock(ctx, 20000)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(txs).To(BeEmpty())
+	})
+})
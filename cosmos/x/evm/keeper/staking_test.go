@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Keeper", func() {
+	var (
+		k   *keeper.Keeper
+		sk  stakingkeeper.Keeper
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx, k, _, sk = setupKeeper()
+	})
+
+	When("StakingDenom", func() {
+		It("should match the staking params", func() {
+			params, err := sk.GetParams(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(k.StakingDenom(ctx)).To(Equal(params.BondDenom))
+
+			params.BondDenom = "umito"
+			Expect(sk.SetParams(ctx, params)).To(Succeed())
+			Expect(k.StakingDenom(ctx)).To(Equal("umito"))
+		})
+	})
+
+	When("ModulePermissions", func() {
+		It("should report the configured minter and burner permissions", func() {
+			Expect(k.ModulePermissions(ctx)).To(ContainElements("minter", "burner"))
+		})
+	})
+})
@@ -29,6 +29,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/eth/params"
@@ -40,6 +41,9 @@ type Plugin interface {
 	plugins.HasGenesis
 	core.ConfigurationPlugin
 	SetChainConfig(*params.ChainConfig)
+	Params() types.Params
+	SetParams(types.Params)
+	GetEvmDenom() string
 }
 
 // plugin implements the core.ConfigurationPlugin interface.
@@ -26,6 +26,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -79,4 +80,17 @@ var _ = Describe("Plugin", func() {
 			// })
 		})
 	})
+
+	Describe("Params", func() {
+		It("should return the legacy params when none are stored", func() {
+			Expect(p.Params()).To(Equal(types.Params{EvmDenom: types.LegacyEvmDenom}))
+			Expect(p.GetEvmDenom()).To(Equal(types.LegacyEvmDenom))
+		})
+
+		It("should return the stored params", func() {
+			p.SetParams(types.Params{EvmDenom: "abera"})
+			Expect(p.GetEvmDenom()).To(Equal("abera"))
+			Expect(LoadParams(p.paramsStore)).To(Equal(types.Params{EvmDenom: "abera"}))
+		})
+	})
 })
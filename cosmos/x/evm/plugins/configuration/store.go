@@ -23,6 +23,8 @@ package configuration
 import (
 	"encoding/json"
 
+	storetypes "cosmossdk.io/store/types"
+
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/params"
 	"pkg.berachain.dev/polaris/lib/encoding"
@@ -45,3 +47,38 @@ func (p *plugin) SetChainConfig(chainConfig *params.ChainConfig) {
 	}
 	p.paramsStore.Set([]byte{types.ChainConfigPrefix}, bz)
 }
+
+// Params returns the x/evm params, or the default params if none are stored.
+func (p *plugin) Params() types.Params {
+	return LoadParams(p.paramsStore)
+}
+
+// SetParams stores the given x/evm params.
+func (p *plugin) SetParams(params types.Params) {
+	StoreParams(p.paramsStore, params)
+}
+
+// GetEvmDenom returns the bank denom that backs EVM balances.
+func (p *plugin) GetEvmDenom() string {
+	return p.Params().EvmDenom
+}
+
+// LoadParams reads the x/evm params from the given evm store, or returns the default params if
+// none are stored. It is used by the plugins that need the params outside of `Prepare`.
+func LoadParams(store storetypes.KVStore) types.Params {
+	params, err := types.ParamsFromBytes(store.Get([]byte{types.ParamsKey}))
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// StoreParams writes the given x/evm params to the given evm store. It is used to write the params
+// outside of `Prepare`, e.g. by the keeper.
+func StoreParams(store storetypes.KVStore, params types.Params) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte{types.ParamsKey}, bz)
+}
@@ -20,11 +20,24 @@
 
 package precompile
 
-import storetypes "cosmossdk.io/store/types"
+import (
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
 
 type (
 	StatePlugin interface {
 		SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
+		// SettleCredit settles the pending credit of the given amount to the given address into
+		// the bank module right away.
+		SettleCredit(common.Address, *big.Int) error
+		// SyncBalance rebases the pending balance of the given address onto its bank balance.
+		SyncBalance(common.Address)
+		// BankAmount returns the given EVM amount in whole units of the underlying bank denom.
+		BankAmount(*big.Int) *big.Int
 	}
 
 	MultiStore interface {
@@ -21,6 +21,8 @@
 package log
 
 import (
+	"math/big"
+	"reflect"
 	"strconv"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -33,6 +35,7 @@ import (
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/lib/errors"
 )
 
 const (
@@ -59,6 +62,57 @@ var defaultCosmosValueDecoders = precompile.ValueDecoders{
 	govtypes.AttributeKeyOption:             ReturnStringAsIs,
 }
 
+// typeValueDecoder returns the attribute value decoder function for an Ethereum event argument
+// of ABI type `argType`, which is used when no custom or default decoder is registered for the
+// attribute key. NOTE: only elementary types with an unambiguous string encoding are supported.
+func typeValueDecoder(argType abi.Type) (precompile.ValueDecoder, bool) {
+	switch argType.T {
+	case abi.StringTy:
+		return ReturnStringAsIs, true
+	case abi.BoolTy:
+		return ConvertBool, true
+	case abi.AddressTy:
+		return ConvertStrictHexAddress, true
+	case abi.IntTy, abi.UintTy:
+		return integerValueDecoder(argType), true
+	default:
+		return nil, false
+	}
+}
+
+// integerValueDecoder returns an attribute value decoder function that converts a base 10 integer
+// `string` to the Go type of the ABI integer type `argType`, e.g. `uint64` or `*big.Int`.
+func integerValueDecoder(argType abi.Type) precompile.ValueDecoder {
+	return func(attributeValue string) (any, error) {
+		value, ok := new(big.Int).SetString(attributeValue, intBase)
+		if !ok || !fitsIntegerType(value, argType) {
+			return nil, errors.Wrap(ErrInvalidAttributeValue, attributeValue)
+		}
+
+		goType := argType.GetType()
+		switch goType.Kind() {
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return reflect.ValueOf(value.Uint64()).Convert(goType).Interface(), nil
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return reflect.ValueOf(value.Int64()).Convert(goType).Interface(), nil
+		default:
+			return value, nil
+		}
+	}
+}
+
+// fitsIntegerType returns true if `value` is in the range of the ABI integer type `argType`.
+func fitsIntegerType(value *big.Int, argType abi.Type) bool {
+	if argType.T == abi.UintTy {
+		return value.Sign() >= 0 && value.BitLen() <= argType.Size
+	}
+	// the magnitude of a signed integer is bounded by 2^(size-1), using -x-1 for negative x
+	if value.Sign() < 0 {
+		value = new(big.Int).Not(value)
+	}
+	return value.BitLen() < argType.Size
+}
+
 // ==============================================================================
 // Default Attribute Value Decoder Functions
 // ==============================================================================
@@ -71,6 +125,8 @@ var (
 	_ precompile.ValueDecoder = ConvertUint64
 	_ precompile.ValueDecoder = ReturnStringAsIs
 	_ precompile.ValueDecoder = ConvertCommonHexAddress
+	_ precompile.ValueDecoder = ConvertStrictHexAddress
+	_ precompile.ValueDecoder = ConvertBool
 )
 
 // ConvertSdkCoins converts the string representation of an `sdk.Coins` to a `[]generated.CosmosCoin`.
@@ -118,6 +174,25 @@ func ConvertCommonHexAddress(attributeValue string) (any, error) {
 	return common.HexToAddress(attributeValue), nil
 }
 
+// ConvertStrictHexAddress converts a common hex address attribute to a common.Address and returns
+// it as type any. Unlike `ConvertCommonHexAddress`, it returns an error if the attribute is not a
+// hex address.
+//
+// ConvertStrictHexAddress is a `precompile.ValueDecoder`.
+func ConvertStrictHexAddress(attributeValue string) (any, error) {
+	if !common.IsHexAddress(attributeValue) {
+		return nil, errors.Wrap(ErrInvalidAttributeValue, attributeValue)
+	}
+	return common.HexToAddress(attributeValue), nil
+}
+
+// ConvertBool converts a `string` to a `bool`.
+//
+// ConvertBool is a `precompile.ValueDecoder`.
+func ConvertBool(attributeValue string) (any, error) {
+	return strconv.ParseBool(attributeValue)
+}
+
 // ==============================================================================
 // Helpers
 // ==============================================================================
@@ -145,3 +220,15 @@ func searchAttributesForArg(attributes *[]abci.EventAttribute, argName string) i
 	// reached end of loop, `argName` not found
 	return notFound
 }
+
+// searchAttributesForKey does a linear search through the given slice `attributes` for the
+// attribute with the key `attrKey`. This function returns the index where `attrKey` was found or
+// -1 if `attrKey` was not found.
+func searchAttributesForKey(attributes *[]abci.EventAttribute, attrKey string) int {
+	for i, attribute := range *attributes {
+		if attribute.Key == attrKey {
+			return i
+		}
+	}
+	return notFound
+}
@@ -31,6 +31,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	libgenerated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	libutils "pkg.berachain.dev/polaris/lib/utils"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -105,6 +106,38 @@ var _ = Describe("Attributes", func() {
 		})
 	})
 
+	Describe("Test ABI Type Attribute Value Decoder Functions", func() {
+		It("should decode elementary types", func() {
+			uint8Type, _ := abi.NewType("uint8", "uint8", nil)
+			int64Type, _ := abi.NewType("int64", "int64", nil)
+			uint256Type, _ := abi.NewType("uint256", "uint256", nil)
+
+			decode, found := typeValueDecoder(uint8Type)
+			Expect(found).To(BeTrue())
+			Expect(decode("255")).To(Equal(uint8(255)))
+			_, err := decode("256")
+			Expect(err).To(HaveOccurred())
+			_, err = decode("-1")
+			Expect(err).To(HaveOccurred())
+
+			decode, _ = typeValueDecoder(int64Type)
+			Expect(decode("-9223372036854775808")).To(Equal(int64(-9223372036854775808)))
+			_, err = decode("9223372036854775808")
+			Expect(err).To(HaveOccurred())
+
+			decode, _ = typeValueDecoder(uint256Type)
+			Expect(decode("10")).To(Equal(big.NewInt(10)))
+			_, err = decode("ten")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not decode non elementary types", func() {
+			bytesType, _ := abi.NewType("bytes", "bytes", nil)
+			_, found := typeValueDecoder(bytesType)
+			Expect(found).To(BeFalse())
+		})
+	})
+
 	Describe("Test Search Attributes for Argument", func() {
 		var attributes = []abci.EventAttribute{
 			{Key: "k0"},
@@ -33,6 +33,9 @@ var (
 	// ErrNoValueDecoderFunc is returned when a Cosmos event's attribute key is not mapped to any
 	// attribute value decoder function.
 	ErrNoValueDecoderFunc = errors.New("no value decoder function is found for event attribute key")
+	// ErrInvalidAttributeValue is returned when a Cosmos event's attribute value cannot be
+	// decoded to the ABI type of its corresponding Ethereum event argument.
+	ErrInvalidAttributeValue = errors.New("invalid event attribute value")
 	// ErrNumberOfCoinsNotSupported is returned when the number of coins in a Cosmos event for the
 	// "amount" attribute is not equal to 1.
 	ErrNumberOfCoinsNotSupported = errors.New("number of coins not supported")
@@ -86,11 +86,23 @@ func (f *Factory) Build(event *sdk.Event) (*coretypes.Log, error) {
 // registerAllEvents registers all Ethereum events from the provided precompiles with the factory.
 func (f *Factory) registerAllEvents(precompiles []precompile.Registrable) {
 	for _, pc := range precompiles {
+		// register the events of every version of a versioned precompile
+		if vpc, isVersioned := utils.GetAs[*precompile.Versioned](pc); isVersioned {
+			f.registerAllEvents(vpc.Versions())
+			continue
+		}
+
 		if spc, ok := utils.GetAs[precompile.StatefulImpl](pc); ok {
+			// get the precompile's Cosmos event mappings, if any are provided
+			var mappings precompile.EventMappings
+			if empc, isMapped := utils.GetAs[precompile.EventMappedImpl](pc); isMapped {
+				mappings = empc.EventMappings()
+			}
+
 			// register the ABI Event as a precompile log
 			moduleEthAddr := spc.RegistryKey()
-			for _, event := range spc.ABIEvents() {
-				_ = f.events.Register(newPrecompileLog(moduleEthAddr, event))
+			for name, event := range spc.ABIEvents() {
+				_ = f.events.Register(newPrecompileLog(moduleEthAddr, event, mappings[name]))
 			}
 
 			// register the precompile's custom value decoders, if any are provided
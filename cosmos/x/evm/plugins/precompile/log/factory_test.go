@@ -31,6 +31,7 @@ import (
 
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/precompile"
@@ -160,6 +161,77 @@ var _ = Describe("Factory", func() {
 		})
 	})
 
+	When("building Eth logs with event mappings", func() {
+		It("should translate the mapped Cosmos event type and attribute keys", func() {
+			pc.RegistryKeyFunc = func() common.Address {
+				return common.BytesToAddress([]byte{0x04})
+			}
+			pc.ABIEventsFunc = mockTypedAbiEvent
+			pc.CustomValueDecodersFunc = func() precompile.ValueDecoders {
+				return nil
+			}
+			f = NewFactory([]precompile.Registrable{&mockEventMapped{
+				StatefulImplMock: pc,
+				mappings: precompile.EventMappings{
+					"Typed": {
+						CosmosType: "typed_event",
+						Attributes: map[string]string{"sender": "from"},
+						ValueDecoders: precompile.ValueDecoders{
+							"memo": func(val string) (any, error) {
+								return "memo: " + val, nil
+							},
+						},
+					},
+				},
+			}})
+
+			sender := common.BytesToAddress([]byte("alice"))
+			event := sdk.NewEvent(
+				"typed_event",
+				sdk.NewAttribute("from", sender.Hex()),
+				sdk.NewAttribute("count", "42"),
+				sdk.NewAttribute("ok", "true"),
+				sdk.NewAttribute("memo", "hi"),
+			)
+			log, err := f.Build(&event)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(log.Address).To(Equal(common.BytesToAddress([]byte{0x04})))
+			Expect(log.Topics).To(HaveLen(2))
+			Expect(log.Topics[1]).To(Equal(common.BytesToHash(sender.Bytes())))
+			packedData, err := mockTypedAbiEvent()["Typed"].Inputs.NonIndexed().Pack(
+				uint64(42), true, "memo: hi",
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(log.Data).To(Equal(packedData))
+
+			// the unmapped event type is not registered
+			event.Type = "typed"
+			_, err = f.Build(&event)
+			Expect(err).To(MatchError(events.ErrEthEventNotRegistered))
+		})
+
+		It("should error on attribute values not matching the ABI type", func() {
+			pc.RegistryKeyFunc = func() common.Address {
+				return common.BytesToAddress([]byte{0x04})
+			}
+			pc.ABIEventsFunc = mockTypedAbiEvent
+			pc.CustomValueDecodersFunc = func() precompile.ValueDecoders {
+				return nil
+			}
+			f = NewFactory([]precompile.Registrable{pc})
+
+			event := sdk.NewEvent(
+				"typed",
+				sdk.NewAttribute("sender", common.BytesToAddress([]byte("alice")).Hex()),
+				sdk.NewAttribute("count", "-1"),
+				sdk.NewAttribute("ok", "true"),
+				sdk.NewAttribute("memo", "hi"),
+			)
+			_, err := f.Build(&event)
+			Expect(err).To(MatchError(ContainSubstring(ErrInvalidAttributeValue.Error())))
+		})
+	})
+
 	When("building invalid Cosmos events", func() {
 		It("should not find the custom value decoder", func() {
 			pc.RegistryKeyFunc = func() common.Address {
@@ -352,3 +424,32 @@ func mockBadAbiEvent() map[string]abi.Event {
 		),
 	}
 }
+
+type mockEventMapped struct {
+	*mock.StatefulImplMock
+	mappings precompile.EventMappings
+}
+
+func (m *mockEventMapped) EventMappings() precompile.EventMappings {
+	return m.mappings
+}
+
+func mockTypedAbiEvent() map[string]abi.Event {
+	addrType, _ := abi.NewType("address", "address", nil)
+	uint64Type, _ := abi.NewType("uint64", "uint64", nil)
+	boolType, _ := abi.NewType("bool", "bool", nil)
+	stringType, _ := abi.NewType("string", "string", nil)
+	return map[string]abi.Event{
+		"Typed": abi.NewEvent(
+			"Typed",
+			"Typed",
+			false,
+			abi.Arguments{
+				{Name: "sender", Type: addrType, Indexed: true},
+				{Name: "count", Type: uint64Type, Indexed: false},
+				{Name: "ok", Type: boolType, Indexed: false},
+				{Name: "memo", Type: stringType, Indexed: false},
+			},
+		),
+	}
+}
@@ -23,6 +23,7 @@ package log
 import (
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
 	libtypes "pkg.berachain.dev/polaris/lib/types"
 )
 
@@ -42,17 +43,30 @@ type precompileLog struct {
 	indexedInputs abi.Arguments
 	// nonIndexedInputs holds an Ethereum event's non-indexed arguments, emitted as event data.
 	nonIndexedInputs abi.Arguments
+	// attributeKeys maps Ethereum event argument names to the Cosmos event attribute keys they
+	// are translated from, if they are not the argument names in snake case.
+	attributeKeys map[string]string
+	// valueDecoders holds the attribute value decoder functions that are only used for this log.
+	valueDecoders precompile.ValueDecoders
 }
 
-// newPrecompileLog returns a new `precompileLog` with the given `precompileAddress` and
-// abiEvent. It separates the indexed and non-indexed arguments of the event.
-func newPrecompileLog(precompileAddr common.Address, abiEvent abi.Event) *precompileLog {
+// newPrecompileLog returns a new `precompileLog` with the given `precompileAddress`, abiEvent and
+// Cosmos event mapping. It separates the indexed and non-indexed arguments of the event.
+func newPrecompileLog(
+	precompileAddr common.Address, abiEvent abi.Event, mapping precompile.EventMapping,
+) *precompileLog {
+	eventType := mapping.CosmosType
+	if eventType == "" {
+		eventType = abi.ToUnderScore(abiEvent.Name)
+	}
 	return &precompileLog{
-		eventType:        abi.ToUnderScore(abiEvent.Name),
+		eventType:        eventType,
 		precompileAddr:   precompileAddr,
 		id:               abiEvent.ID,
 		indexedInputs:    abi.GetIndexed(abiEvent.Inputs),
 		nonIndexedInputs: abiEvent.Inputs.NonIndexed(),
+		attributeKeys:    mapping.Attributes,
+		valueDecoders:    mapping.ValueDecoders,
 	}
 }
 
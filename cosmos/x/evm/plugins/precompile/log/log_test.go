@@ -25,6 +25,7 @@ import (
 
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/crypto"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -40,7 +41,9 @@ var _ = Describe("precompileLog", func() {
 	It("should properly create a new precompile log", func() {
 		var pl *precompileLog
 		Expect(func() {
-			pl = newPrecompileLog(common.BytesToAddress([]byte{1}), mockDefaultAbiEvent())
+			pl = newPrecompileLog(
+				common.BytesToAddress([]byte{1}), mockDefaultAbiEvent(), precompile.EventMapping{},
+			)
 		}).ToNot(Panic())
 		Expect(pl.RegistryKey()).To(Equal("cancel_unbonding_delegation"))
 		Expect(pl.id).To(Equal(crypto.Keccak256Hash(
@@ -50,6 +53,15 @@ var _ = Describe("precompileLog", func() {
 		Expect(pl.indexedInputs).To(HaveLen(1))
 		Expect(pl.nonIndexedInputs).To(HaveLen(2))
 	})
+
+	It("should use the Cosmos event type of the event mapping", func() {
+		pl := newPrecompileLog(
+			common.BytesToAddress([]byte{1}),
+			mockDefaultAbiEvent(),
+			precompile.EventMapping{CosmosType: "cancel_unbond"},
+		)
+		Expect(pl.RegistryKey()).To(Equal("cancel_unbond"))
+	})
 })
 
 // MOCKS BELOW.
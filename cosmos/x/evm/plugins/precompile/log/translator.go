@@ -43,19 +43,8 @@ func (f *Factory) makeTopics(pl *precompileLog, event *sdk.Event) ([]common.Hash
 	// for each Ethereum indexed argument, get the corresponding Cosmos event attribute and
 	// convert to a geth compatible type. NOTE: this iteration has total complexity O(M), where
 	// M = average length of atrribute key strings, as length of `indexedInputs` <= 3.
-	for i, arg := range pl.indexedInputs {
-		attrIdx := searchAttributesForArg(&event.Attributes, arg.Name)
-		if attrIdx == notFound {
-			return nil, errors.Wrap(ErrNoAttributeKeyFound, arg.Name)
-		}
-
-		// convert attribute value (string) to geth compatible type
-		attr := &event.Attributes[attrIdx]
-		decode, err := f.getValueDecoder(attr.Key)
-		if err != nil {
-			return nil, err
-		}
-		value, err := decode(attr.Value)
+	for i := range pl.indexedInputs {
+		value, err := f.decodeArg(pl, event, &pl.indexedInputs[i])
 		if err != nil {
 			return nil, err
 		}
@@ -80,19 +69,8 @@ func (f *Factory) makeData(pl *precompileLog, event *sdk.Event) ([]byte, error)
 	// for each Ethereum non-indexed argument, get the corresponding Cosmos event attribute and
 	// convert to a geth compatible type. NOTE: the total complexity of this iteration: O(M*N^2),
 	// where N is the # of non-indexed args, M = average length of atrribute key strings.
-	for i, arg := range pl.nonIndexedInputs {
-		attrIdx := searchAttributesForArg(&event.Attributes, arg.Name)
-		if attrIdx == notFound {
-			return nil, errors.Wrap(ErrNoAttributeKeyFound, arg.Name)
-		}
-
-		// convert attribute value (string) to geth compatible type
-		attr := event.Attributes[attrIdx]
-		decode, err := f.getValueDecoder(attr.Key)
-		if err != nil {
-			return nil, err
-		}
-		value, err := decode(attr.Value)
+	for i := range pl.nonIndexedInputs {
+		value, err := f.decodeArg(pl, event, &pl.nonIndexedInputs[i])
 		if err != nil {
 			return nil, err
 		}
@@ -107,9 +85,39 @@ func (f *Factory) makeData(pl *precompileLog, event *sdk.Event) ([]byte, error)
 	return data, nil
 }
 
+// decodeArg finds the Cosmos event attribute corresponding to the Ethereum event argument `arg`
+// and converts its value (string) to a geth compatible type.
+func (f *Factory) decodeArg(
+	pl *precompileLog, event *sdk.Event, arg *abi.Argument,
+) (any, error) {
+	var attrIdx int
+	if attrKey, found := pl.attributeKeys[arg.Name]; found {
+		attrIdx = searchAttributesForKey(&event.Attributes, attrKey)
+	} else {
+		attrIdx = searchAttributesForArg(&event.Attributes, arg.Name)
+	}
+	if attrIdx == notFound {
+		return nil, errors.Wrap(ErrNoAttributeKeyFound, arg.Name)
+	}
+
+	attr := &event.Attributes[attrIdx]
+	decode, err := f.getValueDecoder(pl, attr.Key, arg.Type)
+	if err != nil {
+		return nil, err
+	}
+	return decode(attr.Value)
+}
+
 // getValueDecoder returns an attribute value decoder function for a certain Cosmos event
-// attribute key.
-func (f *Factory) getValueDecoder(attrKey string) (precompile.ValueDecoder, error) {
+// attribute key of the given precompile log, which is translated to the ABI type `argType`.
+func (f *Factory) getValueDecoder(
+	pl *precompileLog, attrKey string, argType abi.Type,
+) (precompile.ValueDecoder, error) {
+	// try the event's own attributes
+	if eventDecoder, found := pl.valueDecoders[attrKey]; found {
+		return eventDecoder, nil
+	}
+
 	// try custom precompile event attributes
 	if customDecoder, found := f.customValueDecoders[attrKey]; found {
 		return customDecoder, nil
@@ -120,6 +128,11 @@ func (f *Factory) getValueDecoder(attrKey string) (precompile.ValueDecoder, erro
 		return defaultDecoder, nil
 	}
 
+	// try decoding by the ABI type of the Ethereum event argument
+	if typeDecoder, found := typeValueDecoder(argType); found {
+		return typeDecoder, nil
+	}
+
 	// no value decoder function was found for attribute key
 	return nil, errors.Wrap(ErrNoValueDecoderFunc, attrKey)
 }
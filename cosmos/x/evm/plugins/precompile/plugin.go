@@ -21,13 +21,16 @@
 package precompile
 
 import (
+	"errors"
 	"math/big"
 
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cosmosprecompile "pkg.berachain.dev/polaris/cosmos/precompile"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
@@ -39,6 +42,18 @@ import (
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
+// ErrPrecompileDisabled is returned when a gated precompile is called before it is enabled in the
+// x/evm params.
+var ErrPrecompileDisabled = errors.New("precompile is not enabled")
+
+// ErrCallerNotAllowed is returned when a precompile method is called by a caller that is not
+// allowed to call it in the x/evm params.
+var ErrCallerNotAllowed = errors.New("caller is not allowed to call the precompile method")
+
+// ErrReentrantCall is returned when a precompile is called again by an EVM call that it made,
+// before its own execution has returned.
+var ErrReentrantCall = errors.New("reentrant call to precompile")
+
 // Plugin is the interface that must be implemented by the plugin.
 type Plugin interface {
 	plugins.Base
@@ -55,22 +70,42 @@ type plugin struct {
 	libtypes.Registry[common.Address, vm.PrecompileContainer]
 	// precompiles is all supported precompile contracts.
 	precompiles []ethprecompile.Registrable
+	// gated is the set of precompiles that can only run once enabled in the x/evm params.
+	gated map[common.Address]struct{}
+	// storeKey is the evm store key, used to read the x/evm params.
+	storeKey storetypes.StoreKey
 	// kvGasConfig is the gas config for the KV store.
 	kvGasConfig storetypes.GasConfig
 	// transientKVGasConfig is the gas config for the transient KV store.
 	transientKVGasConfig storetypes.GasConfig
 	// sp allows resetting the context for the reentrancy into the EVM.
 	sp StatePlugin
+	// guard blocks a running precompile from being entered again through the EVM.
+	guard *reentrancyGuard
 }
 
-// NewPlugin creates and returns a plugin with the default KV store gas configs.
-func NewPlugin(precompiles []ethprecompile.Registrable, sp StatePlugin) Plugin {
+// NewPlugin creates and returns a plugin with the default KV store gas configs. The precompiles
+// at the `gated` addresses can only be run once they are enabled in the x/evm params, which are
+// read from the store of the given key.
+func NewPlugin(
+	precompiles []ethprecompile.Registrable,
+	sp StatePlugin,
+	storeKey storetypes.StoreKey,
+	gated ...common.Address,
+) Plugin {
+	gatedSet := make(map[common.Address]struct{}, len(gated))
+	for _, addr := range gated {
+		gatedSet[addr] = struct{}{}
+	}
 	return &plugin{
 		Registry:             registry.NewMap[common.Address, vm.PrecompileContainer](),
 		precompiles:          precompiles,
+		gated:                gatedSet,
+		storeKey:             storeKey,
 		kvGasConfig:          storetypes.KVGasConfig(),
 		transientKVGasConfig: storetypes.TransientGasConfig(),
 		sp:                   sp,
+		guard:                newReentrancyGuard(),
 	}
 }
 
@@ -129,6 +164,26 @@ func (p *plugin) Run(
 	ms := utils.MustGetAs[MultiStore](ctx.MultiStore())
 	cem := utils.MustGetAs[state.ControllableEventManager](ctx.EventManager())
 
+	// gated precompiles can only be run once they are enabled in the x/evm params
+	evmParams := configuration.LoadParams(ctx.KVStore(p.storeKey))
+	if _, gated := p.gated[pc.RegistryKey()]; gated &&
+		!evmParams.IsPrecompileEnabled(pc.RegistryKey()) {
+		return nil, suppliedGas, ErrPrecompileDisabled
+	}
+
+	// restricted precompile methods can only be called by their allowed callers
+	if len(input) >= ethprecompile.NumBytesMethodID &&
+		!evmParams.IsMethodCallerAllowed(pc.RegistryKey(), [4]byte(input), caller) {
+		return nil, suppliedGas, ErrCallerNotAllowed
+	}
+
+	// a precompile cannot be re-entered through the EVM calls that it makes (e.g. a bank send
+	// triggering a contract hook that sends again) until its first execution has returned
+	if !p.guard.enter(sdb, pc.RegistryKey()) {
+		return nil, suppliedGas, ErrReentrantCall
+	}
+	defer p.guard.exit(sdb, pc.RegistryKey())
+
 	// make sure the readOnly is only set if we aren't in readOnly yet, which also makes sure that
 	// the readOnly flag isn't removed for child calls (taken from geth core/vm/interepreter.go)
 	if readOnly && !ms.IsReadOnly() {
@@ -144,18 +199,53 @@ func (p *plugin) Run(
 	p.disableReentrancy(sdb)
 	defer p.enableReentrancy(sdb)
 
-	// recover from any WriteProtection or OutOfGas panic for the EVM to handle as a vm error
+	// run the version of the precompile that is active at the current block height
+	pc = ethprecompile.ActiveVersion(evm, pc)
+
+	// capture the precompile call as a call frame on the tracer, after any panic is recovered
+	endTrace := ethprecompile.Trace(evm, pc, input, caller, value, suppliedGas)
+	defer func() { endTrace(ret, suppliedGas-gasRemaining, err) }()
+
+	// run the precompile on a branch of the state, which is discarded if the precompile returns
+	// an error or panics, so that none of its Cosmos multistore writes are left behind. The branch
+	// is only taken once the value is settled (see below), as the settlement has to find the
+	// pending EVM credit on the current state.
+	snapshot := -1
+	defer func() {
+		if err != nil && snapshot >= 0 {
+			sdb.RevertToSnapshot(snapshot)
+		}
+	}()
+
+	// recover from any panic for the EVM to handle as a vm error
 	defer RecoveryHandler(&err)
 
 	// use a precompile-specific gas meter for dynamic consumption
 	gm := storetypes.NewGasMeter(suppliedGas)
 	gm.ConsumeGas(pc.RequiredGas(input), "RequiredGas")
 
-	// run the precompile container
+	// settle the value sent to a payable precompile into its bank balance, so that it can spend the
+	// value natively, and rebase its EVM balance onto what is left of it after execution. As the
+	// precompile spends the value in the bank denom, it is passed the value in whole bank units.
+	// A failed call does not need to undo the settlement, as the EVM reverts the whole call frame,
+	// including the value transfer, when the precompile returns an error.
+	if value != nil && value.Sign() > 0 {
+		if err = p.sp.SettleCredit(pc.RegistryKey(), value); err != nil {
+			return nil, gm.GasRemaining(), err
+		}
+		defer p.sp.SyncBalance(pc.RegistryKey())
+		value = p.sp.BankAmount(value)
+	}
+	snapshot = sdb.Snapshot()
+
+	// run the precompile container, which syncs the EVM balances of the accounts whose bank
+	// balances it changes through the state plugin
 	ret, err = pc.Run(
 		ctx.WithGasMeter(gm).
 			WithKVGasConfig(p.kvGasConfig).
-			WithTransientKVGasConfig(p.transientKVGasConfig),
+			WithTransientKVGasConfig(p.transientKVGasConfig).
+			WithValue(vm.ReadOnlyContextKey, ms.IsReadOnly()).
+			WithValue(cosmosprecompile.BalanceSyncerContextKey, p.sp),
 		evm,
 		input,
 		caller,
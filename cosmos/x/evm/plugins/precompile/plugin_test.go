@@ -30,10 +30,13 @@ import (
 
 	tmock "pkg.berachain.dev/polaris/cosmos/testing/types/mock"
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events/mock"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
+	ethstate "pkg.berachain.dev/polaris/eth/core/state"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/core/vm"
 	"pkg.berachain.dev/polaris/lib/utils"
@@ -52,8 +55,8 @@ var _ = Describe("plugin", func() {
 		ctx = ctx.WithEventManager(
 			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
 		)
-		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx}))
-		e = &mockEVM{nil, ctx, &mockSDB{nil, ctx, 0}}
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx: ctx}, testutil.EvmKey))
+		e = &mockEVM{nil, ctx, &mockSDB{ctx: ctx}}
 	})
 
 	It("should use correctly consume gas", func() {
@@ -67,6 +70,113 @@ var _ = Describe("plugin", func() {
 		Expect(err).To(MatchError("out of gas"))
 	})
 
+	It("should not run gated precompiles that are not enabled", func() {
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx: ctx}, testutil.EvmKey, addr))
+		_, remainingGas, err := p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).To(MatchError(ErrPrecompileDisabled))
+		Expect(remainingGas).To(Equal(uint64(30)))
+
+		// enabling the precompile in the params lets it run
+		cp := configuration.NewPlugin(testutil.EvmKey)
+		cp.Prepare(ctx)
+		params := types.DefaultParams()
+		params.EnabledPrecompiles = []string{addr.Hex()}
+		cp.SetParams(params)
+		_, remainingGas, err = p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remainingGas).To(Equal(uint64(10)))
+	})
+
+	It("should only run restricted methods for their allowed callers", func() {
+		input := []byte{0xa9, 0x05, 0x9c, 0xbb, 1}
+		cp := configuration.NewPlugin(testutil.EvmKey)
+		cp.Prepare(ctx)
+		params := types.DefaultParams()
+		params.RestrictedMethods = []*types.RestrictedMethod{{
+			Precompile:     addr.Hex(),
+			Method:         "0xa9059cbb",
+			AllowedCallers: []string{testutil.Alice.Hex()},
+		}}
+		cp.SetParams(params)
+
+		_, remainingGas, err := p.Run(e, &mockStateless{}, input, addr, new(big.Int), 30, false)
+		Expect(err).To(MatchError(ErrCallerNotAllowed))
+		Expect(remainingGas).To(Equal(uint64(30)))
+
+		_, _, err = p.Run(e, &mockStateless{}, input, testutil.Alice, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = p.Run(e, &mockStateless{}, input[1:], addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not run reentrant calls to a running precompile", func() {
+		mr := &mockReentrant{p: p}
+		_, _, err := p.Run(e, mr, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).To(MatchError(ErrReentrantCall))
+		Expect(mr.calls).To(Equal(1))
+
+		// the precompile can be run again once its first execution has returned
+		_, _, err = p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should settle the value sent to a precompile", func() {
+		sp := &mockSP{ctx: ctx}
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, sp, testutil.EvmKey))
+		_, _, err := p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sp.settled).To(BeNil())
+		Expect(sp.synced).To(BeFalse())
+
+		pc := &mockStateless{}
+		_, _, err = p.Run(e, pc, []byte{}, addr, big.NewInt(5500), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sp.settled).To(Equal(big.NewInt(5500)))
+		Expect(sp.synced).To(BeTrue())
+		// the precompile spends the value in whole bank units
+		Expect(pc.value).To(Equal(big.NewInt(5)))
+	})
+
+	It("should settle the value sent to a precompile on the state plugin", func() {
+		ctx, ak, bk, _ := testutil.SetupMinimalKeepers()
+		sp := state.NewPlugin(ak, bk, testutil.EvmKey, mock.NewPrecompileLogFactory())
+		sp.Reset(ctx)
+		sdb := ethstate.NewStateDB(sp)
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, sp, testutil.EvmKey))
+		e = &stateEVM{sdb: sdb}
+
+		// the EVM transfers the value to the precompile right before running it
+		sdb.Snapshot()
+		sdb.AddBalance(addr, big.NewInt(5))
+
+		pc := &mockStateless{}
+		_, _, err := p.Run(e, pc, []byte{}, testutil.Alice, big.NewInt(5), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pc.value).To(Equal(big.NewInt(5)))
+
+		// the value is settled into the bank balance of the precompile
+		sdkCtx := sdk.UnwrapSDKContext(sp.GetContext())
+		denom := configuration.LoadParams(sdkCtx.KVStore(testutil.EvmKey)).EvmDenom
+		Expect(bk.GetBalance(sdkCtx, addr[:], denom).Amount.Int64()).To(Equal(int64(5)))
+		Expect(sdb.GetBalance(addr)).To(Equal(big.NewInt(5)))
+	})
+
+	It("should revert a panicking precompile instead of crashing", func() {
+		sdb := e.(*mockEVM).ms
+		_, _, err := p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdb.reverted).To(BeEmpty())
+
+		Expect(func() {
+			_, _, err = p.Run(e, &mockPanicking{}, []byte{}, addr, new(big.Int), 30, false)
+		}).ToNot(Panic())
+		Expect(err).To(MatchError(vm.ErrExecutionReverted))
+		Expect(err.Error()).To(ContainSubstring("nil keeper"))
+
+		// the state is reverted to the snapshot taken right before the precompile ran
+		Expect(sdb.reverted).To(Equal([]int{1}))
+	})
+
 	It("should plug in custom gas configs", func() {
 		Expect(p.KVGasConfig().DeleteCost).To(Equal(uint64(1000)))
 		Expect(p.TransientKVGasConfig().DeleteCost).To(Equal(uint64(100)))
@@ -108,7 +218,23 @@ var (
 // MOCKS BELOW.
 
 type mockSP struct {
-	ctx sdk.Context
+	ctx     sdk.Context
+	settled *big.Int
+	synced  bool
+}
+
+// BankAmount converts with 3 extra decimals, i.e. 1 bank unit is 1000 EVM units.
+func (msp *mockSP) BankAmount(amount *big.Int) *big.Int {
+	return new(big.Int).Quo(amount, big.NewInt(1000))
+}
+
+func (msp *mockSP) SettleCredit(_ common.Address, amount *big.Int) error {
+	msp.settled = amount
+	return nil
+}
+
+func (msp *mockSP) SyncBalance(common.Address) {
+	msp.synced = true
 }
 
 func (msp *mockSP) SetGasConfig(kvg storetypes.GasConfig, tkvg storetypes.GasConfig) {
@@ -125,21 +251,48 @@ func (me *mockEVM) GetStateDB() vm.GethStateDB {
 	return me.ms
 }
 
+// stateEVM runs the precompiles on a real StateDB.
+type stateEVM struct {
+	vm.PrecompileEVM
+	sdb vm.PolarisStateDB
+}
+
+func (se *stateEVM) GetStateDB() vm.GethStateDB {
+	return se.sdb
+}
+
 type mockSDB struct {
 	vm.PolarisStateDB
-	ctx  sdk.Context
-	logs int
+	ctx       sdk.Context
+	logs      int
+	snapshots int
+	reverted  []int
+}
+
+func (ms *mockSDB) Snapshot() int {
+	ms.snapshots++
+	return ms.snapshots - 1
+}
+
+func (ms *mockSDB) RevertToSnapshot(id int) {
+	ms.reverted = append(ms.reverted, id)
 }
 
 func (ms *mockSDB) GetContext() context.Context {
 	return ms.ctx
 }
 
+func (ms *mockSDB) GetState(common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+
 func (ms *mockSDB) AddLog(*coretypes.Log) {
 	ms.logs++
 }
 
-type mockStateless struct{} // at addr 1
+type mockStateless struct { // at addr 1
+	value *big.Int
+}
 
 func (ms *mockStateless) RegistryKey() common.Address {
 	return addr
@@ -147,9 +300,10 @@ func (ms *mockStateless) RegistryKey() common.Address {
 
 func (ms *mockStateless) Run(
 	ctx context.Context, _ vm.PrecompileEVM, _ []byte,
-	_ common.Address, _ *big.Int,
+	_ common.Address, value *big.Int,
 ) ([]byte, error) {
 	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(10, "")
+	ms.value = value
 	return nil, nil
 }
 
@@ -180,3 +334,44 @@ func (msf *mockStateful) Run(
 func (msf *mockStateful) RequiredGas(_ []byte) uint64 {
 	return 1
 }
+
+type mockPanicking struct{} // at addr 1
+
+func (mp *mockPanicking) RegistryKey() common.Address {
+	return addr
+}
+
+// panics as if dereferencing a nil keeper.
+func (mp *mockPanicking) Run(
+	_ context.Context, _ vm.PrecompileEVM, _ []byte,
+	_ common.Address, _ *big.Int,
+) ([]byte, error) {
+	panic("nil keeper")
+}
+
+func (mp *mockPanicking) RequiredGas(_ []byte) uint64 {
+	return 1
+}
+
+type mockReentrant struct { // at addr 1
+	p     *plugin
+	calls int
+}
+
+func (mr *mockReentrant) RegistryKey() common.Address {
+	return addr
+}
+
+// calls back into itself, as if through a contract hook triggered by the precompile.
+func (mr *mockReentrant) Run(
+	_ context.Context, evm vm.PrecompileEVM, input []byte,
+	caller common.Address, value *big.Int,
+) ([]byte, error) {
+	mr.calls++
+	_, _, err := mr.p.Run(evm, mr, input, caller, value, 10, false)
+	return nil, err
+}
+
+func (mr *mockReentrant) RequiredGas(_ []byte) uint64 {
+	return 1
+}
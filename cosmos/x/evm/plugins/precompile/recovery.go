@@ -26,16 +26,16 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
-// RecoveryHandler is used to recover from any WriteProtection and OutOfGas panics that occur
-// during precompile execution; the handler modifies the given error to be returned to the caller.
-// Any other type of panic is propogated up to the caller.
+// RecoveryHandler is used to recover from any panic that occurs during precompile execution; the
+// handler modifies the given error to be returned to the caller. WriteProtection and OutOfGas
+// panics are returned as the corresponding vm errors, and any other panic (e.g. a nil dereference
+// in a keeper) is returned as a reverted execution, instead of crashing the node mid-block.
 func RecoveryHandler(err *error) {
 	if panicked := recover(); panicked != nil {
-		// NOTE: this only propagates an error back to the EVM if the type of the given panic
-		// is ErrWriteProtection, Cosmos ErrorOutOfGas, or Cosmos ErrorGasOverflow
 		switch {
 		case utils.Implements[error](panicked) && errors.Is(utils.MustGetAs[error](panicked), vm.ErrWriteProtection):
 			*err = vm.ErrWriteProtection
@@ -44,8 +44,9 @@ func RecoveryHandler(err *error) {
 		case utils.Implements[storetypes.ErrorOutOfGas](panicked):
 			*err = vm.ErrOutOfGas
 		default:
-			// any other type of panic value is ignored and passed up the call stack
-			panic(panicked)
+			*err = errorslib.Wrapf(
+				vm.ErrExecutionReverted, "panic occurred during precompile execution: %v", panicked,
+			)
 		}
 	}
 }
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"sync"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+)
+
+// reentrancyGuard tracks the precompiles that are currently running against each StateDB. A
+// precompile that re-enters the EVM (e.g. by dispatching a message that triggers a contract hook)
+// must not be entered again by that EVM call before its first execution has returned.
+type reentrancyGuard struct {
+	mu sync.Mutex
+	// running is the set of precompiles that are running, per StateDB.
+	running map[vm.PolarisStateDB]map[common.Address]struct{}
+}
+
+// newReentrancyGuard returns an empty reentrancy guard.
+func newReentrancyGuard() *reentrancyGuard {
+	return &reentrancyGuard{
+		running: make(map[vm.PolarisStateDB]map[common.Address]struct{}),
+	}
+}
+
+// enter marks the precompile at `pc` as running against `sdb`. It returns false if the precompile
+// is already running against `sdb`, in which case the call is reentrant and must not be run.
+func (rg *reentrancyGuard) enter(sdb vm.PolarisStateDB, pc common.Address) bool {
+	rg.mu.Lock()
+	defer rg.mu.Unlock()
+
+	running, found := rg.running[sdb]
+	if !found {
+		running = make(map[common.Address]struct{})
+		rg.running[sdb] = running
+	}
+	if _, reentrant := running[pc]; reentrant {
+		return false
+	}
+	running[pc] = struct{}{}
+	return true
+}
+
+// exit marks the precompile at `pc` as no longer running against `sdb`.
+func (rg *reentrancyGuard) exit(sdb vm.PolarisStateDB, pc common.Address) {
+	rg.mu.Lock()
+	defer rg.mu.Unlock()
+
+	delete(rg.running[sdb], pc)
+	if len(rg.running[sdb]) == 0 {
+		delete(rg.running, sdb)
+	}
+}
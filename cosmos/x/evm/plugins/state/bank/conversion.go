@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bank
+
+import (
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// SetDecimalConversion makes the manager show the bank amounts of the underlying denom with
+// `extraDecimals` more decimals to the EVM, e.g. 12 to show a 6 decimal denom in wei. The part of
+// an EVM balance below one bank unit, its dust, cannot be held by the bank module and is kept per
+// address in the store set with `SetStoreKey` instead. The sum of all dust is tracked alongside, so
+// that the EVM supply always equals the bank supply times 10^extraDecimals plus the total dust.
+func (m *Manager) SetDecimalConversion(extraDecimals uint32) {
+	if extraDecimals == 0 {
+		m.conversion = nil
+		return
+	}
+	m.conversion = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(extraDecimals)), nil)
+}
+
+// ToBankAmount returns the given EVM amount in whole bank units, rounded down.
+func (m *Manager) ToBankAmount(amount *big.Int) *big.Int {
+	if m.conversion == nil {
+		return new(big.Int).Set(amount)
+	}
+	return new(big.Int).Quo(amount, m.conversion)
+}
+
+// Dust returns the part of the EVM balance of the given address below one bank unit.
+func (m *Manager) Dust(ctx sdk.Context, addr common.Address) *big.Int {
+	if m.conversion == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(ctx.KVStore(m.storeKey).Get(dustKey(addr)))
+}
+
+// TotalDust returns the sum of the dust of all addresses.
+func (m *Manager) TotalDust(ctx sdk.Context) *big.Int {
+	if m.conversion == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(ctx.KVStore(m.storeKey).Get([]byte{evmtypes.DustTotalKey}))
+}
+
+// balanceOf returns the EVM view of the bank balance of the given address, including its dust.
+func (m *Manager) balanceOf(ctx sdk.Context, addr common.Address) *big.Int {
+	amount := m.bankKeeper.GetBalance(ctx, addr.Bytes(), m.denom).Amount.BigInt()
+	if m.conversion == nil {
+		return amount
+	}
+	amount.Mul(amount, m.conversion)
+	return amount.Add(amount, m.Dust(ctx, addr))
+}
+
+// convertChange splits the given change of an EVM balance into the change of the bank amount and
+// the new dust of the address. The dust is always in [0, 10^extraDecimals), so a debit that
+// exceeds the dust borrows a whole bank unit.
+func (m *Manager) convertChange(ctx sdk.Context, change BalanceChange) (*big.Int, *big.Int) {
+	total := new(big.Int).Add(m.Dust(ctx, change.Addr), change.Delta)
+	return new(big.Int).DivMod(total, m.conversion, new(big.Int))
+}
+
+// setDust stores the dust of the given address and updates the total dust accordingly.
+func (m *Manager) setDust(ctx sdk.Context, addr common.Address, dust *big.Int) {
+	store := ctx.KVStore(m.storeKey)
+	total := m.TotalDust(ctx)
+	total.Sub(total, m.Dust(ctx, addr)).Add(total, dust)
+
+	setOrDelete(store, dustKey(addr), dust)
+	setOrDelete(store, []byte{evmtypes.DustTotalKey}, total)
+}
+
+// setOrDelete stores the given amount under the given key, or deletes the key if it is zero.
+func setOrDelete(store storetypes.KVStore, key []byte, amount *big.Int) {
+	if amount.Sign() == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, amount.Bytes())
+}
+
+// dustKey returns the store key of the dust of the given address.
+func dustKey(addr common.Address) []byte {
+	return append([]byte{evmtypes.DustKeyPrefix}, addr.Bytes()...)
+}
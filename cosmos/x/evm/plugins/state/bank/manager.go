@@ -2,48 +2,102 @@ package bank
 
 import (
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"math/big"
 	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/lib/ds"
 	"pkg.berachain.dev/polaris/lib/ds/stack"
+	"sort"
 )
 
 const (
-	initCapacity    = 16
-	registryKey     = "bank"
-	underlyingDenom = "umito"
+	initCapacity = 16
+	registryKey  = "bank"
 )
 
-type balanceChange struct {
+const (
+	// EventTypeSettlement is emitted by `Commit` once per settled address in
+	// `SettlementEventsPerAddress` mode.
+	EventTypeSettlement = "evm_settlement"
+	// EventTypeSettlementBatch is emitted by `Settlement.EndBlock` once per block in
+	// `SettlementEventsAggregated` mode, with one attribute per address settled in the block.
+	EventTypeSettlementBatch = "evm_settlement_batch"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyDelta   = "delta"
+	AttributeKeyCount   = "count"
+)
+
+// SettlementEventMode selects how `Commit` reports the settled balance changes as events.
+type SettlementEventMode int
+
+const (
+	// SettlementEventsNone emits no settlement events.
+	SettlementEventsNone SettlementEventMode = iota
+	// SettlementEventsPerAddress emits one event per settled address with its net delta.
+	SettlementEventsPerAddress
+	// SettlementEventsAggregated emits a single event at the end of the block listing every address
+	// settled in the block and its net delta.
+	SettlementEventsAggregated
+)
+
+// BalanceChange is a single signed change to an account's balance of the underlying denom.
+type BalanceChange struct {
 	Addr  common.Address
 	Delta *big.Int
 }
 
+// CommitHook observes the balance changes applied to the bank module by a successful `Commit`.
+type CommitHook func(ctx sdk.Context, changes []BalanceChange)
+
 type state struct {
-	balanceChanges []balanceChange
+	balanceChanges []BalanceChange
 	dirtyBalances  map[common.Address]*big.Int
 }
 
 type Manager struct {
 	bankKeeper BankKeeper
+	denom      string
 	states     ds.Stack[*state]
 	readOnly   bool
+
+	// settlement holds the configuration shared with the other managers of the chain.
+	settlement *Settlement
+
+	// conversion is the number of EVM balance units per bank unit, nil if they are 1:1.
+	conversion *big.Int
+	// storeKey is the key of the store that keeps the dust of the EVM balances.
+	storeKey storetypes.StoreKey
 }
 
-func NewManager(bankKeeper BankKeeper) *Manager {
+// NewManager returns a Manager that backs the EVM balances with the given bank denom.
+func NewManager(bankKeeper BankKeeper, denom string) *Manager {
 	return &Manager{
 		bankKeeper: bankKeeper,
+		denom:      denom,
 		states:     stack.New[*state](initCapacity),
+		settlement: NewSettlement(),
 	}
 }
 
+// Denom returns the underlying bank denom of the EVM balances.
+func (m *Manager) Denom() string {
+	return m.denom
+}
+
+// SetStoreKey sets the store in which the manager keeps the dust of the EVM balances.
+func (m *Manager) SetStoreKey(storeKey storetypes.StoreKey) {
+	m.storeKey = storeKey
+}
+
 func (m *Manager) getCurState() *state {
 	if m.states.Size() == 0 {
 		m.states.Push(&state{
-			balanceChanges: []balanceChange{},
+			balanceChanges: []BalanceChange{},
 			dirtyBalances:  map[common.Address]*big.Int{},
 		})
 	}
@@ -56,7 +110,7 @@ func (m *Manager) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 	if balance != nil {
 		return balance
 	} else {
-		return m.bankKeeper.GetBalance(ctx, addr.Bytes(), underlyingDenom).Amount.BigInt()
+		return m.balanceOf(ctx, addr)
 	}
 }
 
@@ -68,13 +122,51 @@ func (m *Manager) SetBalance(ctx sdk.Context, addr common.Address, newBalance *b
 	}
 
 	curState := m.getCurState()
-	curState.balanceChanges = append(curState.balanceChanges, balanceChange{
+	curState.balanceChanges = append(curState.balanceChanges, BalanceChange{
 		Addr:  addr,
 		Delta: delta,
 	})
 	curState.dirtyBalances[addr] = newBalance
 }
 
+// ModuleBalance returns the EVM module account's balance of the underlying denom. Since `Commit`
+// burns what it takes in and sends out what it mints, it is zero unless funds are stuck.
+func (m *Manager) ModuleBalance(ctx sdk.Context) *big.Int {
+	moduleAddr := authtypes.NewModuleAddress(evmtypes.ModuleName)
+	return m.bankKeeper.GetBalance(ctx, moduleAddr, m.denom).Amount.BigInt()
+}
+
+// SetReconcileMode toggles whether `Commit` clamps a burn to the account's actual bank balance
+// when the account holds less than the pending debit.
+func (m *Manager) SetReconcileMode(enabled bool) {
+	m.settlement.SetReconcileMode(enabled)
+}
+
+// SetSettlementEventMode selects how the settlement events of the managers sharing the settlement
+// are emitted. Defaults to `SettlementEventsNone`.
+func (m *Manager) SetSettlementEventMode(mode SettlementEventMode) {
+	m.settlement.SetSettlementEventMode(mode)
+}
+
+// SetMaxAddressesPerBlock caps the number of distinct addresses the commits of a block may settle,
+// counted across all managers sharing the settlement. A commit that exceeds the cap fails without
+// applying any change. Zero, the default, is unlimited.
+func (m *Manager) SetMaxAddressesPerBlock(maxAddresses int) {
+	m.settlement.SetMaxAddressesPerBlock(maxAddresses)
+}
+
+// UseSettlement makes the manager share the given settlement, e.g. with the managers of the
+// previous txs, instead of its own.
+func (m *Manager) UseSettlement(settlement *Settlement) {
+	m.settlement = settlement
+}
+
+// RegisterCommitHook registers a hook that is invoked with the applied changes at the end of every
+// successful `Commit`. Hooks run in registration order.
+func (m *Manager) RegisterCommitHook(hook CommitHook) {
+	m.settlement.RegisterCommitHook(hook)
+}
+
 // RegistryKey implements `types.Registrable`.
 func (m *Manager) RegistryKey() string {
 	return registryKey
@@ -84,7 +176,7 @@ func (m *Manager) RegistryKey() string {
 func (m *Manager) Snapshot() int {
 	curState := m.getCurState()
 	newState := state{
-		balanceChanges: []balanceChange{},
+		balanceChanges: []BalanceChange{},
 		dirtyBalances:  map[common.Address]*big.Int{},
 	}
 	for addr, balance := range curState.dirtyBalances {
@@ -102,54 +194,243 @@ func (m *Manager) RevertToSnapshot(id int) {
 // Finalize implements `types.Finalizeable`.
 func (m *Manager) Finalize() {}
 
+// Reset drops all pending changes and snapshots, e.g. after they have been committed.
+func (m *Manager) Reset() {
+	m.states = stack.New[*state](initCapacity)
+}
+
 // Commit commits pending changes to bank module.
 func (m *Manager) Commit(ctx sdk.Context) error {
 	// TODO(thai): must consider about error happening in the middle of this function.
 
 	totalDirtyBalances := m.getCurState().dirtyBalances
+	if err := m.settlement.checkAddresses(ctx, totalDirtyBalances); err != nil {
+		return err
+	}
+
 	for addr := range totalDirtyBalances {
-		bankBalance := m.bankKeeper.GetBalance(ctx, addr.Bytes(), underlyingDenom)
+		bankBalance := m.bankKeeper.GetBalance(ctx, addr.Bytes(), m.denom)
 		ctx.Logger().Info(fmt.Sprintf("[evm->bank] BEFORE: %s: %s", addr.String(), bankBalance.String()))
 	}
 
 	count := 0
+	var applied []BalanceChange
 	for i := 0; i < m.states.Size(); i++ {
 		s := m.states.PeekAt(i)
 
 		for j, change := range s.balanceChanges {
-			switch change.Delta.Sign() {
-			case 1:
-				amount := sdk.NewCoins(sdk.NewCoin(underlyingDenom, sdkmath.NewIntFromBigInt(change.Delta)))
-				if err := m.bankKeeper.MintCoins(ctx, evmtypes.ModuleName, amount); err != nil {
-					return err
-				}
-				if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, change.Addr.Bytes(), amount); err != nil {
-					return err
-				}
-				break
-
-			case -1:
-				amount := sdk.NewCoins(sdk.NewCoin(underlyingDenom, sdkmath.NewIntFromBigInt(new(big.Int).Neg(change.Delta))))
-				if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, change.Addr.Bytes(), evmtypes.ModuleName, amount); err != nil {
-					return err
-				}
-				if err := m.bankKeeper.BurnCoins(ctx, evmtypes.ModuleName, amount); err != nil {
-					return err
-				}
-				break
-
-			default:
+			if err := m.settle(ctx, change); err != nil {
+				return err
 			}
 
+			applied = append(applied, change)
 			count++
 			ctx.Logger().Info(fmt.Sprintf("[evm->bank] CHANGE(#%d)(%d,%d): %s: %s", count, i, j, change.Addr.String(), change.Delta.String()))
 		}
 	}
 
 	for addr := range totalDirtyBalances {
-		bankBalance := m.bankKeeper.GetBalance(ctx, addr.Bytes(), underlyingDenom)
+		bankBalance := m.bankKeeper.GetBalance(ctx, addr.Bytes(), m.denom)
 		ctx.Logger().Info(fmt.Sprintf("[evm->bank] AFTER: %s: %s", addr.String(), bankBalance.String()))
 	}
 
+	m.settlement.recordAddresses(ctx, totalDirtyBalances)
+	m.settlement.emitEvents(ctx, applied)
+
+	for i, hook := range m.settlement.commitHooks {
+		m.runCommitHook(ctx, i, hook, applied)
+	}
+
+	return nil
+}
+
+// Reconcile compares the balance the EVM observed for every account with pending changes, i.e. its
+// pending balance without its pending changes, against its current bank balance. The two differ
+// when the account was changed by native bank activity after the EVM read it, in which case
+// `Commit` would not settle the account to the balance the EVM expects. Native bank activity on
+// accounts without pending changes is not a discrepancy, as the EVM reads their bank balance.
+// Discrepancies are logged and, if correct is set, fixed by rebasing the pending balance of the
+// account onto its bank balance. It returns the number of discrepancies.
+func (m *Manager) Reconcile(ctx sdk.Context, correct bool) (int, error) {
+	curState := m.getCurState()
+	addrs := make([]common.Address, 0, len(curState.dirtyBalances))
+	for addr := range curState.dirtyBalances {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Hex() < addrs[j].Hex()
+	})
+
+	count := 0
+	for _, addr := range addrs {
+		pendingDelta := m.pendingDelta(addr)
+		observed := new(big.Int).Sub(curState.dirtyBalances[addr], pendingDelta)
+		actual := m.balanceOf(ctx, addr)
+		if observed.Cmp(actual) == 0 {
+			continue
+		}
+
+		count++
+		ctx.Logger().Error(fmt.Sprintf(
+			"[evm->bank] DISCREPANCY: %s: observed %s, actual %s",
+			addr.String(), observed.String(), actual.String(),
+		))
+		if !correct {
+			continue
+		}
+
+		rebased := new(big.Int).Add(actual, pendingDelta)
+		if rebased.Sign() < 0 {
+			return count, fmt.Errorf(
+				"%w: address %s, pending delta %s, actual balance %s",
+				ErrInsufficientFunds, addr.String(), pendingDelta.String(), actual.String(),
+			)
+		}
+		curState.dirtyBalances[addr] = rebased
+	}
+	return count, nil
+}
+
+// SettleCredit settles the latest pending credit of `amount` to the given address into the bank
+// module right away, e.g. the value sent to a payable precompile, so that the address can spend it
+// natively. The credit is dropped from the pending changes, while the pending balance is kept, as
+// it now matches the bank balance.
+func (m *Manager) SettleCredit(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	curState := m.getCurState()
+	for i := len(curState.balanceChanges) - 1; i >= 0; i-- {
+		change := curState.balanceChanges[i]
+		if change.Addr != addr || change.Delta.Cmp(amount) != 0 {
+			continue
+		}
+		if err := m.settle(ctx, change); err != nil {
+			return err
+		}
+		curState.balanceChanges = append(
+			curState.balanceChanges[:i], curState.balanceChanges[i+1:]...,
+		)
+		return nil
+	}
+	return fmt.Errorf(
+		"%w: address %s, amount %s", ErrNoPendingCredit, addr.String(), amount.String(),
+	)
+}
+
+// SyncBalance rebases the pending balance of the given address onto its bank balance, after the
+// bank balance was changed natively, e.g. by a payable precompile spending the value sent to it.
+func (m *Manager) SyncBalance(ctx sdk.Context, addr common.Address) {
+	curState := m.getCurState()
+	if _, found := curState.dirtyBalances[addr]; !found {
+		return
+	}
+	actual := m.balanceOf(ctx, addr)
+	curState.dirtyBalances[addr] = new(big.Int).Add(actual, m.pendingDelta(addr))
+}
+
+// pendingDelta returns the sum of the pending changes to the balance of the given address.
+func (m *Manager) pendingDelta(addr common.Address) *big.Int {
+	delta := new(big.Int)
+	for i := 0; i < m.states.Size(); i++ {
+		for _, change := range m.states.PeekAt(i).balanceChanges {
+			if change.Addr == addr {
+				delta.Add(delta, change.Delta)
+			}
+		}
+	}
+	return delta
+}
+
+// runCommitHook invokes the given hook with a copy of the applied changes, so that the hook cannot
+// modify the pending changes of the manager. The hook runs on a cache of the context that is only
+// written if the hook returns, so a panicking hook is logged, leaves no state behind and does not
+// prevent the remaining hooks from running.
+func (m *Manager) runCommitHook(ctx sdk.Context, index int, hook CommitHook, applied []BalanceChange) {
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.Logger().Error(fmt.Sprintf("[evm->bank] HOOK(#%d) panicked: %v", index, r))
+		}
+	}()
+
+	changes := make([]BalanceChange, len(applied))
+	for i, change := range applied {
+		changes[i] = BalanceChange{Addr: change.Addr, Delta: new(big.Int).Set(change.Delta)}
+	}
+	cacheCtx, write := ctx.CacheContext()
+	hook(cacheCtx, changes)
+	write()
+}
+
+// ReplayChanges applies an externally supplied, ordered change log to the bank module through the
+// same settlement path as `Commit`. It does not touch the pending changes of the manager.
+func (m *Manager) ReplayChanges(ctx sdk.Context, changes []BalanceChange) error {
+	for i, change := range changes {
+		if err := m.settle(ctx, change); err != nil {
+			return err
+		}
+		ctx.Logger().Info(fmt.Sprintf("[evm->bank] REPLAY(#%d): %s: %s", i+1, change.Addr.String(), change.Delta.String()))
+	}
+	return nil
+}
+
+// settle mints or burns the underlying denom for the given balance change. With a decimal
+// conversion, only whole bank units are minted or burned and the remainder is kept as dust.
+func (m *Manager) settle(ctx sdk.Context, change BalanceChange) error {
+	if m.conversion == nil {
+		if err := m.settleCoins(ctx, change.Addr, change.Delta); err != nil {
+			return err
+		}
+	} else {
+		delta, dust := m.convertChange(ctx, change)
+		if err := m.settleCoins(ctx, change.Addr, delta); err != nil {
+			return err
+		}
+		m.setDust(ctx, change.Addr, dust)
+	}
+	return nil
+}
+
+// settleCoins mints or burns the given signed amount of the underlying denom for the given
+// address.
+func (m *Manager) settleCoins(ctx sdk.Context, addr common.Address, delta *big.Int) error {
+	switch delta.Sign() {
+	case 1:
+		amount := sdk.NewCoins(sdk.NewCoin(m.denom, sdkmath.NewIntFromBigInt(delta)))
+		if err := m.bankKeeper.MintCoins(ctx, evmtypes.ModuleName, amount); err != nil {
+			return err
+		}
+		if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr.Bytes(), amount); err != nil {
+			return err
+		}
+
+	case -1:
+		debit := new(big.Int).Neg(delta)
+		balance := m.bankKeeper.GetBalance(ctx, addr.Bytes(), m.denom).Amount.BigInt()
+		if balance.Cmp(debit) < 0 {
+			if !m.settlement.reconcile {
+				return fmt.Errorf(
+					"%w: address %s, expected debit %s, actual balance %s",
+					ErrInsufficientFunds, addr.String(), debit.String(), balance.String(),
+				)
+			}
+			ctx.Logger().Error(fmt.Sprintf(
+				"[evm->bank] RECONCILE: %s: clamping debit %s to balance %s",
+				addr.String(), debit.String(), balance.String(),
+			))
+			debit = balance
+		}
+		if debit.Sign() == 0 {
+			return nil
+		}
+
+		amount := sdk.NewCoins(sdk.NewCoin(m.denom, sdkmath.NewIntFromBigInt(debit)))
+		if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, addr.Bytes(), evmtypes.ModuleName, amount); err != nil {
+			return err
+		}
+		if err := m.bankKeeper.BurnCoins(ctx, evmtypes.ModuleName, amount); err != nil {
+			return err
+		}
+
+	default:
+	}
+
 	return nil
 }
@@ -0,0 +1,489 @@
+package bank_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/bank"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const denom = evmtypes.LegacyEvmDenom
+
+var _ = Describe("Bank Manager", func() {
+	var (
+		ctx sdk.Context
+		bk  bankkeeper.BaseKeeper
+		m   *bank.Manager
+	)
+
+	fund := func(addr common.Address, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount)))
+		Expect(bk.MintCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+		Expect(bk.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr.Bytes(), coins)).To(Succeed())
+	}
+
+	drain := func(addr common.Address, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount)))
+		Expect(bk.SendCoinsFromAccountToModule(ctx, addr.Bytes(), evmtypes.ModuleName, coins)).To(Succeed())
+		Expect(bk.BurnCoins(ctx, evmtypes.ModuleName, coins)).To(Succeed())
+	}
+
+	bankBalance := func(addr common.Address) *big.Int {
+		return bk.GetBalance(ctx, addr.Bytes(), denom).Amount.BigInt()
+	}
+
+	BeforeEach(func() {
+		ctx, _, bk, _ = testutil.SetupMinimalKeepers()
+		m = bank.NewManager(bk, denom)
+	})
+
+	It("should settle mints and burns", func() {
+		fund(testutil.Alice, 100)
+		m.SetBalance(ctx, testutil.Alice, big.NewInt(40))
+		m.SetBalance(ctx, testutil.Bob, big.NewInt(60))
+
+		Expect(m.Commit(ctx)).To(Succeed())
+		Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(40)))
+		Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(60)))
+	})
+
+	When("the account is drained out-of-band", func() {
+		BeforeEach(func() {
+			fund(testutil.Alice, 100)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(40))
+			drain(testutil.Alice, 80)
+		})
+
+		It("should fail with a descriptive insufficient funds error", func() {
+			err := m.Commit(ctx)
+			Expect(err).To(MatchError(bank.ErrInsufficientFunds))
+			Expect(err.Error()).To(ContainSubstring(testutil.Alice.String()))
+			Expect(err.Error()).To(ContainSubstring("expected debit 60"))
+			Expect(err.Error()).To(ContainSubstring("actual balance 20"))
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(20)))
+		})
+
+		It("should clamp the burn in reconcile mode", func() {
+			m.SetReconcileMode(true)
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(0)))
+		})
+
+		It("should clamp the burn in the reconcile mode of a shared settlement", func() {
+			settlement := bank.NewSettlement()
+			settlement.SetReconcileMode(true)
+			m.UseSettlement(settlement)
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(0)))
+		})
+	})
+
+	When("replaying a change log", func() {
+		It("should match the balances of a direct commit", func() {
+			fund(testutil.Alice, 100)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(70))
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(30))
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(50))
+			Expect(m.Commit(ctx)).To(Succeed())
+			aliceCommitted, bobCommitted := bankBalance(testutil.Alice), bankBalance(testutil.Bob)
+
+			ctx, _, bk, _ = testutil.SetupMinimalKeepers()
+			m = bank.NewManager(bk, denom)
+			fund(testutil.Alice, 100)
+			Expect(m.ReplayChanges(ctx, []bank.BalanceChange{
+				{Addr: testutil.Alice, Delta: big.NewInt(-30)},
+				{Addr: testutil.Bob, Delta: big.NewInt(30)},
+				{Addr: testutil.Alice, Delta: big.NewInt(-20)},
+			})).To(Succeed())
+
+			Expect(bankBalance(testutil.Alice)).To(Equal(aliceCommitted))
+			Expect(bankBalance(testutil.Bob)).To(Equal(bobCommitted))
+		})
+
+		It("should fail on a debit the account cannot cover", func() {
+			err := m.ReplayChanges(ctx, []bank.BalanceChange{
+				{Addr: testutil.Alice, Delta: big.NewInt(-1)},
+			})
+			Expect(err).To(MatchError(bank.ErrInsufficientFunds))
+		})
+	})
+
+	When("settling a credit right away", func() {
+		It("should settle the credit and keep the pending balance", func() {
+			fund(testutil.Alice, 100)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(70))
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(30))
+
+			Expect(m.SettleCredit(ctx, testutil.Bob, big.NewInt(30))).To(Succeed())
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(30)))
+			Expect(m.GetBalance(ctx, testutil.Bob)).To(Equal(big.NewInt(30)))
+
+			// the settled credit is not minted again on commit
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(70)))
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(30)))
+		})
+
+		It("should fail without a matching pending credit", func() {
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(30))
+			err := m.SettleCredit(ctx, testutil.Bob, big.NewInt(20))
+			Expect(err).To(MatchError(bank.ErrNoPendingCredit))
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(0)))
+		})
+
+		It("should sync the pending balance after native spending", func() {
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(30))
+			Expect(m.SettleCredit(ctx, testutil.Bob, big.NewInt(30))).To(Succeed())
+			drain(testutil.Bob, 10)
+
+			m.SyncBalance(ctx, testutil.Bob)
+			Expect(m.GetBalance(ctx, testutil.Bob)).To(Equal(big.NewInt(20)))
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(20)))
+		})
+	})
+
+	When("commit hooks are registered", func() {
+		It("should invoke the hooks in order with the applied changes", func() {
+			var calls []string
+			var observed []bank.BalanceChange
+			m.RegisterCommitHook(func(_ sdk.Context, changes []bank.BalanceChange) {
+				calls = append(calls, "first")
+				observed = changes
+			})
+			m.RegisterCommitHook(func(_ sdk.Context, _ []bank.BalanceChange) {
+				calls = append(calls, "second")
+			})
+
+			fund(testutil.Alice, 100)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(40))
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(60))
+			Expect(m.Commit(ctx)).To(Succeed())
+
+			Expect(calls).To(Equal([]string{"first", "second"}))
+			Expect(observed).To(Equal([]bank.BalanceChange{
+				{Addr: testutil.Alice, Delta: big.NewInt(-60)},
+				{Addr: testutil.Bob, Delta: big.NewInt(60)},
+			}))
+		})
+
+		It("should not run the hooks when the commit fails", func() {
+			called := false
+			m.RegisterCommitHook(func(_ sdk.Context, _ []bank.BalanceChange) {
+				called = true
+			})
+
+			fund(testutil.Alice, 100)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(40))
+			drain(testutil.Alice, 80)
+			Expect(m.Commit(ctx)).To(MatchError(bank.ErrInsufficientFunds))
+			Expect(called).To(BeFalse())
+		})
+
+		It("should survive a panicking hook", func() {
+			called := false
+			m.RegisterCommitHook(func(_ sdk.Context, changes []bank.BalanceChange) {
+				changes[0].Delta.SetInt64(1000)
+				panic("hook failure")
+			})
+			m.RegisterCommitHook(func(_ sdk.Context, _ []bank.BalanceChange) {
+				called = true
+			})
+
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(60))
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(called).To(BeTrue())
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(60)))
+			Expect(m.GetBalance(ctx, testutil.Bob)).To(Equal(big.NewInt(60)))
+		})
+
+		It("should only keep the state written by hooks that return", func() {
+			m.RegisterCommitHook(func(ctx sdk.Context, _ []bank.BalanceChange) {
+				ctx.KVStore(testutil.EvmKey).Set([]byte("panicked"), []byte{1})
+				panic("hook failure")
+			})
+			m.RegisterCommitHook(func(ctx sdk.Context, _ []bank.BalanceChange) {
+				ctx.KVStore(testutil.EvmKey).Set([]byte("returned"), []byte{1})
+			})
+
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(60))
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(ctx.KVStore(testutil.EvmKey).Has([]byte("panicked"))).To(BeFalse())
+			Expect(ctx.KVStore(testutil.EvmKey).Has([]byte("returned"))).To(BeTrue())
+		})
+
+		It("should share the hooks of the settlement across managers", func() {
+			settlement := bank.NewSettlement()
+			calls := 0
+			settlement.RegisterCommitHook(func(_ sdk.Context, _ []bank.BalanceChange) {
+				calls++
+			})
+
+			for i := int64(1); i <= 2; i++ {
+				m = bank.NewManager(bk, denom)
+				m.UseSettlement(settlement)
+				m.SetBalance(ctx, testutil.Bob, big.NewInt(i))
+				Expect(m.Commit(ctx)).To(Succeed())
+			}
+			Expect(calls).To(Equal(2))
+		})
+	})
+	When("settlement events are enabled", func() {
+		BeforeEach(func() {
+			fund(testutil.Alice, 100)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(70))
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(30))
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(50))
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+		})
+
+		It("should not emit events by default", func() {
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(ctx.EventManager().Events()).To(BeEmpty())
+		})
+
+		It("should emit one event per address with the net delta", func() {
+			m.SetSettlementEventMode(bank.SettlementEventsPerAddress)
+			Expect(m.Commit(ctx)).To(Succeed())
+
+			events := ctx.EventManager().Events()
+			Expect(events).To(HaveLen(2))
+			deltas := map[string]string{}
+			for _, event := range events {
+				Expect(event.Type).To(Equal(bank.EventTypeSettlement))
+				addr, _ := event.GetAttribute(bank.AttributeKeyAddress)
+				delta, _ := event.GetAttribute(bank.AttributeKeyDelta)
+				deltas[addr.Value] = delta.Value
+			}
+			Expect(deltas).To(Equal(map[string]string{
+				testutil.Alice.Hex(): "-50",
+				testutil.Bob.Hex():   "30",
+			}))
+		})
+
+		It("should emit a single aggregated event with all changes of the block", func() {
+			settlement := bank.NewSettlement()
+			settlement.SetTransientStoreKey(testutil.EvmTKey)
+			settlement.SetSettlementEventMode(bank.SettlementEventsAggregated)
+			m.UseSettlement(settlement)
+			Expect(m.Commit(ctx)).To(Succeed())
+
+			next := bank.NewManager(bk, denom)
+			next.UseSettlement(settlement)
+			next.SetBalance(ctx, testutil.Bob, big.NewInt(40))
+			Expect(next.Commit(ctx)).To(Succeed())
+			Expect(ctx.EventManager().Events()).To(BeEmpty())
+
+			settlement.EndBlock(ctx)
+			events := ctx.EventManager().Events()
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Type).To(Equal(bank.EventTypeSettlementBatch))
+			count, _ := events[0].GetAttribute(bank.AttributeKeyCount)
+			Expect(count.Value).To(Equal("2"))
+			alice, found := events[0].GetAttribute(testutil.Alice.Hex())
+			Expect(found).To(BeTrue())
+			Expect(alice.Value).To(Equal("-50"))
+			bob, found := events[0].GetAttribute(testutil.Bob.Hex())
+			Expect(found).To(BeTrue())
+			Expect(bob.Value).To(Equal("40"))
+
+			// The next block starts from scratch.
+			settlement.EndBlock(ctx)
+			Expect(ctx.EventManager().Events()).To(HaveLen(1))
+		})
+	})
+
+	When("the number of addresses per block is capped", func() {
+		carol := common.BytesToAddress([]byte("carol"))
+
+		BeforeEach(func() {
+			settlement := bank.NewSettlement()
+			settlement.SetTransientStoreKey(testutil.EvmTKey)
+			m.UseSettlement(settlement)
+			m.SetMaxAddressesPerBlock(2)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(10))
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(20))
+		})
+
+		It("should commit at the cap", func() {
+			// Touching an address again does not count towards the cap.
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(15))
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(15)))
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(20)))
+		})
+
+		It("should fail the commit above the cap without applying changes", func() {
+			m.SetBalance(ctx, carol, big.NewInt(30))
+			err := m.Commit(ctx)
+			Expect(err).To(MatchError(bank.ErrTooManyAddresses))
+			Expect(err.Error()).To(ContainSubstring("3 addresses touched, max 2"))
+			Expect(bankBalance(testutil.Alice).Sign()).To(BeZero())
+			Expect(bankBalance(testutil.Bob).Sign()).To(BeZero())
+		})
+
+		It("should not count the addresses of a commit whose writes are discarded", func() {
+			cacheCtx, _ := ctx.CacheContext()
+			Expect(m.Commit(cacheCtx)).To(Succeed())
+			m.Reset()
+
+			m.SetBalance(ctx, carol, big.NewInt(30))
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(carol)).To(Equal(big.NewInt(30)))
+		})
+
+		It("should not cap by default", func() {
+			m.SetMaxAddressesPerBlock(0)
+			m.SetBalance(ctx, carol, big.NewInt(30))
+			Expect(m.Commit(ctx)).To(Succeed())
+		})
+
+		It("should count the addresses settled by every manager in the block", func() {
+			settlement := bank.NewSettlement()
+			settlement.SetTransientStoreKey(testutil.EvmTKey)
+			settlement.SetMaxAddressesPerBlock(2)
+			m.UseSettlement(settlement)
+			Expect(m.Commit(ctx)).To(Succeed())
+
+			next := bank.NewManager(bk, denom)
+			next.UseSettlement(settlement)
+			next.SetBalance(ctx, testutil.Alice, big.NewInt(5))
+			Expect(next.Commit(ctx)).To(Succeed())
+			next.Reset()
+
+			next.SetBalance(ctx, carol, big.NewInt(30))
+			Expect(next.Commit(ctx)).To(MatchError(bank.ErrTooManyAddresses))
+			Expect(bankBalance(carol).Sign()).To(BeZero())
+
+			settlement.EndBlock(ctx)
+			Expect(next.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(carol)).To(Equal(big.NewInt(30)))
+		})
+	})
+
+	When("the bank balance diverged from the balance the EVM observed", func() {
+		BeforeEach(func() {
+			fund(testutil.Alice, 100)
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(150))
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(20))
+			drain(testutil.Alice, 30)
+		})
+
+		It("should detect the discrepancy without correcting it", func() {
+			count, err := m.Reconcile(ctx, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+			Expect(m.GetBalance(ctx, testutil.Alice)).To(Equal(big.NewInt(150)))
+		})
+
+		It("should ignore native bank activity on accounts without pending changes", func() {
+			dave := common.BytesToAddress([]byte("dave"))
+			fund(dave, 10)
+
+			count, err := m.Reconcile(ctx, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("should rebase the pending balance onto the bank balance in correct mode", func() {
+			count, err := m.Reconcile(ctx, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+			Expect(m.GetBalance(ctx, testutil.Alice)).To(Equal(big.NewInt(120)))
+
+			count, err = m.Reconcile(ctx, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(120)))
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(20)))
+		})
+
+		It("should fail to rebase a pending debit the bank balance cannot cover", func() {
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(10))
+			drain(testutil.Alice, 60)
+
+			count, err := m.Reconcile(ctx, true)
+			Expect(err).To(MatchError(bank.ErrInsufficientFunds))
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	When("the manager is configured with another denom", func() {
+		It("should only settle the configured denom", func() {
+			m = bank.NewManager(bk, "abera")
+			Expect(m.Denom()).To(Equal("abera"))
+			fund(testutil.Alice, 100)
+			Expect(m.GetBalance(ctx, testutil.Alice)).To(Equal(big.NewInt(0)))
+
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(5))
+			Expect(m.Commit(ctx)).To(Succeed())
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(0)))
+			Expect(bk.GetBalance(ctx, testutil.Bob.Bytes(), "abera").Amount.Int64()).To(
+				Equal(int64(5)),
+			)
+		})
+	})
+
+	When("converting to the EVM decimals", func() {
+		// 1 bank unit is 1000 EVM units.
+		BeforeEach(func() {
+			m.SetStoreKey(testutil.EvmKey)
+			m.SetDecimalConversion(3)
+			fund(testutil.Alice, 10)
+		})
+
+		It("should scale the bank balance", func() {
+			Expect(m.GetBalance(ctx, testutil.Alice)).To(Equal(big.NewInt(10000)))
+			Expect(m.Dust(ctx, testutil.Alice).Sign()).To(BeZero())
+			Expect(m.ToBankAmount(big.NewInt(2999))).To(Equal(big.NewInt(2)))
+		})
+
+		It("should keep the remainder below one bank unit as dust", func() {
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(7499))
+			m.SetBalance(ctx, testutil.Bob, big.NewInt(2501))
+			Expect(m.Commit(ctx)).To(Succeed())
+			m.Reset()
+
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(7)))
+			Expect(m.Dust(ctx, testutil.Alice)).To(Equal(big.NewInt(499)))
+			Expect(bankBalance(testutil.Bob)).To(Equal(big.NewInt(2)))
+			Expect(m.Dust(ctx, testutil.Bob)).To(Equal(big.NewInt(501)))
+			Expect(m.TotalDust(ctx)).To(Equal(big.NewInt(1000)))
+
+			Expect(m.GetBalance(ctx, testutil.Alice)).To(Equal(big.NewInt(7499)))
+			Expect(m.GetBalance(ctx, testutil.Bob)).To(Equal(big.NewInt(2501)))
+		})
+
+		It("should borrow a bank unit when a debit exceeds the dust", func() {
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(9999))
+			Expect(m.Commit(ctx)).To(Succeed())
+			m.Reset()
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(9)))
+			Expect(m.Dust(ctx, testutil.Alice)).To(Equal(big.NewInt(999)))
+
+			m.SetBalance(ctx, testutil.Alice, big.NewInt(8000))
+			Expect(m.Commit(ctx)).To(Succeed())
+			m.Reset()
+			Expect(bankBalance(testutil.Alice)).To(Equal(big.NewInt(8)))
+			Expect(m.Dust(ctx, testutil.Alice).Sign()).To(BeZero())
+			Expect(m.TotalDust(ctx).Sign()).To(BeZero())
+		})
+
+		It("should fail on a debit the bank balance and dust cannot cover", func() {
+			Expect(m.ReplayChanges(ctx, []bank.BalanceChange{
+				{Addr: testutil.Alice, Delta: big.NewInt(-10001)},
+			})).To(MatchError(bank.ErrInsufficientFunds))
+		})
+	})
+})
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bank
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// Settlement holds the settlement configuration that outlives a single bank manager. The state
+// plugin builds a new manager for every tx, so anything configured on the chain, e.g. by the
+// keeper, is kept here and shared by all managers using it. The state of the current block, i.e.
+// the settled addresses and their net deltas, is kept in the transient store, so that it is
+// discarded along with the writes of a failed tx.
+type Settlement struct {
+	// commitHooks are invoked in registration order after each successful commit.
+	commitHooks []CommitHook
+
+	// reconcile clamps burns to the account's bank balance instead of failing when the
+	// account was drained by native bank activity after the EVM observed its balance.
+	reconcile bool
+
+	// maxAddresses is the maximum number of distinct addresses the commits of a single block may
+	// settle. Zero means unlimited.
+	maxAddresses int
+
+	// eventMode selects the settlement events emitted by the commits.
+	eventMode SettlementEventMode
+
+	// tkey is the key of the transient store holding the state of the current block. Without it,
+	// neither the addresses per block nor the aggregated settlement events are tracked.
+	tkey storetypes.StoreKey
+}
+
+// NewSettlement returns a Settlement without any hooks or limits.
+func NewSettlement() *Settlement {
+	return &Settlement{}
+}
+
+// SetTransientStoreKey sets the key of the transient store that holds the addresses and net deltas
+// settled in the current block.
+func (s *Settlement) SetTransientStoreKey(tkey storetypes.StoreKey) {
+	s.tkey = tkey
+}
+
+// EndBlock emits the aggregated settlement event of the block in `SettlementEventsAggregated`
+// mode, with the addresses in ascending order, and clears the state of the block.
+func (s *Settlement) EndBlock(ctx sdk.Context) {
+	if s.tkey == nil {
+		return
+	}
+	store := ctx.TransientStore(s.tkey)
+	defer func() {
+		clearPrefix(store, []byte{evmtypes.SettledAddressKeyPrefix})
+		clearPrefix(store, []byte{evmtypes.SettledNetDeltaKeyPrefix})
+		store.Delete([]byte{evmtypes.SettledAddressCountKey})
+	}()
+	if s.eventMode != SettlementEventsAggregated {
+		return
+	}
+
+	it := storetypes.KVStorePrefixIterator(store, []byte{evmtypes.SettledNetDeltaKeyPrefix})
+	defer it.Close()
+	var deltas []sdk.Attribute
+	for ; it.Valid(); it.Next() {
+		addr := common.BytesToAddress(it.Key()[1:])
+		deltas = append(deltas, sdk.NewAttribute(addr.Hex(), string(it.Value())))
+	}
+	if len(deltas) == 0 {
+		return
+	}
+
+	attrs := make([]sdk.Attribute, 0, len(deltas)+1)
+	attrs = append(attrs, sdk.NewAttribute(AttributeKeyCount, fmt.Sprintf("%d", len(deltas))))
+	attrs = append(attrs, deltas...)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeSettlementBatch, attrs...))
+}
+
+// SetReconcileMode toggles whether commits clamp a burn to the account's actual bank balance when
+// the account holds less than the pending debit.
+func (s *Settlement) SetReconcileMode(enabled bool) {
+	s.reconcile = enabled
+}
+
+// SetSettlementEventMode selects how the settled balance changes are reported as events. Defaults
+// to `SettlementEventsNone`.
+func (s *Settlement) SetSettlementEventMode(mode SettlementEventMode) {
+	s.eventMode = mode
+}
+
+// SetMaxAddressesPerBlock caps the number of distinct addresses the commits of a single block may
+// settle. A commit that exceeds the cap fails without applying any change. Zero, the default, is
+// unlimited.
+func (s *Settlement) SetMaxAddressesPerBlock(maxAddresses int) {
+	s.maxAddresses = maxAddresses
+}
+
+// checkAddresses returns an error if settling the given addresses would exceed the number of
+// addresses per block.
+func (s *Settlement) checkAddresses(ctx sdk.Context, addrs map[common.Address]*big.Int) error {
+	if s.maxAddresses == 0 || s.tkey == nil {
+		return nil
+	}
+	store := ctx.TransientStore(s.tkey)
+	touched := sdk.BigEndianToUint64(store.Get([]byte{evmtypes.SettledAddressCountKey}))
+	for addr := range addrs {
+		if !store.Has(settledAddressKey(addr)) {
+			touched++
+		}
+	}
+	if touched > uint64(s.maxAddresses) {
+		return fmt.Errorf(
+			"%w: %d addresses touched, max %d", ErrTooManyAddresses, touched, s.maxAddresses,
+		)
+	}
+	return nil
+}
+
+// recordAddresses counts the given addresses towards the number of addresses per block.
+func (s *Settlement) recordAddresses(ctx sdk.Context, addrs map[common.Address]*big.Int) {
+	if s.maxAddresses == 0 || s.tkey == nil {
+		return
+	}
+	store := ctx.TransientStore(s.tkey)
+	count := sdk.BigEndianToUint64(store.Get([]byte{evmtypes.SettledAddressCountKey}))
+	for addr := range addrs {
+		if !store.Has(settledAddressKey(addr)) {
+			store.Set(settledAddressKey(addr), []byte{1})
+			count++
+		}
+	}
+	store.Set([]byte{evmtypes.SettledAddressCountKey}, sdk.Uint64ToBigEndian(count))
+}
+
+// RegisterCommitHook registers a hook that is invoked with the applied changes at the end of every
+// successful `Commit` of a manager using this settlement. Hooks run in registration order.
+func (s *Settlement) RegisterCommitHook(hook CommitHook) {
+	s.commitHooks = append(s.commitHooks, hook)
+}
+
+// emitEvents nets the changes applied by a commit per address and, in `SettlementEventsPerAddress`
+// mode, emits them right away in ascending order of the addresses. In `SettlementEventsAggregated`
+// mode they are added to the net deltas of the block instead.
+func (s *Settlement) emitEvents(ctx sdk.Context, applied []BalanceChange) {
+	if s.eventMode == SettlementEventsNone || len(applied) == 0 {
+		return
+	}
+
+	net := make(map[common.Address]*big.Int)
+	for _, change := range applied {
+		if net[change.Addr] == nil {
+			net[change.Addr] = new(big.Int)
+		}
+		net[change.Addr].Add(net[change.Addr], change.Delta)
+	}
+
+	if s.eventMode == SettlementEventsAggregated {
+		s.addNetDeltas(ctx, net)
+		return
+	}
+	for _, addr := range sortedAddresses(net) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeSettlement,
+			sdk.NewAttribute(AttributeKeyAddress, addr.Hex()),
+			sdk.NewAttribute(AttributeKeyDelta, net[addr].String()),
+		))
+	}
+}
+
+// addNetDeltas adds the given net deltas to the net deltas of the block.
+func (s *Settlement) addNetDeltas(ctx sdk.Context, net map[common.Address]*big.Int) {
+	if s.tkey == nil {
+		return
+	}
+	store := ctx.TransientStore(s.tkey)
+	for addr, delta := range net {
+		key := append([]byte{evmtypes.SettledNetDeltaKeyPrefix}, addr.Bytes()...)
+		total, _ := new(big.Int).SetString(string(store.Get(key)), 10)
+		if total == nil {
+			total = new(big.Int)
+		}
+		store.Set(key, []byte(total.Add(total, delta).String()))
+	}
+}
+
+// settledAddressKey returns the transient store key marking the given address as settled in the
+// current block.
+func settledAddressKey(addr common.Address) []byte {
+	return append([]byte{evmtypes.SettledAddressKeyPrefix}, addr.Bytes()...)
+}
+
+// clearPrefix deletes all entries under the given prefix of the store.
+func clearPrefix(store storetypes.KVStore, pfx []byte) {
+	it := storetypes.KVStorePrefixIterator(store, pfx)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// sortedAddresses returns the addresses of the given net deltas in ascending order.
+func sortedAddresses(net map[common.Address]*big.Int) []common.Address {
+	addrs := make([]common.Address, 0, len(net))
+	for addr := range net {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+	return addrs
+}
@@ -82,6 +82,15 @@ func CodeKeyFor(codeHash common.Hash) []byte {
 	return bz
 }
 
+// AccountFirstSeenKeyFor defines the full key under which the height an account was first created
+// at is stored.
+func AccountFirstSeenKeyFor(address common.Address) []byte {
+	bz := make([]byte, 1+common.AddressLength)
+	copy(bz, []byte{types.AccountFirstSeenKeyPrefix})
+	copy(bz[1:], address[:])
+	return bz
+}
+
 // AddressFromCodeHashKey returns the address from a code hash key.
 func AddressFromCodeHashKey(key []byte) common.Address {
 	return common.BytesToAddress(key[1:])
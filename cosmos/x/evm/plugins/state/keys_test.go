@@ -98,3 +98,13 @@ var _ = Describe("CodeKeyFor", func() {
 		Expect(key[1:]).To(Equal(address.Bytes()))
 	})
 })
+
+var _ = Describe("AccountFirstSeenKeyFor", func() {
+	It("returns the first-seen key for a given account", func() {
+		address := common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
+		key := AccountFirstSeenKeyFor(address)
+		Expect(key).To(HaveLen(1 + common.AddressLength))
+		Expect(key[0]).To(Equal(types.AccountFirstSeenKeyPrefix))
+		Expect(key[1:]).To(Equal(address.Bytes()))
+	})
+})
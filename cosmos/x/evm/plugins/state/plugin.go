@@ -32,12 +32,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/store/snapmulti"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	ethstate "pkg.berachain.dev/polaris/eth/core/state"
+	"pkg.berachain.dev/polaris/eth/core/vm"
 	"pkg.berachain.dev/polaris/eth/crypto"
 	"pkg.berachain.dev/polaris/lib/snapshot"
 	libtypes "pkg.berachain.dev/polaris/lib/types"
@@ -63,6 +65,20 @@ type Plugin interface {
 	IterateState(fn func(addr common.Address, key common.Hash, value common.Hash) bool)
 	// SetGasConfig sets the gas config for the plugin.
 	SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
+	// FlushBankChanges commits the pending balance changes to the bank module mid-block.
+	FlushBankChanges() error
+	// ReconcileBank reports, and optionally corrects, EVM balances that no longer match the bank
+	// module.
+	ReconcileBank(ctx sdk.Context, correct bool) (int, error)
+	// SettleCredit settles the pending credit of the given amount to the given address into the
+	// bank module right away.
+	SettleCredit(addr common.Address, amount *big.Int) error
+	// SetSettlement sets the settlement shared by the bank managers of all txs.
+	SetSettlement(settlement *bank.Settlement)
+	// SyncBalance rebases the pending balance of the given address onto its bank balance.
+	SyncBalance(addr common.Address)
+	// BankAmount returns the given EVM amount in whole units of the underlying bank denom.
+	BankAmount(amount *big.Int) *big.Int
 }
 
 // The StatePlugin is a very fun and interesting part of the EVM implementation. But if you want to
@@ -107,6 +123,8 @@ type plugin struct {
 
 	bk BankKeeper
 	bm *bank.Manager
+	// settlement is shared by the bank managers of all txs, as they only live for a single tx.
+	settlement *bank.Settlement
 
 	// getQueryContext allows for querying state a historical height.
 	getQueryContext func(height int64, prove bool) (sdk.Context, error)
@@ -126,11 +144,12 @@ func NewPlugin(
 	plf events.PrecompileLogFactory,
 ) Plugin {
 	return &plugin{
-		storeKey: storeKey,
-		ak:       ak,
-		bk:       bk,
-		plf:      plf,
-		mu:       sync.Mutex{},
+		storeKey:   storeKey,
+		ak:         ak,
+		bk:         bk,
+		plf:        plf,
+		settlement: bank.NewSettlement(),
+		mu:         sync.Mutex{},
 	}
 }
 
@@ -141,10 +160,70 @@ func (p *plugin) CommitToBank() {
 		if err != nil {
 			p.ctx.Logger().Error("failed to commit pending changes to bank module", "err", err)
 			p.savedErr = err
+			return
 		}
+		p.bm.Reset()
 	}
 }
 
+// FlushBankChanges commits the pending balance changes to the bank module and drops them from the
+// bank manager, independently of the block-boundary commit in `CommitToBank`.
+func (p *plugin) FlushBankChanges() error {
+	if p.bm == nil {
+		return nil
+	}
+	if err := p.bm.Commit(p.ctx); err != nil {
+		return err
+	}
+	p.bm.Reset()
+	return nil
+}
+
+// ReconcileBank compares the EVM balances of the accounts with pending changes against the bank
+// module in the given context and, if correct is set, rebases mismatching
+// ones onto the bank balance. It returns the number of mismatches.
+func (p *plugin) ReconcileBank(ctx sdk.Context, correct bool) (int, error) {
+	bm := p.bm
+	if bm == nil {
+		bm = p.newBankManager(ctx)
+	}
+	return bm.Reconcile(ctx, correct)
+}
+
+// SettleCredit settles the latest pending credit of the given amount to the given address into
+// the bank module, so that the address can spend it natively, e.g. a payable precompile.
+func (p *plugin) SettleCredit(addr common.Address, amount *big.Int) error {
+	return p.bm.SettleCredit(p.ctx, addr, amount)
+}
+
+// SyncBalance rebases the pending balance of the given address onto its bank balance, after the
+// bank balance was changed natively.
+func (p *plugin) SyncBalance(addr common.Address) {
+	p.bm.SyncBalance(p.ctx, addr)
+}
+
+// newBankManager returns a bank manager that settles the EVM balances in the gas denom of the x/evm
+// params, converted to the EVM decimals with the dust kept in the evm store.
+func (p *plugin) newBankManager(ctx sdk.Context) *bank.Manager {
+	params := configuration.LoadParams(ctx.KVStore(p.storeKey))
+	bm := bank.NewManager(p.bk, params.EvmDenom)
+	bm.SetStoreKey(p.storeKey)
+	bm.SetDecimalConversion(params.ExtraDecimals)
+	bm.UseSettlement(p.settlement)
+	return bm
+}
+
+// BankAmount returns the given EVM amount in whole units of the underlying bank denom, e.g. the
+// value sent to a payable precompile as it is spent natively.
+func (p *plugin) BankAmount(amount *big.Int) *big.Int {
+	return p.bm.ToBankAmount(amount)
+}
+
+// SetSettlement sets the settlement shared by the bank managers of all txs.
+func (p *plugin) SetSettlement(settlement *bank.Settlement) {
+	p.settlement = settlement
+}
+
 // Prepare sets up the context on the state plugin for a new block. It sets the gas configs to be 0
 // so that query calls to the EVM (ones that do not invoke a new transaction) do not charge gas.
 //
@@ -182,7 +261,7 @@ func (p *plugin) Reset(ctx context.Context) {
 	// in the EVM are not being charged additional gas unknowingly.
 	p.SetGasConfig(storetypes.GasConfig{}, storetypes.GasConfig{})
 
-	p.bm = bank.NewManager(p.bk)
+	p.bm = p.newBankManager(sdkCtx)
 
 	// We setup a snapshot controller to properly revert the Controllable MultiStore and EventManager.
 	p.Controller = snapshot.NewController[string, libtypes.Controllable[string]]()
@@ -222,7 +301,13 @@ func (p *plugin) CreateAccount(addr common.Address) {
 	p.ak.SetAccount(p.ctx, acc)
 
 	// initialize the code hash to empty
-	p.cms.GetKVStore(p.storeKey).Set(CodeHashKeyFor(addr), emptyCodeHashBytes)
+	store := p.cms.GetKVStore(p.storeKey)
+	store.Set(CodeHashKeyFor(addr), emptyCodeHashBytes)
+
+	// index the height the account was first created at, keeping the earliest one
+	if firstSeenKey := AccountFirstSeenKeyFor(addr); !store.Has(firstSeenKey) {
+		store.Set(firstSeenKey, sdk.Uint64ToBigEndian(uint64(p.ctx.BlockHeight())))
+	}
 }
 
 // Exist implements the `StatePlugin` interface by reporting whether the given account address
@@ -545,9 +630,10 @@ func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 		}
 	}
 
-	// Create a State Plugin with the requested chain height.
+	// Create a State Plugin with the requested chain height. The state is only ever queried, so
+	// the precompiles may read state that is not part of the consensus.
 	sp := NewPlugin(p.ak, p.bk, p.storeKey, p.plf)
-	sp.Reset(ctx)
+	sp.Reset(ctx.WithValue(vm.QueryContextKey, true))
 	return sp, nil
 }
 
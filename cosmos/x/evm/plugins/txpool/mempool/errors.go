@@ -23,5 +23,6 @@ package mempool
 import "errors"
 
 var (
-	ErrIncorrectTxType = errors.New("tx is not of type WrappedEthereumTransaction")
+	ErrIncorrectTxType  = errors.New("tx is not of type WrappedEthereumTransaction")
+	ErrNonceGapTooLarge = errors.New("tx nonce is too far ahead of the pending nonce")
 )
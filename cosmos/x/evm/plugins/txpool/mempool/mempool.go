@@ -30,6 +30,10 @@ import (
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 )
 
+// FlagMaxNonceGap is the app option (`[polaris.mempool] max-nonce-gap` in app.toml) that sets the
+// maximum nonce gap of the mempool, see `SetMaxNonceGap`.
+const FlagMaxNonceGap = "polaris.mempool.max-nonce-gap"
+
 // EthTxPool is a mempool for Ethereum transactions. It wraps a PriorityNonceMempool and caches
 // transactions that are added to the mempool by ethereum transaction hash.
 type EthTxPool struct {
@@ -52,6 +56,10 @@ type EthTxPool struct {
 	// by nonce.
 	nonceToHash map[common.Address]map[uint64]common.Hash
 
+	// maxNonceGap is the maximum distance a tx nonce may be ahead of the sender's pending nonce
+	// for the tx to be accepted as queued. A value of 0 disables the check.
+	maxNonceGap uint64
+
 	// We have a mutex to protect the ethTxCache and nonces maps since they are accessed
 	// concurrently by multiple goroutines.
 	mu sync.RWMutex
@@ -89,6 +97,13 @@ func (etp *EthTxPool) SetNonceRetriever(nr NonceRetriever) {
 	etp.nr = nr
 }
 
+// SetMaxNonceGap sets the maximum distance a tx nonce may be ahead of the sender's pending nonce.
+// Txs within the gap are accepted as queued, txs beyond it are rejected. A value of 0 disables
+// the check.
+func (etp *EthTxPool) SetMaxNonceGap(maxNonceGap uint64) {
+	etp.maxNonceGap = maxNonceGap
+}
+
 // SetBaseFee updates the base fee in the priority policy.
 func (etp *EthTxPool) SetBaseFee(baseFee *big.Int) {
 	etp.priorityPolicy.baseFee = baseFee
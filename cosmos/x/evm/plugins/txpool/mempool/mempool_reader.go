@@ -21,7 +21,9 @@
 package mempool
 
 import (
+	"bytes"
 	"context"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -159,6 +161,32 @@ func (etp *EthTxPool) Nonce(addr common.Address) uint64 {
 	return pendingNonces[addr] + 1
 }
 
+// NonceConflicts returns the nonces of the given sender that have more than one tx cached, along
+// with the hashes of the competing txs. Since replacement keeps a single tx per nonce, a non-empty
+// result indicates a bookkeeping bug.
+func (etp *EthTxPool) NonceConflicts(sender common.Address) map[uint64][]common.Hash {
+	etp.mu.RLock()
+	defer etp.mu.RUnlock()
+
+	hashesByNonce := make(map[uint64][]common.Hash)
+	for hash, tx := range etp.ethTxCache {
+		if coretypes.GetSender(tx) == sender {
+			hashesByNonce[tx.Nonce()] = append(hashesByNonce[tx.Nonce()], hash)
+		}
+	}
+
+	conflicts := make(map[uint64][]common.Hash)
+	for nonce, hashes := range hashesByNonce {
+		if len(hashes) > 1 {
+			sort.Slice(hashes, func(i, j int) bool {
+				return bytes.Compare(hashes[i].Bytes(), hashes[j].Bytes()) < 0
+			})
+			conflicts[nonce] = hashes
+		}
+	}
+	return conflicts
+}
+
 // Stats returns the number of currently pending and queued (locally created) transactions.
 //
 // NOT THREAD SAFE.
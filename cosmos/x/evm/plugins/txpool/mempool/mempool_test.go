@@ -136,6 +136,30 @@ var _ = Describe("EthTxPool", func() {
 			Expect(q11).To(BeEmpty())
 		})
 
+		It("should accept queued txs within the max nonce gap", func() {
+			etp.SetMaxNonceGap(2)
+
+			// addr1's pending nonce is 1, so nonce 3 sits exactly at the gap boundary.
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3})
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(isQueuedTx(etp, ethTx1)).To(BeTrue())
+			Expect(isPendingTx(etp, ethTx1)).To(BeFalse())
+		})
+
+		It("should reject txs beyond the max nonce gap", func() {
+			etp.SetMaxNonceGap(2)
+
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 4})
+			Expect(etp.Insert(ctx, tx1)).To(MatchError(ErrNonceGapTooLarge))
+			Expect(etp.Get(ethTx1.Hash())).To(BeNil())
+
+			// Filling the gap moves the pending nonce forward, so the tx is now accepted.
+			_, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(isQueuedTx(etp, ethTx1)).To(BeTrue())
+		})
+
 		It("should handle replacement txs", func() {
 			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1)})
 			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(2)})
@@ -149,6 +173,31 @@ var _ = Describe("EthTxPool", func() {
 			Expect(etp.Get(ethTx2.Hash()).Hash()).To(Equal(ethTx2.Hash()))
 
 		})
+		It("should report no nonce conflicts after a replacement", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1)})
+			_, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(2)})
+
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.Insert(ctx, tx2)).To(Succeed())
+			Expect(etp.NonceConflicts(addr1)).To(BeEmpty())
+		})
+
+		It("should detect a transient nonce conflict", func() {
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1)})
+			ethTx2, _ := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(2)})
+			_, tx3 := buildTx(key2, &coretypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(1)})
+
+			Expect(etp.Insert(ctx, tx1)).To(Succeed())
+			Expect(etp.Insert(ctx, tx3)).To(Succeed())
+			// Simulate the window before the replaced tx is evicted from the cache.
+			etp.ethTxCache[ethTx2.Hash()] = ethTx2
+
+			conflicts := etp.NonceConflicts(addr1)
+			Expect(conflicts).To(HaveLen(1))
+			Expect(conflicts[1]).To(ConsistOf(ethTx1.Hash(), ethTx2.Hash()))
+			Expect(etp.NonceConflicts(addr2)).To(BeEmpty())
+		})
+
 		It("should enqueue transactions with out of order nonces then poll from queue when inorder nonce tx is received",
 			func() {
 				_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
@@ -63,6 +63,17 @@ func (etp *EthTxPool) Insert(ctx context.Context, tx sdk.Tx) error {
 		return err
 	}
 
+	// Reject future txs that are further ahead of the sender's pending nonce than allowed.
+	if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil && etp.maxNonceGap > 0 {
+		sender := coretypes.GetSender(ethTx)
+		if pendingNonce := etp.Nonce(sender); ethTx.Nonce() > pendingNonce+etp.maxNonceGap {
+			return errorslib.Wrapf(
+				ErrNonceGapTooLarge, "nonce %d, pending nonce %d, max gap %d",
+				ethTx.Nonce(), pendingNonce, etp.maxNonceGap,
+			)
+		}
+	}
+
 	// Call the base mempool's Insert method
 	if err := etp.PriorityNonceMempool.Insert(ctx, tx); err != nil {
 		return err
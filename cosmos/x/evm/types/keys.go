@@ -35,4 +35,14 @@ const (
 	GenesisHeaderKey
 	ParamsKey
 	ChainConfigPrefix
+	AccountFirstSeenKeyPrefix
+	DustKeyPrefix
+	DustTotalKey
+)
+
+// Keys of the transient store, which only holds the state of the current block.
+const (
+	SettledAddressKeyPrefix byte = iota
+	SettledAddressCountKey
+	SettledNetDeltaKeyPrefix
 )
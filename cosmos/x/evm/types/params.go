@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// LegacyEvmDenom is the denom that backs EVM balances and gas on chains that never stored params,
+// as it was hardcoded before it became a param.
+const LegacyEvmDenom = "umito"
+
+// MaxExtraDecimals is the maximum number of decimals the EVM balances can have in addition to the
+// bank denom, i.e. the EVM view of a 0 decimal denom has 18 decimals like wei.
+const MaxExtraDecimals = 18
+
+// methodIDLength is the length of a method selector of a restricted precompile method.
+const methodIDLength = 4
+
+// ErrInvalidParams is returned when the x/evm params fail validation.
+var ErrInvalidParams = errors.New("invalid evm params")
+
+// DefaultParams returns the default x/evm params. They have no evm denom, as it defaults to the
+// bond denom of the staking module at genesis.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate returns an error if the params are invalid.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.EvmDenom); err != nil {
+		return errorslib.Wrapf(ErrInvalidParams, "evm denom: %v", err)
+	}
+	if p.ExtraDecimals > MaxExtraDecimals {
+		return errorslib.Wrapf(
+			ErrInvalidParams, "extra decimals %d exceed %d", p.ExtraDecimals, MaxExtraDecimals,
+		)
+	}
+	if err := validateAddresses(p.EnabledPrecompiles); err != nil {
+		return errorslib.Wrapf(ErrInvalidParams, "enabled precompiles: %v", err)
+	}
+	if err := validateRestrictedMethods(p.RestrictedMethods); err != nil {
+		return errorslib.Wrapf(ErrInvalidParams, "restricted methods: %v", err)
+	}
+	return nil
+}
+
+// IsPrecompileEnabled returns whether the gated precompile at the given address is enabled.
+func (p Params) IsPrecompileEnabled(pc common.Address) bool {
+	for _, addr := range p.EnabledPrecompiles {
+		if common.HexToAddress(addr) == pc {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMethodRestricted returns whether the given precompile method can only be called by its
+// allowed callers.
+func (p Params) IsMethodRestricted(pc common.Address, method [4]byte) bool {
+	return p.restrictedMethod(pc, method) != nil
+}
+
+// IsMethodCallerAllowed returns whether the given caller can call the given precompile method,
+// i.e. the method is not restricted or the caller is one of its allowed callers.
+func (p Params) IsMethodCallerAllowed(
+	pc common.Address, method [4]byte, caller common.Address,
+) bool {
+	rm := p.restrictedMethod(pc, method)
+	if rm == nil {
+		return true
+	}
+	for _, addr := range rm.AllowedCallers {
+		if common.HexToAddress(addr) == caller {
+			return true
+		}
+	}
+	return false
+}
+
+// restrictedMethod returns the restriction of the given precompile method, or nil if the method is
+// not restricted.
+func (p Params) restrictedMethod(pc common.Address, method [4]byte) *RestrictedMethod {
+	for _, rm := range p.RestrictedMethods {
+		if common.HexToAddress(rm.Precompile) == pc &&
+			bytes.Equal(common.FromHex(rm.Method), method[:]) {
+			return rm
+		}
+	}
+	return nil
+}
+
+// validateRestrictedMethods returns an error if the given list has an invalid restriction or
+// restricts the same precompile method twice.
+func validateRestrictedMethods(rms []*RestrictedMethod) error {
+	seen := make(map[string]struct{}, len(rms))
+	for _, rm := range rms {
+		if rm == nil {
+			return errors.New("nil restricted method")
+		}
+		if !common.IsHexAddress(rm.Precompile) {
+			return fmt.Errorf("invalid precompile address %s", rm.Precompile)
+		}
+		method, err := hexutil.Decode(rm.Method)
+		if err != nil || len(method) != methodIDLength {
+			return fmt.Errorf("invalid method selector %s", rm.Method)
+		}
+		key := string(append(common.HexToAddress(rm.Precompile).Bytes(), method...))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate restriction of method %s of %s", rm.Method, rm.Precompile)
+		}
+		seen[key] = struct{}{}
+		if err = validateAddresses(rm.AllowedCallers); err != nil {
+			return fmt.Errorf("allowed callers of method %s of %s: %w", rm.Method, rm.Precompile, err)
+		}
+	}
+	return nil
+}
+
+// validateAddresses returns an error if the given list has an invalid or duplicate hex address.
+func validateAddresses(addrs []string) error {
+	seen := make(map[common.Address]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid address %s", addr)
+		}
+		if _, ok := seen[common.HexToAddress(addr)]; ok {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[common.HexToAddress(addr)] = struct{}{}
+	}
+	return nil
+}
+
+// ParamsFromBytes decodes JSON encoded params. It returns the params with the legacy evm denom for
+// empty bytes, so chains that never stored params keep their original gas denom.
+func ParamsFromBytes(bz []byte) (Params, error) {
+	if len(bz) == 0 {
+		return Params{EvmDenom: LegacyEvmDenom}, nil
+	}
+	var p Params
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return Params{}, err
+	}
+	return p, nil
+}
+
+// GenesisParams returns the params of the x/evm genesis state, which is the Ethereum genesis JSON
+// with an optional "params" field. The default params are returned if the field is absent, and
+// the evm denom defaults to the given bond denom of the staking module if it is not set.
+func GenesisParams(bz json.RawMessage, bondDenom string) (Params, error) {
+	var gen struct {
+		Params *Params `json:"params"`
+	}
+	if err := json.Unmarshal(bz, &gen); err != nil {
+		return Params{}, err
+	}
+	params := DefaultParams()
+	if gen.Params != nil {
+		params = *gen.Params
+	}
+	if params.EvmDenom == "" {
+		params.EvmDenom = bondDenom
+	}
+	return params, nil
+}
+
+// WithGenesisParams returns the given Ethereum genesis JSON with the given params set in its
+// "params" field.
+func WithGenesisParams(ethGen json.RawMessage, p Params) (json.RawMessage, error) {
+	var gen map[string]json.RawMessage
+	if err := json.Unmarshal(ethGen, &gen); err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	gen["params"] = bz
+	return json.Marshal(gen)
+}
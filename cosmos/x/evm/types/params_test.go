@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	"encoding/json"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Params", func() {
+	It("should leave the default evm denom to the staking module", func() {
+		Expect(types.DefaultParams().EvmDenom).To(BeEmpty())
+	})
+
+	It("should validate the evm denom", func() {
+		Expect(types.Params{EvmDenom: "abera"}.Validate()).To(Succeed())
+		Expect(types.Params{}.Validate()).To(MatchError(types.ErrInvalidParams))
+		Expect(types.Params{EvmDenom: "1mito"}.Validate()).To(MatchError(types.ErrInvalidParams))
+
+		Expect(types.Params{EvmDenom: "umito", ExtraDecimals: 12}.Validate()).To(Succeed())
+		Expect(types.Params{EvmDenom: "umito", ExtraDecimals: 19}.Validate()).To(
+			MatchError(types.ErrInvalidParams),
+		)
+	})
+
+	It("should validate the enabled precompiles", func() {
+		params := types.Params{EvmDenom: "abera"}
+		params.EnabledPrecompiles = []string{"0x0000000000000000000000000000000000000069"}
+		Expect(params.Validate()).To(Succeed())
+		Expect(params.IsPrecompileEnabled(common.BytesToAddress([]byte{0x69}))).To(BeTrue())
+		Expect(params.IsPrecompileEnabled(common.BytesToAddress([]byte{0x70}))).To(BeFalse())
+
+		params.EnabledPrecompiles = []string{"0x69"}
+		Expect(params.Validate()).To(MatchError(types.ErrInvalidParams))
+		params.EnabledPrecompiles = []string{
+			"0x0000000000000000000000000000000000000069",
+			"0x0000000000000000000000000000000000000069",
+		}
+		Expect(params.Validate()).To(MatchError(types.ErrInvalidParams))
+	})
+
+	It("should validate the restricted methods", func() {
+		pc := common.BytesToAddress([]byte{0x69})
+		params := types.Params{EvmDenom: "abera"}
+		params.RestrictedMethods = []*types.RestrictedMethod{{
+			Precompile:     pc.Hex(),
+			Method:         "0xa9059cbb",
+			AllowedCallers: []string{"0x0000000000000000000000000000000000000070"},
+		}}
+		Expect(params.Validate()).To(Succeed())
+		method := [4]byte{0xa9, 0x05, 0x9c, 0xbb}
+		Expect(params.IsMethodRestricted(pc, method)).To(BeTrue())
+		Expect(params.IsMethodRestricted(pc, [4]byte{1, 2, 3, 4})).To(BeFalse())
+		Expect(params.IsMethodCallerAllowed(
+			pc, method, common.BytesToAddress([]byte{0x70}),
+		)).To(BeTrue())
+		Expect(params.IsMethodCallerAllowed(
+			pc, method, common.BytesToAddress([]byte{0x71}),
+		)).To(BeFalse())
+
+		params.RestrictedMethods[0].Method = "0xa9059c"
+		Expect(params.Validate()).To(MatchError(types.ErrInvalidParams))
+		params.RestrictedMethods[0].Method = "0xa9059cbb"
+		params.RestrictedMethods[0].AllowedCallers = []string{"0x70"}
+		Expect(params.Validate()).To(MatchError(types.ErrInvalidParams))
+		params.RestrictedMethods[0].AllowedCallers = nil
+		params.RestrictedMethods = append(params.RestrictedMethods, params.RestrictedMethods[0])
+		Expect(params.Validate()).To(MatchError(types.ErrInvalidParams))
+	})
+
+	It("should default empty bytes to the legacy evm denom", func() {
+		params, err := types.ParamsFromBytes(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(Equal(types.Params{EvmDenom: types.LegacyEvmDenom}))
+
+		params, err = types.ParamsFromBytes([]byte(`{"evm_denom":"abera"}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params.EvmDenom).To(Equal("abera"))
+	})
+
+	It("should round trip the params through the genesis state", func() {
+		ethGen := json.RawMessage(`{"gasLimit":"0x1"}`)
+		params, err := types.GenesisParams(ethGen, "stake")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(Equal(types.Params{EvmDenom: "stake"}))
+
+		gen, err := types.WithGenesisParams(ethGen, types.DefaultParams())
+		Expect(err).ToNot(HaveOccurred())
+		params, err = types.GenesisParams(gen, "stake")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(Equal(types.Params{EvmDenom: "stake"}))
+
+		gen, err = types.WithGenesisParams(ethGen, types.Params{EvmDenom: "abera"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(gen)).To(ContainSubstring(`"gasLimit":"0x1"`))
+		params, err = types.GenesisParams(gen, "stake")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params.EvmDenom).To(Equal("abera"))
+	})
+
+	It("should round trip the update params msg through protobuf", func() {
+		msg := &types.MsgUpdateParams{
+			Authority: "authority",
+			Params: &types.Params{
+				EvmDenom:           "abera",
+				ExtraDecimals:      12,
+				EnabledPrecompiles: []string{"0x0000000000000000000000000000000000000069"},
+			},
+		}
+		bz, err := msg.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+
+		var decoded types.MsgUpdateParams
+		Expect(decoded.Unmarshal(bz)).To(Succeed())
+		Expect(&decoded).To(Equal(msg))
+	})
+})
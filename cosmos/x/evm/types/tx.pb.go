@@ -138,35 +138,135 @@ func (m *WrappedEthereumTransactionResult) GetReturnData() []byte {
 	return nil
 }
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// `authority` is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// `params` are the x/evm params to update to. All params must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*WrappedEthereumTransaction)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransaction")
 	proto.RegisterType((*WrappedEthereumTransactionResult)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransactionResult")
+	proto.RegisterType((*MsgUpdateParams)(nil), "polaris.evm.v1alpha1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "polaris.evm.v1alpha1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("polaris/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
 
 var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbd, 0x4a, 0x03, 0x41,
-	0x14, 0x85, 0x33, 0x1a, 0x8d, 0x8e, 0x62, 0xb1, 0x08, 0xc6, 0x05, 0xd7, 0x90, 0x2a, 0x88, 0xcc,
-	0x26, 0x0a, 0x3e, 0x80, 0x18, 0x3b, 0x9b, 0x55, 0x11, 0x6c, 0xc2, 0xcd, 0xee, 0x65, 0x77, 0x31,
-	0xb3, 0x33, 0xcc, 0xcc, 0x0e, 0x89, 0x95, 0x6f, 0xa0, 0x8f, 0xe2, 0x63, 0x58, 0xa6, 0xb4, 0x94,
-	0xa4, 0xf0, 0x35, 0x24, 0x93, 0x04, 0x2c, 0x4c, 0x61, 0x35, 0x3f, 0xe7, 0x1e, 0xee, 0x39, 0x7c,
-	0xf4, 0x48, 0x8a, 0x01, 0xa8, 0x5c, 0x87, 0x68, 0x79, 0x68, 0x3b, 0x30, 0x90, 0x19, 0x74, 0x42,
-	0x33, 0x64, 0x52, 0x09, 0x23, 0xbc, 0xfd, 0x85, 0xcc, 0xd0, 0x72, 0xb6, 0x94, 0xfd, 0x83, 0x58,
-	0x68, 0x2e, 0x74, 0xc8, 0x75, 0x1a, 0xda, 0xce, 0xec, 0x98, 0x8f, 0x37, 0xdb, 0xd4, 0x7f, 0x50,
-	0x20, 0x25, 0x26, 0x5d, 0x93, 0xa1, 0xc2, 0x92, 0xdf, 0x29, 0x28, 0x34, 0xc4, 0x26, 0x17, 0x85,
-	0xe7, 0xd1, 0x6a, 0x02, 0x06, 0xea, 0xa4, 0x41, 0x5a, 0xbb, 0x91, 0xbb, 0x37, 0x47, 0xb4, 0xb1,
-	0xda, 0x11, 0xa1, 0x2e, 0x07, 0xc6, 0x3b, 0xa4, 0x5b, 0x29, 0xe8, 0x5e, 0xa9, 0x31, 0x71, 0xde,
-	0x6a, 0x54, 0x4b, 0x41, 0xdf, 0x6b, 0x4c, 0x66, 0x92, 0xe5, 0x3d, 0x54, 0x4a, 0xa8, 0xfa, 0x5a,
-	0x83, 0xb4, 0xb6, 0xa3, 0x9a, 0xe5, 0xdd, 0xd9, 0xd3, 0x3b, 0xa6, 0x3b, 0x0a, 0x4d, 0xa9, 0x8a,
-	0x9e, 0x5b, 0xba, 0xee, 0x96, 0xd2, 0xf9, 0xd7, 0x15, 0x18, 0x38, 0x7b, 0x25, 0x94, 0xde, 0xe8,
-	0xf4, 0x16, 0x95, 0xcd, 0x63, 0xf4, 0x9e, 0xe9, 0x5e, 0xd7, 0x64, 0xbf, 0xf3, 0xb6, 0xd9, 0x5f,
-	0xed, 0xd9, 0xea, 0xbc, 0xfe, 0xc5, 0x7f, 0x1d, 0xf3, 0x86, 0xfe, 0xc6, 0xcb, 0xf7, 0xfb, 0x09,
-	0xb9, 0xbc, 0xfe, 0x98, 0x04, 0x64, 0x3c, 0x09, 0xc8, 0xd7, 0x24, 0x20, 0x6f, 0xd3, 0xa0, 0x32,
-	0x9e, 0x06, 0x95, 0xcf, 0x69, 0x50, 0x79, 0x3c, 0x95, 0x4f, 0x29, 0xeb, 0xa3, 0x82, 0x38, 0x83,
-	0xbc, 0x60, 0x09, 0xda, 0x70, 0x09, 0x6e, 0xc1, 0x62, 0xe8, 0x08, 0x9a, 0x91, 0x44, 0xdd, 0xdf,
-	0x74, 0x34, 0xce, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x0e, 0xd9, 0x14, 0xdd, 0x01, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0xd1, 0xda, 0xba, 0xd3, 0x52, 0x61, 0x10, 0xda, 0x86, 0x1a, 0xd7, 0x05, 0xa1, 0x14,
+	0x4d, 0xba, 0x55, 0x3c, 0x78, 0x14, 0xd7, 0x5b, 0x41, 0xa2, 0x45, 0xf0, 0xb2, 0xbc, 0x6e, 0x1e,
+	0x49, 0x70, 0x27, 0x33, 0xcc, 0x9b, 0x0c, 0x5d, 0x4f, 0xe2, 0x2f, 0xf0, 0xa7, 0xf4, 0x67, 0x78,
+	0xec, 0xd1, 0xa3, 0xec, 0x1e, 0xfa, 0x0b, 0xbc, 0xcb, 0x4e, 0xb2, 0x54, 0x4b, 0x16, 0xf4, 0x94,
+	0xcc, 0x7b, 0xdf, 0xfb, 0x3e, 0xbe, 0x8f, 0x8f, 0x3f, 0xd0, 0x6a, 0x02, 0xa6, 0xa0, 0x18, 0x9d,
+	0x8c, 0xdd, 0x00, 0x26, 0x3a, 0x87, 0x41, 0x6c, 0xcf, 0x23, 0x6d, 0x94, 0x55, 0xe2, 0x7e, 0xb3,
+	0x8e, 0xd0, 0xc9, 0x68, 0xb9, 0x0e, 0x76, 0xc6, 0x8a, 0xa4, 0xa2, 0x58, 0x52, 0x16, 0xbb, 0xc1,
+	0xe2, 0x53, 0xc3, 0x83, 0x47, 0xad, 0x6c, 0x1a, 0x0c, 0x48, 0xaa, 0x21, 0xfd, 0x23, 0x1e, 0x7c,
+	0x30, 0xa0, 0x35, 0xa6, 0x43, 0x9b, 0xa3, 0xc1, 0x4a, 0xbe, 0x37, 0x50, 0x12, 0x8c, 0x6d, 0xa1,
+	0x4a, 0x21, 0xf8, 0x5a, 0x0a, 0x16, 0x76, 0x59, 0x8f, 0x1d, 0x6c, 0x25, 0xfe, 0xbf, 0x3f, 0xe5,
+	0xbd, 0xd5, 0x17, 0x09, 0x52, 0x35, 0xb1, 0x62, 0x8f, 0xdf, 0xcd, 0x80, 0x46, 0x15, 0x61, 0xea,
+	0x6f, 0xd7, 0x92, 0x8d, 0x0c, 0xe8, 0x94, 0x30, 0x5d, 0xac, 0x9c, 0x1c, 0xa1, 0x31, 0xca, 0xec,
+	0xde, 0xea, 0xb1, 0x83, 0x6e, 0xb2, 0xe1, 0xe4, 0x70, 0xf1, 0x14, 0x0f, 0xf9, 0xa6, 0x41, 0x5b,
+	0x99, 0x72, 0xe4, 0x45, 0x6f, 0x7b, 0x51, 0x5e, 0x8f, 0x5e, 0x2f, 0xa4, 0x2b, 0x7e, 0xef, 0x84,
+	0xb2, 0x53, 0x9d, 0x82, 0xc5, 0xb7, 0xde, 0x85, 0xd8, 0xe7, 0x5d, 0xa8, 0x6c, 0xae, 0x4c, 0x61,
+	0xa7, 0x5e, 0xaa, 0x9b, 0x5c, 0x0f, 0xc4, 0x73, 0xbe, 0x5e, 0xbb, 0xf5, 0x52, 0x9b, 0xc7, 0xfb,
+	0x51, 0x5b, 0x80, 0x51, 0xcd, 0x95, 0x34, 0xd8, 0x97, 0xdb, 0x5f, 0xaf, 0x2e, 0x0e, 0xaf, 0x59,
+	0xfa, 0x7b, 0x7c, 0xe7, 0x86, 0x6c, 0x82, 0xa4, 0x55, 0x49, 0x78, 0xfc, 0x8b, 0x71, 0x7e, 0x42,
+	0xd9, 0x3b, 0x34, 0xae, 0x18, 0xa3, 0xf8, 0xcc, 0xb7, 0x87, 0x36, 0xff, 0x33, 0xc1, 0xa3, 0x76,
+	0xc5, 0xd5, 0x09, 0x06, 0x2f, 0xfe, 0xf7, 0xa2, 0xc9, 0x3c, 0xe5, 0x5b, 0x7f, 0x25, 0xf3, 0xb8,
+	0x9d, 0xe7, 0x86, 0x93, 0xe0, 0xe9, 0x3f, 0xc1, 0x96, 0x86, 0x83, 0x3b, 0x5f, 0xae, 0x2e, 0x0e,
+	0xd9, 0xab, 0x37, 0xdf, 0x67, 0x21, 0xbb, 0x9c, 0x85, 0xec, 0xe7, 0x2c, 0x64, 0xdf, 0xe6, 0x61,
+	0xe7, 0x72, 0x1e, 0x76, 0x7e, 0xcc, 0xc3, 0xce, 0xc7, 0x27, 0xfa, 0x53, 0x16, 0x9d, 0xa1, 0x81,
+	0x71, 0x0e, 0x45, 0x19, 0xa5, 0xe8, 0xe2, 0x65, 0x0b, 0x9b, 0x9a, 0x9e, 0xfb, 0x3a, 0xda, 0xa9,
+	0x46, 0x3a, 0x5b, 0xf7, 0x2d, 0x7c, 0xf6, 0x7b, 0x00, 0x34, 0xf8, 0xcf, 0x79, 0xf8, 0x02, 0x00,
 	0x00,
 }
 
@@ -184,6 +284,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgServiceClient interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
+	// UpdateParams defines a governance operation for updating the x/evm params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgServiceClient struct {
@@ -203,10 +305,21 @@ func (c *msgServiceClient) EthTransaction(ctx context.Context, in *WrappedEthere
 	return out, nil
 }
 
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
+	// UpdateParams defines a governance operation for updating the x/evm params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -216,6 +329,9 @@ type UnimplementedMsgServiceServer struct {
 func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthTransaction not implemented")
 }
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -239,6 +355,24 @@ func _MsgService_EthTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "polaris.evm.v1alpha1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -247,6 +381,10 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EthTransaction",
 			Handler:    _MsgService_EthTransaction_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -324,6 +462,71 @@ func (m *WrappedEthereumTransactionResult) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -368,6 +571,32 @@ func (m *WrappedEthereumTransactionResult) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -593,6 +822,174 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
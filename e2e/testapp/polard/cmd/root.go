@@ -163,10 +163,43 @@ func initCometBFTConfig() *cmtcfg.Config {
 	return cmtcfg.DefaultConfig()
 }
 
+// polarisAppConfig extends the SDK app config with the Polaris options.
+type polarisAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	Polaris polarisConfig `mapstructure:"polaris"`
+}
+
+// polarisConfig is the `[polaris]` section of the app config.
+type polarisConfig struct {
+	Mempool polarisMempoolConfig `mapstructure:"mempool"`
+}
+
+// polarisMempoolConfig is the `[polaris.mempool]` section of the app config.
+type polarisMempoolConfig struct {
+	// MaxNonceGap is read through `evmmempool.FlagMaxNonceGap`.
+	MaxNonceGap uint64 `mapstructure:"max-nonce-gap"`
+}
+
+// polarisConfigTemplate is the app config template of the Polaris options.
+const polarisConfigTemplate = `
+###############################################################################
+###                              Polaris Config                             ###
+###############################################################################
+
+[polaris.mempool]
+
+# max-nonce-gap is the maximum distance a tx nonce may be ahead of the sender's pending nonce
+# for the tx to be accepted as queued. Txs beyond it are rejected. 0 disables the check.
+max-nonce-gap = {{ .Polaris.Mempool.MaxNonceGap }}
+`
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
-	return serverconfig.DefaultConfigTemplate, serverconfig.DefaultConfig()
+	return serverconfig.DefaultConfigTemplate + polarisConfigTemplate, polarisAppConfig{
+		Config: *serverconfig.DefaultConfig(),
+	}
 }
 
 func initRootCmd(
@@ -21,42 +21,138 @@
 package testapp
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	authprecompile "pkg.berachain.dev/polaris/cosmos/precompile/auth"
+	authzprecompile "pkg.berachain.dev/polaris/cosmos/precompile/authz"
 	bankprecompile "pkg.berachain.dev/polaris/cosmos/precompile/bank"
+	bech32precompile "pkg.berachain.dev/polaris/cosmos/precompile/bech32"
+	consensusprecompile "pkg.berachain.dev/polaris/cosmos/precompile/consensus"
 	distrprecompile "pkg.berachain.dev/polaris/cosmos/precompile/distribution"
+	ed25519precompile "pkg.berachain.dev/polaris/cosmos/precompile/ed25519"
 	govprecompile "pkg.berachain.dev/polaris/cosmos/precompile/governance"
+	groupprecompile "pkg.berachain.dev/polaris/cosmos/precompile/group"
+	grpcqueryprecompile "pkg.berachain.dev/polaris/cosmos/precompile/grpcquery"
+	mintprecompile "pkg.berachain.dev/polaris/cosmos/precompile/mint"
+	msgexecutorprecompile "pkg.berachain.dev/polaris/cosmos/precompile/msgexecutor"
+	multicallprecompile "pkg.berachain.dev/polaris/cosmos/precompile/multicall"
+	randomnessprecompile "pkg.berachain.dev/polaris/cosmos/precompile/randomness"
+	registryprecompile "pkg.berachain.dev/polaris/cosmos/precompile/registry"
 	stakingprecompile "pkg.berachain.dev/polaris/cosmos/precompile/staking"
+	upgradeprecompile "pkg.berachain.dev/polaris/cosmos/precompile/upgrade"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 )
 
+// authzExecutableMsgs are the type URLs of the messages that the authz precompile can execute.
+var authzExecutableMsgs = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+}
+
+// msgExecutorExecutableMsgs are the type URLs of the messages that the msg executor precompile can
+// execute.
+var msgExecutorExecutableMsgs = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+}
+
+// grpcQueryAllowedPaths are the gRPC method paths that the gRPC query precompile can query.
+var grpcQueryAllowedPaths = []string{
+	"/cosmos.bank.v1beta1.Query/Balance",
+	"/cosmos.bank.v1beta1.Query/AllBalances",
+	"/cosmos.bank.v1beta1.Query/SupplyOf",
+	"/cosmos.distribution.v1beta1.Query/DelegationRewards",
+	"/cosmos.staking.v1beta1.Query/Delegation",
+	"/cosmos.staking.v1beta1.Query/Validator",
+}
+
 // PrecompilesToInject returns a function that provides the initialization of the standard
 // set of precompiles.
 func PrecompilesToInject(app *SimApp, customPcs ...ethprecompile.Registrable) func() *ethprecompile.Injector {
 	return func() *ethprecompile.Injector {
+		bankPc := bankprecompile.NewPrecompileContract(
+			app.AccountKeeper,
+			bankkeeper.NewMsgServerImpl(app.BankKeeper),
+			app.BankKeeper,
+			app.BankKeeper,
+			app.AuthzKeeper,
+			app.AuthzKeeper,
+			authkeeper.NewQueryServer(app.AccountKeeper),
+		)
+		bankPc.SetQueryContextFn(app.CreateQueryContext)
+		bankPc.SetParamsKeeper(app.EVMKeeper)
+
 		// Create the precompile injector with the standard precompiles.
 		pcs := ethprecompile.NewPrecompiles([]ethprecompile.Registrable{
-			bankprecompile.NewPrecompileContract(
+			authprecompile.NewPrecompileContract(
+				app.AccountKeeper,
+				authkeeper.NewQueryServer(app.AccountKeeper),
+			),
+			authzprecompile.NewPrecompileContract(
 				app.AccountKeeper,
-				bankkeeper.NewMsgServerImpl(app.BankKeeper),
-				app.BankKeeper,
+				app.AppCodec(),
+				app.AuthzKeeper,
+				app.AuthzKeeper,
+				authzExecutableMsgs,
 			),
+			bankPc,
+			bech32precompile.NewPrecompileContract(app.AccountKeeper),
+			consensusprecompile.NewPrecompileContract(app.ConsensusParamsKeeper),
 			distrprecompile.NewPrecompileContract(
 				app.AccountKeeper,
 				app.StakingKeeper,
 				distrkeeper.NewMsgServerImpl(app.DistrKeeper),
 				distrkeeper.NewQuerier(app.DistrKeeper),
 			),
+			ed25519precompile.NewPrecompileContract(),
 			govprecompile.NewPrecompileContract(
 				app.AccountKeeper,
+				app.AppCodec(),
 				govkeeper.NewMsgServerImpl(app.GovKeeper),
 				govkeeper.NewQueryServer(app.GovKeeper),
 			),
-			stakingprecompile.NewPrecompileContract(app.AccountKeeper, app.StakingKeeper),
+			groupprecompile.NewPrecompileContract(app.AccountKeeper, app.AppCodec(), app.GroupKeeper),
+			grpcqueryprecompile.NewPrecompileContract(app.GRPCQueryRouter(), grpcQueryAllowedPaths),
+			mintprecompile.NewPrecompileContract(mintkeeper.NewQueryServerImpl(app.MintKeeper)),
+			msgexecutorprecompile.NewPrecompileContract(
+				app.AppCodec(),
+				app.MsgServiceRouter(),
+				msgExecutorExecutableMsgs,
+			),
+			multicallprecompile.NewPrecompileContract(),
+			randomnessprecompile.NewPrecompileContract(),
+			registryprecompile.NewPrecompileContract(app.EVMKeeper),
+			stakingprecompile.NewPrecompileContract(
+				app.AccountKeeper,
+				app.StakingKeeper,
+				slashingkeeper.NewQuerier(app.SlashingKeeper),
+			),
+			upgradeprecompile.NewPrecompileContract(app.UpgradeKeeper),
 		}...)
 
+		// Opt into the EIP-2537 BLS12-381 precompiles.
+		for _, pc := range ethprecompile.GetBLS12381Precompiles() {
+			pcs.AddPrecompile(pc)
+		}
+
 		// Add the custom precompiles to the injector.
 		for _, pc := range customPcs {
 			pcs.AddPrecompile(pc)
@@ -34,14 +34,27 @@ type (
 	Argument           = abi.Argument
 	ArgumentMarshaling = abi.ArgumentMarshaling
 	Arguments          = abi.Arguments
+	Error              = abi.Error
 	Event              = abi.Event
 	Method             = abi.Method
+	Type               = abi.Type
 )
 
 var (
-	MakeTopics = abi.MakeTopics
-	NewEvent   = abi.NewEvent
-	NewType    = abi.NewType
+	MakeTopics         = abi.MakeTopics
+	NewError           = abi.NewError
+	NewEvent           = abi.NewEvent
+	NewType            = abi.NewType
+	ParseTopicsIntoMap = abi.ParseTopicsIntoMap
+)
+
+// Type kinds of the ABI `Type`s that have a string representation in Cosmos event attributes.
+const (
+	IntTy     = abi.IntTy
+	UintTy    = abi.UintTy
+	BoolTy    = abi.BoolTy
+	StringTy  = abi.StringTy
+	AddressTy = abi.AddressTy
 )
 
 // ToMixedCase converts a under_score formatted string to mixedCase format (camelCase with the
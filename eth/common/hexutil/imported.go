@@ -29,4 +29,9 @@ type (
 	Uint  = hexutil.Uint
 )
 
-var MustDecode = hexutil.MustDecode
+var (
+	Decode       = hexutil.Decode
+	DecodeUint64 = hexutil.DecodeUint64
+	Encode       = hexutil.Encode
+	MustDecode   = hexutil.MustDecode
+)
@@ -46,6 +46,8 @@ type (
 )
 
 var (
+	// ApplyMessage computes the new state by applying the given message against the old state.
+	ApplyMessage = core.ApplyMessage
 	// ApplyTransactionWithEVM applies a transaction to the current state of the blockchain.
 	ApplyTransactionWithEVMWithResult = core.ApplyTransactionWithEVMWithResult
 	// NewEVMTxContext creates a new context for use in the EVM.
@@ -64,4 +66,6 @@ var (
 var (
 	// ErrInsufficientBalanceForGas is the error return when gas required to execute a transaction overflows.
 	ErrGasUintOverflow = core.ErrGasUintOverflow
+	// ErrIntrinsicGas is returned if the gas limit of a message is below its intrinsic gas.
+	ErrIntrinsicGas = core.ErrIntrinsicGas
 )
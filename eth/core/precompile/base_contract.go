@@ -23,6 +23,7 @@ package precompile
 import (
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
 )
 
 // ==============================================================================
@@ -34,6 +35,8 @@ import (
 type Injector struct {
 	// precompiles stores the precompiles.
 	precompiles []Registrable
+	// gated stores the addresses of the precompiles that are disabled until enabled at runtime.
+	gated []common.Address
 }
 
 func NewPrecompiles(precompiles ...Registrable) *Injector {
@@ -52,6 +55,18 @@ func (pci *Injector) AddPrecompile(precompile Registrable) {
 	pci.precompiles = append(pci.precompiles, precompile)
 }
 
+// AddGatedPrecompile adds a new precompile to the injector, which is disabled until it is enabled
+// at runtime, e.g. by a governance proposal.
+func (pci *Injector) AddGatedPrecompile(precompile Registrable) {
+	pci.AddPrecompile(precompile)
+	pci.gated = append(pci.gated, precompile.RegistryKey())
+}
+
+// GetGatedPrecompiles returns the addresses of the gated precompiles.
+func (pci *Injector) GetGatedPrecompiles() []common.Address {
+	return pci.gated
+}
+
 // ==============================================================================
 // Base Precompile
 // ==============================================================================
@@ -59,6 +74,14 @@ func (pci *Injector) AddPrecompile(precompile Registrable) {
 type BaseContract interface {
 	StatefulImpl
 	GetPlugin() Plugin
+	// ABIErrors returns the custom errors of the precompile's ABI, which can be returned as
+	// `RevertError`s.
+	ABIErrors() map[string]abi.Error
+	// GasTable returns the gas cost registered for each method.
+	GasTable() GasTable
+	// RegisterMethodGas registers the gas cost of the method with the given signature (e.g.
+	// `getAllBalances(address)`). It returns an error if the ABI has no such method.
+	RegisterMethodGas(sig string, gas MethodGas) error
 }
 
 // baseContract is a base implementation of `StatefulImpl`.
@@ -69,13 +92,16 @@ type baseContract struct {
 	address common.Address
 	// plugin stores the core precompile plugin.
 	plugin Plugin
+	// gasTable stores the gas cost of the methods, keyed by method signature.
+	gasTable GasTable
 }
 
 // NewBaseContract creates a new `BasePrecompile`.
 func NewBaseContract(abiStr string, address common.Address) BaseContract {
 	return &baseContract{
-		abi:     abi.MustUnmarshalJSON(abiStr),
-		address: address,
+		abi:      abi.MustUnmarshalJSON(abiStr),
+		address:  address,
+		gasTable: make(GasTable),
 	}
 }
 
@@ -94,6 +120,11 @@ func (c *baseContract) ABIEvents() map[string]abi.Event {
 	return c.abi.Events
 }
 
+// ABIErrors implements BaseContract.
+func (c *baseContract) ABIErrors() map[string]abi.Error {
+	return c.abi.Errors
+}
+
 // CustomValueDecoders implements StatefulImpl.
 func (c *baseContract) CustomValueDecoders() ValueDecoders {
 	return nil
@@ -108,3 +139,19 @@ func (c *baseContract) SetPlugin(plugin Plugin) {
 func (c *baseContract) GetPlugin() Plugin {
 	return c.plugin
 }
+
+// GasTable implements GasMeteredImpl.
+func (c *baseContract) GasTable() GasTable {
+	return c.gasTable
+}
+
+// RegisterMethodGas implements BaseContract.
+func (c *baseContract) RegisterMethodGas(sig string, gas MethodGas) error {
+	for _, method := range c.abi.Methods {
+		if method.Sig == sig {
+			c.gasTable[sig] = gas
+			return nil
+		}
+	}
+	return errorslib.Wrap(ErrMethodNotFound, sig)
+}
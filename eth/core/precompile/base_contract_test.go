@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	solidity "pkg.berachain.dev/polaris/contracts/bindings/testing"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Base Contract", func() {
+	var bc BaseContract
+
+	BeforeEach(func() {
+		bc = NewBaseContract(solidity.MockPrecompileMetaData.ABI, common.Address{0x1})
+	})
+
+	It("should not charge gas for methods without registered gas", func() {
+		Expect(bc.GasTable()).ToNot(HaveKey(getOutputABI.Sig))
+	})
+
+	It("should register the gas of the ABI methods", func() {
+		gas := MethodGas{Flat: 100, PerByte: 3}
+		Expect(bc.RegisterMethodGas(getOutputABI.Sig, gas)).To(Succeed())
+		Expect(bc.GasTable()).To(HaveKeyWithValue(getOutputABI.Sig, gas))
+		Expect(bc.GasTable()).ToNot(HaveKey(getOutputPartialABI.Sig))
+	})
+
+	It("should fail to register the gas of unknown methods", func() {
+		err := bc.RegisterMethodGas("unknown(address)", MethodGas{Flat: 100})
+		Expect(err).To(MatchError(ContainSubstring(ErrMethodNotFound.Error())))
+	})
+
+	It("should compute the cost of a call", func() {
+		Expect(MethodGas{Flat: 100, PerByte: 3}.Cost(64)).To(Equal(uint64(292)))
+		Expect(MethodGas{}.Cost(64)).To(Equal(uint64(0)))
+	})
+})
@@ -65,17 +65,25 @@ func (dp *defaultPlugin) GetActive(rules *params.Rules) []common.Address {
 // Run implements core.PrecompilePlugin.
 func (dp *defaultPlugin) Run(
 	evm vm.PrecompileEVM, pc vm.PrecompileContainer, input []byte,
-	caller common.Address, value *big.Int, suppliedGas uint64, _ bool,
-) ([]byte, uint64, error) {
+	caller common.Address, value *big.Int, suppliedGas uint64, readOnly bool,
+) (ret []byte, gasRemaining uint64, err error) {
+	pc = ActiveVersion(evm, pc)
+
+	// capture the precompile call as a call frame on the tracer
+	endTrace := Trace(evm, pc, input, caller, value, suppliedGas)
+	defer func() { endTrace(ret, suppliedGas-gasRemaining, err) }()
+
 	gasCost := pc.RequiredGas(input)
 	if gasCost > suppliedGas {
 		return nil, 0, vm.ErrOutOfGas
 	}
 
-	suppliedGas -= gasCost
-	output, err := pc.Run(context.Background(), evm, input, caller, value)
+	ret, err = pc.Run(
+		context.WithValue(context.Background(), vm.ReadOnlyContextKey, readOnly),
+		evm, input, caller, value,
+	)
 
-	return output, suppliedGas, err
+	return ret, suppliedGas - gasCost, err
 }
 
 // EnableReentrancy implements core.PrecompilePlugin.
@@ -105,3 +113,14 @@ func GetDefaultPrecompiles(rules *params.Rules) []Registrable {
 	}
 	return allPrecompiles
 }
+
+// GetBLS12381Precompiles returns the EIP-2537 BLS12-381 precompiles (G1/G2 addition,
+// multiplication and multi-exponentiation, pairing and mapping to the curve), which are not
+// enabled by any hard fork. Chains can opt into them by injecting them as custom precompiles.
+func GetBLS12381Precompiles() []Registrable {
+	blsPrecompiles := make([]Registrable, 0, len(vm.PrecompiledContractsBLS))
+	for _, precompile := range vm.PrecompiledContractsBLS {
+		blsPrecompiles = append(blsPrecompiles, precompile)
+	}
+	return blsPrecompiles
+}
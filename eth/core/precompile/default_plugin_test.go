@@ -54,4 +54,15 @@ var _ = Describe("Default Plugin", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	When("getting the BLS12-381 precompiles", func() {
+		It("should return the EIP-2537 precompiles", func() {
+			pcs := GetBLS12381Precompiles()
+			Expect(pcs).To(HaveLen(9))
+			for _, pc := range pcs {
+				Expect(pc.RegistryKey().Big().Int64()).To(BeNumerically(">=", 0x0a))
+				Expect(pc.RegistryKey().Big().Int64()).To(BeNumerically("<=", 0x12))
+			}
+		})
+	})
 })
@@ -20,7 +20,12 @@
 
 package precompile
 
-import "errors"
+import (
+	"errors"
+
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
 
 var (
 	// ErrMethodNotFound is returned when the precompile method is not found.
@@ -41,4 +46,51 @@ var (
 	// ErrNoPrecompileMethodForABIMethod is returned when no precompile method is provided for a
 	// corresponding ABI method.
 	ErrNoPrecompileMethodForABIMethod = errors.New("this ABI method does not have a corresponding precompile method")
+
+	// ErrInvalidVersion is returned when a version of a versioned precompile has a different
+	// address than the genesis version or shares its activation height with another version.
+	ErrInvalidVersion = errors.New("invalid precompile version")
+
+	// ErrNonPayableMethod is returned when value is sent to a precompile method that is not
+	// payable.
+	ErrNonPayableMethod = errors.New("precompile method is not payable")
 )
+
+// Compile-time assertion.
+var _ RevertError = (*revertError)(nil)
+
+// revertError is a `RevertError` for a custom error of a precompile's ABI.
+type revertError struct {
+	// cause is the Go error that caused the revert.
+	cause error
+	// data is the ABI encoded custom error.
+	data []byte
+}
+
+// NewRevertError returns a `RevertError` that reverts with the given ABI custom error and args,
+// while wrapping the Go error that caused it.
+func NewRevertError(abiErr abi.Error, cause error, args ...any) error {
+	packed, err := abiErr.Inputs.Pack(args...)
+	if err != nil {
+		return errorslib.Wrapf(cause, "failed to pack custom error %s: %v", abiErr.Name, err)
+	}
+	return &revertError{
+		cause: cause,
+		data:  append(abiErr.ID.Bytes()[:4], packed...),
+	}
+}
+
+// Error implements `error`.
+func (e *revertError) Error() string {
+	return e.cause.Error()
+}
+
+// Unwrap returns the Go error that caused the revert.
+func (e *revertError) Unwrap() error {
+	return e.cause
+}
+
+// RevertData implements `RevertError`.
+func (e *revertError) RevertData() []byte {
+	return e.data
+}
@@ -32,6 +32,7 @@ const (
 	// impl names stored as constants, to be used in error messages.
 	statelessContainerName = `StatelessImpl`
 	statefulContainerName  = `StatefulImpl`
+	versionedContainerName = `Versioned`
 )
 
 // AbstractFactory is an interface that all precompile container factories must adhere to.
@@ -44,6 +45,7 @@ type AbstractFactory interface {
 var (
 	_ AbstractFactory = (*StatelessFactory)(nil)
 	_ AbstractFactory = (*StatefulFactory)(nil)
+	_ AbstractFactory = (*VersionedFactory)(nil)
 )
 
 // ===========================================================================
@@ -108,6 +110,57 @@ func (sf *StatefulFactory) Build(
 	return NewStatefulContainer(si, idsToMethods)
 }
 
+// ===========================================================================
+// Versioned Container Factory
+// ===========================================================================
+
+// VersionedFactory is used to build versioned precompile containers.
+type VersionedFactory struct{}
+
+// NewVersionedFactory creates and returns a new `VersionedFactory`.
+func NewVersionedFactory() *VersionedFactory {
+	return &VersionedFactory{}
+}
+
+// Build returns a versioned precompile container, which holds a stateful or stateless container
+// for each version of the given versioned precompile. This function will return an error if the
+// given contract is not a `Versioned` precompile.
+//
+// Build implements `AbstractFactory`.
+func (vf *VersionedFactory) Build(
+	rp Registrable, p Plugin,
+) (vm.PrecompileContainer, error) {
+	v, ok := utils.GetAs[*Versioned](rp)
+	if !ok {
+		return nil, errorslib.Wrap(ErrWrongContainerFactory, versionedContainerName)
+	}
+
+	genesis, err := buildVersion(v.genesis, p)
+	if err != nil {
+		return nil, err
+	}
+	vc := &versionedContainer{
+		genesis:  genesis,
+		upgrades: make([]vm.PrecompileContainer, len(v.upgrades)),
+		heights:  make([]uint64, len(v.upgrades)),
+	}
+	for i, upgrade := range v.upgrades {
+		if vc.upgrades[i], err = buildVersion(upgrade.Impl, p); err != nil {
+			return nil, err
+		}
+		vc.heights[i] = upgrade.ActivationHeight
+	}
+	return vc, nil
+}
+
+// buildVersion builds the container of a single version of a versioned precompile.
+func buildVersion(rp Registrable, p Plugin) (vm.PrecompileContainer, error) {
+	if utils.Implements[StatefulImpl](rp) {
+		return NewStatefulFactory().Build(rp, p)
+	}
+	return NewStatelessFactory().Build(rp, p)
+}
+
 // This function matches each Go implementation of the precompile to the ABI's respective function.
 // It searches for the ABI function in the Go precompile contract and performs basic validation on
 // the implemented function.
@@ -196,6 +196,15 @@ func (ms *mockStateful) OverloadedFunc0(_ context.Context, _ *big.Int) (*big.Int
 	return big.NewInt(420), nil
 }
 
+// ============================================================================.
+type mockGasMetered struct {
+	*mockStateful
+}
+
+func (mgm *mockGasMetered) GasTable() GasTable {
+	return GasTable{getOutputABI.Sig: {Flat: 100, PerByte: 2}}
+}
+
 // ============================================================================.
 type badMockStateful struct {
 	*mockBase
@@ -89,6 +89,28 @@ type (
 		SetPlugin(Plugin)
 	}
 
+	// GasMeteredImpl is an optional interface for stateful precompiled contracts that charge a
+	// flat and a per input byte amount of gas per method, on top of the gas consumed while
+	// executing the method. `BaseContract` implements it with the costs registered by
+	// `RegisterMethodGas`.
+	GasMeteredImpl interface {
+		StatefulImpl
+
+		// GasTable should return the gas cost of each method of the contract.
+		GasTable() GasTable
+	}
+
+	// EventMappedImpl is an optional interface for stateful precompiled contracts with ABI events
+	// that are not translated from Cosmos events by naming convention, i.e. the Cosmos event type
+	// is not the ABI event name in snake case or the Cosmos attribute keys are not the ABI event
+	// argument names in snake case.
+	EventMappedImpl interface {
+		StatefulImpl
+
+		// EventMappings should return the Cosmos event translations of the contract's ABI events.
+		EventMappings() EventMappings
+	}
+
 	// DynamicImpl is the interface for all dynamic stateful precompiled contracts.
 	DynamicImpl interface {
 		StatefulImpl
@@ -106,4 +128,49 @@ type (
 	// ValueDecoders is a type that represents a map of event attribute keys to value decoder
 	// functions.
 	ValueDecoders map[string]ValueDecoder
+
+	// EventMapping describes how a Cosmos event is translated into the Ethereum log of an ABI
+	// event. The zero value translates by naming convention.
+	EventMapping struct {
+		// CosmosType is the type of the Cosmos event. If empty, the ABI event name in snake case
+		// is used.
+		CosmosType string
+		// Attributes maps ABI event argument names to Cosmos event attribute keys. Arguments that
+		// are missing from the map are matched with the attribute key of their name in snake case.
+		Attributes map[string]string
+		// ValueDecoders maps Cosmos event attribute keys to value decoder functions that are only
+		// used for this event. They take precedence over the contract's `CustomValueDecoders`.
+		ValueDecoders ValueDecoders
+	}
+
+	// EventMappings is a type that represents a map of ABI event names to their Cosmos event
+	// translations.
+	EventMappings map[string]EventMapping
+
+	// MethodGas is the gas cost of calling a precompile method, which is charged before the method
+	// is executed.
+	MethodGas struct {
+		// Flat is the fixed gas cost of every call.
+		Flat uint64
+		// PerByte is the gas cost of every byte of the call's ABI encoded arguments.
+		PerByte uint64
+	}
+
+	// GasTable is a type that represents a map of ABI method signatures (e.g.
+	// `getAllBalances(address)`) to the gas cost of calling the method. Methods that are missing
+	// from the table cost no gas before execution.
+	GasTable map[string]MethodGas
 )
+
+// Cost returns the gas cost of a call with the given number of bytes of ABI encoded arguments.
+func (mg MethodGas) Cost(numArgBytes int) uint64 {
+	return mg.Flat + mg.PerByte*uint64(numArgBytes)
+}
+
+// RevertError is an error returned by a precompile method that carries ABI encoded revert data,
+// e.g. a Solidity custom error. The revert data is returned to the calling contract, which can
+// then decode the reason of the failure.
+type RevertError interface {
+	error
+	RevertData() []byte
+}
@@ -103,6 +103,12 @@ func (m *method) Call(ctx context.Context, input []byte) ([]byte, error) {
 		err = utils.MustGetAs[error](revert)
 	}
 	if err != nil {
+		// the EVM only returns the revert data to the caller if the error is exactly
+		// `ErrExecutionReverted`, so the error is not wrapped in that case
+		var revertErr RevertError
+		if errors.As(err, &revertErr) {
+			return revertErr.RevertData(), vm.ErrExecutionReverted
+		}
 		if !errors.Is(err, vm.ErrWriteProtection) {
 			err = errorslib.Wrapf(
 				vm.ErrExecutionReverted,
@@ -22,6 +22,7 @@ package precompile
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"reflect"
 
@@ -61,6 +62,26 @@ var _ = Describe("Method", func() {
 			Expect(res).To(BeNil())
 			Expect(sc.executableCalled).To(BeTrue())
 		})
+
+		It("should return the revert data of a revert error", func() {
+			sc := &mockStatefulWithMethod{&mockBase{}, false}
+			execute, found := reflect.TypeOf(sc).MethodByName("MockRevertExecutable")
+			Expect(found).To(BeTrue())
+			method := newMethod(sc, abi.Method{}, execute)
+			ctx := vm.NewPolarContext(
+				context.Background(),
+				vmmock.NewEVM(),
+				common.Address{1},
+				big.NewInt(0),
+			)
+
+			res, err := method.Call(ctx, []byte{0, 0, 0, 0})
+			Expect(err).To(Equal(vm.ErrExecutionReverted))
+			Expect(res[:4]).To(Equal(mockAbiError.ID.Bytes()[:4]))
+			args, err := mockAbiError.Inputs.Unpack(res[4:])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(Equal([]any{"reason"}))
+		})
 	})
 })
 
@@ -77,3 +98,21 @@ func (ms *mockStatefulWithMethod) MockExecutable(
 	ms.executableCalled = true
 	return nil
 }
+
+var mockAbiError = abi.NewError("MockError", abi.Arguments{
+	{Name: "reason", Type: mustNewType("string")},
+})
+
+func (ms *mockStatefulWithMethod) MockRevertExecutable(
+	_ context.Context,
+) error {
+	return NewRevertError(mockAbiError, errors.New("mock error"), "reason")
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
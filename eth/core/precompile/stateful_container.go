@@ -26,6 +26,7 @@ import (
 
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
@@ -83,17 +84,37 @@ func (sc *statefulContainer) Run(
 		return nil, ErrMethodNotFound
 	}
 
+	// Only view and pure methods can be called in a read-only call.
+	polarCtx := vm.NewPolarContext(ctx, evm, caller, value)
+	if polarCtx.IsReadOnly() && !method.abiMethod.IsConstant() {
+		return nil, errorslib.Wrap(vm.ErrWriteProtection, method.abiMethod.Name)
+	}
+
+	// Only payable methods can receive value, which is available as the msg value of the ctx.
+	if value != nil && value.Sign() > 0 && !method.abiMethod.IsPayable() {
+		return nil, errorslib.Wrap(ErrNonPayableMethod, method.abiMethod.Name)
+	}
+
 	// Execute the method with the reflected ctx and raw input
-	return method.Call(
-		vm.NewPolarContext(ctx, evm, caller, value),
-		input,
-	)
+	return method.Call(polarCtx, input)
 }
 
-// RequiredGas checks the Method corresponding to input for the required gas amount. TODO: remove
-// unneeded input from interface.
+// RequiredGas checks the Method corresponding to input for the required gas amount, which is the
+// cost of the method in the gas table, if the stateful implementation is a `GasMeteredImpl`.
 //
 // RequiredGas implements PrecompileContainer.
-func (sc *statefulContainer) RequiredGas([]byte) uint64 {
-	return 0
+func (sc *statefulContainer) RequiredGas(input []byte) uint64 {
+	if len(input) < NumBytesMethodID {
+		return 0
+	}
+	method, found := sc.idsToMethods[utils.UnsafeBytesToStr(input[:NumBytesMethodID])]
+	if !found {
+		return 0
+	}
+
+	gmi, ok := utils.GetAs[GasMeteredImpl](sc.StatefulImpl)
+	if !ok {
+		return 0
+	}
+	return gmi.GasTable()[method.abiMethod.Sig].Cost(len(input) - NumBytesMethodID)
 }
@@ -66,6 +66,34 @@ var _ = Describe("Stateful Container", func() {
 			Expect(sc.RequiredGas(blank)).To(Equal(uint64(0)))
 		})
 
+		It("should return the gas of the method in the gas table", func() {
+			var gmc vm.PrecompileContainer
+			gmc, err = NewStatefulContainer(
+				&mockGasMetered{&mockStateful{&mockBase{}}}, mockIdsToMethods,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(gmc.RequiredGas(getOutputABI.ID)).To(Equal(uint64(100)))
+
+			// method not in the gas table
+			Expect(gmc.RequiredGas(getOutputPartialABI.ID)).To(Equal(uint64(0)))
+
+			// method not found and invalid input
+			Expect(gmc.RequiredGas(badInput)).To(Equal(uint64(0)))
+			Expect(gmc.RequiredGas(blank)).To(Equal(uint64(0)))
+		})
+
+		It("should add the per byte gas of the method to its flat gas", func() {
+			var gmc vm.PrecompileContainer
+			gmc, err = NewStatefulContainer(
+				&mockGasMetered{&mockStateful{&mockBase{}}}, mockIdsToMethods,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			// 100 flat gas + 2 gas for each of the 32 bytes of arguments
+			input := append(append([]byte{}, getOutputABI.ID...), make([]byte, 32)...)
+			Expect(gmc.RequiredGas(input)).To(Equal(uint64(164)))
+		})
 	})
 
 	Describe("Test Run", func() {
@@ -112,6 +140,53 @@ var _ = Describe("Stateful Container", func() {
 			))
 		})
 
+		It("should not run state-mutating methods in a read-only call", func() {
+			var inputs []byte
+			inputs, err = getOutputABI.Inputs.Pack("string")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = sc.Run(
+				context.WithValue(context.Background(), vm.ReadOnlyContextKey, true),
+				vm.UnwrapPolarContext(ctx).Evm(),
+				append(getOutputABI.ID, inputs...),
+				vm.UnwrapPolarContext(ctx).MsgSender(),
+				vm.UnwrapPolarContext(ctx).MsgValue(),
+			)
+			Expect(err).To(MatchError(ContainSubstring(vm.ErrWriteProtection.Error())))
+		})
+
+		It("should only send value to payable methods", func() {
+			var inputs []byte
+			inputs, err = getOutputABI.Inputs.Pack("string")
+			Expect(err).ToNot(HaveOccurred())
+			input := append(getOutputABI.ID, inputs...)
+			_, err = sc.Run(
+				ctx,
+				vm.UnwrapPolarContext(ctx).Evm(),
+				input,
+				vm.UnwrapPolarContext(ctx).MsgSender(),
+				big.NewInt(1),
+			)
+			Expect(err).To(MatchError(ErrNonPayableMethod))
+
+			payableABI := getOutputABI
+			payableABI.StateMutability = "payable"
+			var pc vm.PrecompileContainer
+			pc, err = NewStatefulContainer(&mockStateful{&mockBase{}}, map[string]*method{
+				utils.UnsafeBytesToStr(getOutputABI.ID): newMethod(
+					mockStatefulDummy, payableABI, getOutputFunc,
+				),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = pc.Run(
+				ctx,
+				vm.UnwrapPolarContext(ctx).Evm(),
+				input,
+				vm.UnwrapPolarContext(ctx).MsgSender(),
+				big.NewInt(1),
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should return properly for valid method calls", func() {
 			var inputs []byte
 			inputs, err = getOutputABI.Inputs.Pack("string")
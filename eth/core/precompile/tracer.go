@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// Trace captures the start of a precompile call as a call frame on the tracer of the given EVM.
+// Tracers that implement `vm.PrecompileTracer` receive the frame with the decoded method of the
+// call, while all other tracers receive it as a CALL from the caller to the precompile, so that
+// the call tracer and struct logger also see the precompile run. The returned function captures
+// the end of the call and must be called with its output, gas used, and error.
+func Trace(
+	evm vm.PrecompileEVM, pc vm.PrecompileContainer, input []byte,
+	caller common.Address, value *big.Int, gas uint64,
+) func(output []byte, gasUsed uint64, err error) {
+	tracer, ok := getTracer(evm)
+	if !ok {
+		return func([]byte, uint64, error) {}
+	}
+
+	if pt, isPrecompileTracer := utils.GetAs[vm.PrecompileTracer](tracer); isPrecompileTracer {
+		pt.CapturePrecompileEnter(
+			pc.RegistryKey(), MethodSig(ActiveVersion(evm, pc), input), caller, input, gas, value,
+		)
+		return pt.CapturePrecompileExit
+	}
+
+	tracer.CaptureEnter(vm.CALL, caller, pc.RegistryKey(), input, gas, value)
+	return tracer.CaptureExit
+}
+
+// MethodSig returns the signature of the method that the given input calls on a stateful
+// precompile container, or an empty string if the container is not stateful or the method is not
+// found.
+func MethodSig(pc vm.PrecompileContainer, input []byte) string {
+	sc, ok := utils.GetAs[*statefulContainer](pc)
+	if !ok || len(input) < NumBytesMethodID {
+		return ""
+	}
+	method, found := sc.idsToMethods[utils.UnsafeBytesToStr(input[:NumBytesMethodID])]
+	if !found {
+		return ""
+	}
+	return method.abiMethod.Sig
+}
+
+// getTracer returns the tracer of the given EVM, if it has one.
+func getTracer(evm vm.PrecompileEVM) (vm.EVMLogger, bool) {
+	gethEVM, ok := utils.GetAs[*vm.GethEVM](evm)
+	if !ok || gethEVM.Config.Tracer == nil {
+		return nil, false
+	}
+	return gethEVM.Config.Tracer, true
+}
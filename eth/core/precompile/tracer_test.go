@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	vmmock "pkg.berachain.dev/polaris/eth/core/vm/mock"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracer", func() {
+	var sc vm.PrecompileContainer
+
+	BeforeEach(func() {
+		var err error
+		sc, err = NewStatefulContainer(&mockStateful{&mockBase{}}, mockIdsToMethods)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should decode the method signature of stateful precompile calls", func() {
+		Expect(MethodSig(sc, getOutputABI.ID)).To(Equal(getOutputABI.Sig))
+		Expect(MethodSig(sc, getOutputPartialABI.ID)).To(Equal(getOutputPartialABI.Sig))
+
+		// method not found and invalid input
+		Expect(MethodSig(sc, []byte{1, 2, 3, 4})).To(BeEmpty())
+		Expect(MethodSig(sc, []byte{1})).To(BeEmpty())
+
+		// stateless precompile
+		Expect(MethodSig(&mockStateless{}, getOutputABI.ID)).To(BeEmpty())
+	})
+
+	It("should not trace without a precompile tracer", func() {
+		endTrace := Trace(
+			vmmock.NewEVM(), sc, getOutputABI.ID, common.Address{}, big.NewInt(0), 100,
+		)
+		Expect(func() { endTrace(nil, 10, nil) }).ToNot(Panic())
+	})
+
+	It("should trace a precompile call on the call tracer", func() {
+		tracer, err := tracers.DefaultDirectory.New("callTracer", &tracers.Context{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		pp := NewDefaultPlugin()
+		evm := vm.NewGethEVMWithPrecompiles(
+			vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{},
+			vmmock.NewEmptyStateDB(), params.DefaultChainConfig, vm.Config{Tracer: tracer}, pp,
+		)
+
+		inputs, err := getOutputABI.Inputs.Pack("string")
+		Expect(err).ToNot(HaveOccurred())
+		caller := common.BytesToAddress([]byte{1})
+		ret, _, err := pp.Run(
+			evm, sc, append(getOutputABI.ID, inputs...), caller, big.NewInt(0), 100, false,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := tracer.GetResult()
+		Expect(err).ToNot(HaveOccurred())
+		var frame struct {
+			Calls []struct {
+				Type   string `json:"type"`
+				From   string `json:"from"`
+				To     string `json:"to"`
+				Output string `json:"output"`
+			} `json:"calls"`
+		}
+		Expect(json.Unmarshal(res, &frame)).To(Succeed())
+		Expect(frame.Calls).To(HaveLen(1))
+		Expect(frame.Calls[0].Type).To(Equal("CALL"))
+		Expect(frame.Calls[0].From).To(Equal(strings.ToLower(caller.Hex())))
+		Expect(frame.Calls[0].To).To(Equal(strings.ToLower(sc.RegistryKey().Hex())))
+		Expect(frame.Calls[0].Output).To(Equal(hexutil.Encode(ret)))
+	})
+})
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// ==============================================================================
+// Versioned Precompile
+// ==============================================================================
+
+// Version is an upgrade of a precompile contract, which replaces the previous version from its
+// activation height onwards.
+type Version struct {
+	// ActivationHeight is the first block number at which this version is run.
+	ActivationHeight uint64
+	// Impl is the implementation of the precompile contract for this version.
+	Impl Registrable
+}
+
+// Versioned is a precompile contract with multiple versions, which are activated at block heights
+// like hard forks. This allows rolling out behavioral fixes of a precompile deterministically,
+// while historical blocks are still replayed with the version that was active at the time.
+type Versioned struct {
+	// genesis is the version of the precompile that is active until the first upgrade.
+	genesis Registrable
+	// upgrades stores the upgraded versions, sorted by activation height.
+	upgrades []Version
+}
+
+// NewVersioned creates a new versioned precompile, which runs the `genesis` implementation until
+// the activation height of the first of the given `upgrades`. All versions must be registered at
+// the same address and have unique activation heights.
+func NewVersioned(genesis Registrable, upgrades ...Version) (*Versioned, error) {
+	sorted := make([]Version, len(upgrades))
+	copy(sorted, upgrades)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ActivationHeight < sorted[j].ActivationHeight
+	})
+
+	for i, upgrade := range sorted {
+		if upgrade.Impl.RegistryKey() != genesis.RegistryKey() {
+			return nil, errorslib.Wrap(ErrInvalidVersion, upgrade.Impl.RegistryKey().Hex())
+		}
+		if i > 0 && upgrade.ActivationHeight == sorted[i-1].ActivationHeight {
+			return nil, errorslib.Wrapf(
+				ErrInvalidVersion, "duplicate activation height %d", upgrade.ActivationHeight,
+			)
+		}
+	}
+
+	return &Versioned{
+		genesis:  genesis,
+		upgrades: sorted,
+	}, nil
+}
+
+// RegistryKey returns the address shared by all versions of the precompile.
+//
+// RegistryKey implements `libtypes.Registrable`.
+func (v *Versioned) RegistryKey() common.Address {
+	return v.genesis.RegistryKey()
+}
+
+// Versions returns the implementations of all versions of the precompile, starting with the
+// genesis version.
+func (v *Versioned) Versions() []Registrable {
+	impls := make([]Registrable, 0, len(v.upgrades)+1)
+	impls = append(impls, v.genesis)
+	for _, upgrade := range v.upgrades {
+		impls = append(impls, upgrade.Impl)
+	}
+	return impls
+}
+
+// ==============================================================================
+// Versioned Container
+// ==============================================================================
+
+// Compile-time assertion to ensure `versionedContainer` adheres to `vm.PrecompileContainer`.
+var _ vm.PrecompileContainer = (*versionedContainer)(nil)
+
+// versionedContainer is a container that runs the precompile container of the version that is
+// active at the current block height.
+type versionedContainer struct {
+	// genesis is the container of the genesis version.
+	genesis vm.PrecompileContainer
+	// upgrades stores the containers of the upgraded versions, sorted by activation height.
+	upgrades []vm.PrecompileContainer
+	// heights stores the activation heights of the upgraded versions.
+	heights []uint64
+}
+
+// RegistryKey implements `libtypes.Registrable`.
+func (vc *versionedContainer) RegistryKey() common.Address {
+	return vc.genesis.RegistryKey()
+}
+
+// activeAt returns the container of the version that is active at the given block number.
+func (vc *versionedContainer) activeAt(blockNumber *big.Int) vm.PrecompileContainer {
+	// find the number of upgrades activated at or before the block number
+	activated := sort.Search(len(vc.heights), func(i int) bool {
+		return new(big.Int).SetUint64(vc.heights[i]).Cmp(blockNumber) > 0
+	})
+	if activated == 0 {
+		return vc.genesis
+	}
+	return vc.upgrades[activated-1]
+}
+
+// RequiredGas returns the required gas of the latest version. NOTE: precompile plugins should
+// resolve the active version with `ActiveVersion` before charging the required gas.
+//
+// RequiredGas implements `vm.PrecompileContainer`.
+func (vc *versionedContainer) RequiredGas(input []byte) uint64 {
+	if len(vc.upgrades) == 0 {
+		return vc.genesis.RequiredGas(input)
+	}
+	return vc.upgrades[len(vc.upgrades)-1].RequiredGas(input)
+}
+
+// Run runs the version of the precompile that is active at the current block height.
+//
+// Run implements `vm.PrecompileContainer`.
+func (vc *versionedContainer) Run(
+	ctx context.Context, evm vm.PrecompileEVM, input []byte, caller common.Address, value *big.Int,
+) ([]byte, error) {
+	return vc.activeAt(evm.GetContext().BlockNumber).Run(ctx, evm, input, caller, value)
+}
+
+// ActiveVersion returns the container of the precompile version that is active at the current
+// block height of the EVM, or the given container if it is not versioned.
+func ActiveVersion(evm vm.PrecompileEVM, pc vm.PrecompileContainer) vm.PrecompileContainer {
+	if vc, ok := pc.(*versionedContainer); ok {
+		return vc.activeAt(evm.GetContext().BlockNumber)
+	}
+	return pc
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"context"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/core/vm/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Versioned Precompile", func() {
+	var (
+		v   *Versioned
+		evm *mock.PrecompileEVMMock
+	)
+
+	atHeight := func(height int64) {
+		evm.GetContextFunc = func() *vm.BlockContext {
+			return &vm.BlockContext{BlockNumber: big.NewInt(height)}
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		v, err = NewVersioned(
+			&mockVersion{gas: 1},
+			Version{ActivationHeight: 20, Impl: &mockVersion{gas: 3}},
+			Version{ActivationHeight: 10, Impl: &mockVersion{gas: 2}},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		evm = mock.NewEVM()
+	})
+
+	It("should reject versions at other addresses or duplicate heights", func() {
+		_, err := NewVersioned(
+			&mockVersion{gas: 1}, Version{ActivationHeight: 10, Impl: &mockStateless{}},
+		)
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidVersion.Error())))
+
+		_, err = NewVersioned(
+			&mockVersion{gas: 1},
+			Version{ActivationHeight: 10, Impl: &mockVersion{gas: 2}},
+			Version{ActivationHeight: 10, Impl: &mockVersion{gas: 3}},
+		)
+		Expect(err).To(MatchError(ContainSubstring(ErrInvalidVersion.Error())))
+	})
+
+	It("should return all versions in activation order", func() {
+		Expect(v.RegistryKey()).To(Equal(mockVersionAddr))
+		Expect(v.Versions()).To(Equal([]Registrable{
+			&mockVersion{gas: 1}, &mockVersion{gas: 2}, &mockVersion{gas: 3},
+		}))
+	})
+
+	It("should run the version active at the block height", func() {
+		pc, err := NewVersionedFactory().Build(v, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pc.RegistryKey()).To(Equal(mockVersionAddr))
+
+		for height, gas := range map[int64]uint64{0: 1, 9: 1, 10: 2, 19: 2, 20: 3, 100: 3} {
+			atHeight(height)
+			Expect(ActiveVersion(evm, pc).RequiredGas(nil)).To(Equal(gas))
+			out, err := pc.Run(context.Background(), evm, nil, common.Address{}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal([]byte{byte(gas)}))
+		}
+	})
+
+	It("should not resolve containers that are not versioned", func() {
+		pc := &mockStateless{}
+		Expect(ActiveVersion(evm, pc)).To(Equal(pc))
+	})
+
+	It("should fail to build other precompiles", func() {
+		_, err := NewVersionedFactory().Build(&mockStateless{}, nil)
+		Expect(err).To(MatchError(ContainSubstring(ErrWrongContainerFactory.Error())))
+	})
+})
+
+// ============================================================================.
+var mockVersionAddr = common.BytesToAddress([]byte("versioned"))
+
+type mockVersion struct {
+	gas uint64
+}
+
+func (mv *mockVersion) RegistryKey() common.Address {
+	return mockVersionAddr
+}
+
+func (mv *mockVersion) RequiredGas(_ []byte) uint64 {
+	return mv.gas
+}
+
+func (mv *mockVersion) Run(
+	_ context.Context, _ vm.PrecompileEVM, _ []byte,
+	_ common.Address, _ *big.Int,
+) ([]byte, error) {
+	return []byte{byte(mv.gas)}, nil
+}
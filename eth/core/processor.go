@@ -185,6 +185,16 @@ func (sp *StateProcessor) ProcessTransaction(
 		return nil, errorslib.Wrapf(err, "could not apply transaction [%s]", tx.Hash().Hex())
 	}
 
+	// The state plugin saves the errors of the state changes it could not apply, e.g. when the
+	// balance changes of the transaction could not be settled to the bank module. The transaction
+	// fails in that case, as its receipt would not match the committed state.
+	if err = sp.statedb.Error(); err != nil {
+		sp.header.GasUsed -= receipt.GasUsed
+		return nil, errorslib.Wrapf(
+			err, "could not commit state of transaction [%s]", tx.Hash().Hex(),
+		)
+	}
+
 	// Consume the gas used by the state transition. In both the out of block gas as well as out of
 	// gas on the plugin cases, the line below will consume the remaining gas for the block and
 	// transaction respectively.
@@ -251,6 +261,8 @@ func (sp *StateProcessor) BuildAndRegisterPrecompiles(precompiles []precompile.R
 		// choose the appropriate precompile factory
 		var af precompile.AbstractFactory
 		switch {
+		case utils.Implements[*precompile.Versioned](pc):
+			af = precompile.NewVersionedFactory()
 		case utils.Implements[precompile.StatefulImpl](pc):
 			af = precompile.NewStatefulFactory()
 		case utils.Implements[precompile.StatelessImpl](pc):
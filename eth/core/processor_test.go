@@ -22,6 +22,7 @@ package core_test
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	bindings "pkg.berachain.dev/polaris/contracts/bindings/testing"
@@ -141,6 +142,25 @@ var _ = Describe("StateProcessor", func() {
 			Expect(logs).To(BeEmpty())
 		})
 
+		It("should fail a transaction whose state changes could not be applied", func() {
+			signedTx := types.MustSignNewTx(key, signer, legacyTxData)
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			sdb.ErrorFunc = func() error {
+				return errors.New("too many addresses touched in block")
+			}
+			Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+			result, err := sp.ProcessTransaction(context.Background(), signedTx)
+			Expect(err).To(MatchError(ContainSubstring("too many addresses touched in block")))
+			Expect(result).To(BeNil())
+			block, receipts, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.GasUsed()).To(BeZero())
+			Expect(receipts).To(BeEmpty())
+		})
+
 		It("should handle", func() {
 			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
 				return big.NewInt(1000001)
@@ -32,8 +32,16 @@ import (
 // ContextKey defines a type alias for a stdlib Context key.
 type ContextKey string
 
-// PolarContextKey is the key in the context.Context which holds the PolarContext.
-const PolarContextKey ContextKey = "polar-context"
+const (
+	// PolarContextKey is the key in the context.Context which holds the PolarContext.
+	PolarContextKey ContextKey = "polar-context"
+	// ReadOnlyContextKey is the key in the base context of a PolarContext which holds whether the
+	// precompile is run in a read-only call, e.g. a STATICCALL.
+	ReadOnlyContextKey ContextKey = "read-only"
+	// QueryContextKey is the key in the base context of a PolarContext which holds whether the
+	// precompile is run by a query, e.g. an eth_call, instead of by a transaction.
+	QueryContextKey ContextKey = "query"
+)
 
 // Compile-time assertion that PolarContext implements context.Context.
 var _ context.Context = (*PolarContext)(nil)
@@ -85,6 +93,20 @@ func (c *PolarContext) Block() *BlockContext {
 	return c.evm.GetContext()
 }
 
+// IsReadOnly returns true if the precompile is run in a read-only call, in which it must not
+// modify state.
+func (c *PolarContext) IsReadOnly() bool {
+	readOnly, _ := c.baseCtx.Value(ReadOnlyContextKey).(bool)
+	return readOnly
+}
+
+// IsQuery returns true if the precompile is run by a query, e.g. an eth_call, in which it may read
+// state that is not part of the consensus, e.g. the state at a past height.
+func (c *PolarContext) IsQuery() bool {
+	query, _ := c.baseCtx.Value(QueryContextKey).(bool)
+	return query
+}
+
 // =============================================================================
 // context.Context implementation
 // =============================================================================
@@ -41,11 +41,16 @@ type (
 	TxContext           = vm.TxContext
 )
 
+const (
+	CALL = vm.CALL
+)
+
 var (
 	NewGethEVMWithPrecompiles     = vm.NewEVMWithPrecompiles
 	ErrOutOfGas                   = vm.ErrOutOfGas
 	ErrExecutionReverted          = vm.ErrExecutionReverted
 	ErrWriteProtection            = vm.ErrWriteProtection
+	PrecompiledContractsBLS       = vm.PrecompiledContractsBLS
 	PrecompiledContractsBerlin    = vm.PrecompiledContractsBerlin
 	PrecompiledContractsByzantium = vm.PrecompiledContractsByzantium
 	PrecompiledContractsHomestead = vm.PrecompiledContractsHomestead
@@ -22,6 +22,9 @@ package vm
 
 import (
 	"context"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
 )
 
 type (
@@ -32,4 +35,19 @@ type (
 		// GetContext returns the current context of the state plugin.
 		GetContext() context.Context
 	}
+
+	// PrecompileTracer defines an extension to the EVMLogger provided by Go-Ethereum to capture
+	// stateful precompile calls as call frames with their decoded method.
+	PrecompileTracer interface {
+		EVMLogger
+		// CapturePrecompileEnter is called when a precompile starts running the given method,
+		// which is the method signature for stateful precompiles and empty otherwise.
+		CapturePrecompileEnter(
+			pc common.Address, method string, caller common.Address,
+			input []byte, gas uint64, value *big.Int,
+		)
+		// CapturePrecompileExit is called when the precompile call started by the last
+		// CapturePrecompileEnter returns.
+		CapturePrecompileExit(output []byte, gasUsed uint64, err error)
+	}
 )
@@ -39,3 +39,8 @@ var (
 	// InitialBaseFee is the initial base fee for the first block of the chain.
 	InitialBaseFee = params.InitialBaseFee
 )
+
+const (
+	// TxGas is the intrinsic gas of a transaction that is not a contract creation.
+	TxGas = params.TxGas
+)
@@ -107,6 +107,11 @@ func NewWithNetworkingStack(
 	return pl
 }
 
+// Blockchain returns the canonical chain run by Polaris.
+func (pl *Polaris) Blockchain() core.Blockchain {
+	return pl.blockchain
+}
+
 // APIs return the collection of RPC services the polar package offers.
 // NOTE, some of these services probably need to be moved to somewhere else.
 func (pl *Polaris) APIs() []rpc.API {